@@ -24,5 +24,19 @@ type Client interface {
 	// you should notify bytedance through `done` method,
 	// then bytedance will start handling the data in this day
 	// @param dateList, optional, if dataList is empty, indicate target date is previous day
+	// Dates are taken in the location of option.WithDateLocation if it's set,
+	// otherwise in the location of each date. Future dates and duplicated
+	// dates are rejected
 	Done(dateList []time.Time, topic string, opts ...option.Option) (*DoneResponse, error)
+
+	// DoneRange
+	//
+	// Marks the dates from `from` to `to` done, both inclusive
+	DoneRange(from time.Time, to time.Time, topic string, opts ...option.Option) (*DoneResponse, error)
+
+	// DoneTopics
+	//
+	// Marks the dates done for each topic, the responses of topics succeeded
+	// are returned even if some topics failed
+	DoneTopics(dateList []time.Time, topics []string, opts ...option.Option) (map[string]*DoneResponse, error)
 }
@@ -1,6 +1,8 @@
 package common
 
 import (
+	"errors"
+	"fmt"
 	"strings"
 	"time"
 
@@ -47,16 +49,17 @@ func (c *clientImpl) ListOperations(request *ListOperationsRequest,
 }
 
 func (c *clientImpl) Done(dateList []time.Time, topic string, opts ...option.Option) (*DoneResponse, error) {
-	var dates []*Date
-	for _, date := range dateList {
-		dates = c.appendDoneDate(dates, date)
+	options := option.Conv2Options(opts...)
+	dates, err := doneDates(dateList, options.DateLocation, time.Now())
+	if err != nil {
+		return nil, err
 	}
 	url := strings.ReplaceAll(c.cu.doneUrlFormat, "{}", topic)
 	request := &DoneRequest{
 		DataDates: dates,
 	}
 	response := &DoneResponse{}
-	err := c.cli.DoPBRequest(url, request, response, option.Conv2Options(opts...))
+	err = c.cli.DoPBRequest(url, request, response, options)
 	if err != nil {
 		return nil, err
 	}
@@ -64,11 +67,37 @@ func (c *clientImpl) Done(dateList []time.Time, topic string, opts ...option.Opt
 	return response, nil
 }
 
-func (c *clientImpl) appendDoneDate(dates []*Date,
-	date time.Time) []*Date {
-	return append(dates, &Date{
-		Year:  int32(date.Year()),
-		Month: int32(date.Month()),
-		Day:   int32(date.Day()),
-	})
+func (c *clientImpl) DoneRange(from time.Time, to time.Time,
+	topic string, opts ...option.Option) (*DoneResponse, error) {
+	if location := option.Conv2Options(opts...).DateLocation; location != nil {
+		from, to = from.In(location), to.In(location)
+	}
+	if from.After(to) {
+		return nil, errors.New("done date range is empty")
+	}
+	return c.Done(DateRange(from, to), topic, opts...)
+}
+
+func (c *clientImpl) DoneTopics(dateList []time.Time, topics []string,
+	opts ...option.Option) (map[string]*DoneResponse, error) {
+	options := option.Conv2Options(opts...)
+	if _, err := doneDates(dateList, options.DateLocation, time.Now()); err != nil {
+		return nil, err
+	}
+	responses := make(map[string]*DoneResponse, len(topics))
+	var failedTopics []string
+	var lastErr error
+	for _, topic := range topics {
+		response, err := c.Done(dateList, topic, opts...)
+		if err != nil {
+			failedTopics = append(failedTopics, topic)
+			lastErr = err
+			continue
+		}
+		responses[topic] = response
+	}
+	if lastErr != nil {
+		return responses, fmt.Errorf("done topics %v fail, last err: %w", failedTopics, lastErr)
+	}
+	return responses, nil
 }
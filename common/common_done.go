@@ -0,0 +1,178 @@
+package common
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"sort"
+	"sync"
+	"time"
+
+	. "github.com/byteplus-sdk/sdk-go/common/protocol"
+	. "github.com/byteplus-sdk/sdk-go/core"
+	"github.com/byteplus-sdk/sdk-go/core/option"
+)
+
+// DateRange returns the dates from `from` to `to`, both inclusive, one for each
+// day in the location of `from`, each date is the midnight of the day
+func DateRange(from time.Time, to time.Time) []time.Time {
+	location := from.Location()
+	to = to.In(location)
+	day := time.Date(from.Year(), from.Month(), from.Day(), 0, 0, 0, 0, location)
+	end := time.Date(to.Year(), to.Month(), to.Day(), 0, 0, 0, 0, location)
+	var dates []time.Time
+	for !day.After(end) {
+		dates = append(dates, day)
+		day = day.AddDate(0, 0, 1)
+	}
+	return dates
+}
+
+// doneDates converts the dates to the Date of DoneRequest in the location of
+// option.WithDateLocation, or the location of each date if it's not set.
+// Future dates and duplicated dates are rejected
+func doneDates(dateList []time.Time, location *time.Location, now time.Time) ([]*Date, error) {
+	dates := make([]*Date, 0, len(dateList))
+	exist := make(map[string]bool, len(dateList))
+	for _, date := range dateList {
+		if location != nil {
+			date = date.In(location)
+		}
+		key := date.Format("2006-01-02")
+		if exist[key] {
+			return nil, fmt.Errorf("duplicated done date %s", key)
+		}
+		exist[key] = true
+		if key > now.In(date.Location()).Format("2006-01-02") {
+			return nil, fmt.Errorf("done date %s is in the future", key)
+		}
+		dates = append(dates, &Date{
+			Year:  int32(date.Year()),
+			Month: int32(date.Month()),
+			Day:   int32(date.Day()),
+		})
+	}
+	return dates, nil
+}
+
+// DoneLedger records the dates marked done of each topic in a local file,
+// so that a backfill can find the dates not done yet, and won't call Done
+// for the dates done again
+type DoneLedger struct {
+	lock sync.Mutex
+	path string
+	// topic -> dates in format of "yyyy-mm-dd"
+	topicDates map[string]map[string]bool
+}
+
+func NewDoneLedger(path string) (*DoneLedger, error) {
+	ledger := &DoneLedger{path: path, topicDates: make(map[string]map[string]bool)}
+	content, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return ledger, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var topicDates map[string][]string
+	if err = json.Unmarshal(content, &topicDates); err != nil {
+		return nil, err
+	}
+	for topic, dates := range topicDates {
+		ledger.topicDates[topic] = make(map[string]bool, len(dates))
+		for _, date := range dates {
+			ledger.topicDates[topic][date] = true
+		}
+	}
+	return ledger, nil
+}
+
+// Done calls client.Done for the dates of topic not in ledger, and records
+// them after success. The dates are taken in the location of
+// option.WithDateLocation, or the location of each date if it's not set
+func (receiver *DoneLedger) Done(client Client, dateList []time.Time,
+	topic string, opts ...option.Option) (*DoneResponse, error) {
+	if len(dateList) == 0 {
+		return nil, errors.New("done date list is empty")
+	}
+	location := option.Conv2Options(opts...).DateLocation
+	undone := receiver.PendingDates(dateList, topic, location)
+	if len(undone) == 0 {
+		return &DoneResponse{Status: &Status{Code: StatusCodeSuccess, Message: "all dates are done"}}, nil
+	}
+	response, err := client.Done(undone, topic, opts...)
+	if err != nil {
+		return nil, err
+	}
+	if response.GetStatus().GetCode() != StatusCodeSuccess {
+		return response, nil
+	}
+	return response, receiver.record(undone, topic, location)
+}
+
+// IsDone returns whether the date of topic is recorded in ledger
+func (receiver *DoneLedger) IsDone(date time.Time, topic string) bool {
+	receiver.lock.Lock()
+	defer receiver.lock.Unlock()
+	return receiver.topicDates[topic][date.Format("2006-01-02")]
+}
+
+// PendingDates returns the dates of topic not recorded in ledger, the dates
+// are taken in location if it's not nil
+func (receiver *DoneLedger) PendingDates(dateList []time.Time,
+	topic string, location *time.Location) []time.Time {
+	var pending []time.Time
+	for _, date := range dateList {
+		if location != nil {
+			date = date.In(location)
+		}
+		if !receiver.IsDone(date, topic) {
+			pending = append(pending, date)
+		}
+	}
+	return pending
+}
+
+// DoneDates returns the dates of topic recorded in ledger in ascending order
+func (receiver *DoneLedger) DoneDates(topic string) []string {
+	receiver.lock.Lock()
+	defer receiver.lock.Unlock()
+	dates := make([]string, 0, len(receiver.topicDates[topic]))
+	for date := range receiver.topicDates[topic] {
+		dates = append(dates, date)
+	}
+	sort.Strings(dates)
+	return dates
+}
+
+func (receiver *DoneLedger) record(dateList []time.Time, topic string, location *time.Location) error {
+	receiver.lock.Lock()
+	defer receiver.lock.Unlock()
+	if receiver.topicDates[topic] == nil {
+		receiver.topicDates[topic] = make(map[string]bool)
+	}
+	for _, date := range dateList {
+		if location != nil {
+			date = date.In(location)
+		}
+		receiver.topicDates[topic][date.Format("2006-01-02")] = true
+	}
+	topicDates := make(map[string][]string, len(receiver.topicDates))
+	for topic, dates := range receiver.topicDates {
+		for date := range dates {
+			topicDates[topic] = append(topicDates[topic], date)
+		}
+		sort.Strings(topicDates[topic])
+	}
+	content, err := json.MarshalIndent(topicDates, "", "  ")
+	if err != nil {
+		return err
+	}
+	tmpPath := receiver.path + ".tmp"
+	if err = ioutil.WriteFile(tmpPath, content, 0644); err != nil {
+		return err
+	}
+	return os.Rename(tmpPath, receiver.path)
+}
@@ -0,0 +1,80 @@
+package common
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/byteplus-sdk/sdk-go/core/option"
+)
+
+func TestDateRange(t *testing.T) {
+	from := time.Date(2021, 2, 27, 15, 0, 0, 0, time.UTC)
+	to := time.Date(2021, 3, 1, 1, 0, 0, 0, time.UTC)
+	dates := DateRange(from, to)
+	if len(dates) != 3 || dates[2].Format("2006-01-02") != "2021-03-01" || dates[0].Hour() != 0 {
+		t.Errorf("DateRange() = %v", dates)
+	}
+	if dates := DateRange(to, from); len(dates) != 0 {
+		t.Errorf("DateRange() of reversed range = %v", dates)
+	}
+}
+
+func Test_doneDates(t *testing.T) {
+	now := time.Date(2021, 6, 10, 12, 0, 0, 0, time.UTC)
+	tokyo := time.FixedZone("Tokyo", 9*3600)
+	// 2021-06-09 20:00 in UTC is 2021-06-10 in Tokyo
+	date := time.Date(2021, 6, 9, 20, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name     string
+		dates    []time.Time
+		location *time.Location
+		wantDay  int32
+		wantErr  bool
+	}{
+		{name: "own_location", dates: []time.Time{date}, wantDay: 9},
+		{name: "explicit_location", dates: []time.Time{date}, location: tokyo, wantDay: 10},
+		{name: "future", dates: []time.Time{now.AddDate(0, 0, 1)}, wantErr: true},
+		{name: "duplicated", dates: []time.Time{date, date.Add(time.Hour)}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := doneDates(tt.dates, tt.location, now)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("doneDates() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && got[0].Day != tt.wantDay {
+				t.Errorf("doneDates() day = %v, want %v", got[0].Day, tt.wantDay)
+			}
+		})
+	}
+}
+
+func TestDoneLedger(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "done.json")
+	ledger, err := NewDoneLedger(path)
+	if err != nil {
+		t.Fatalf("NewDoneLedger() error = %v", err)
+	}
+	client := &mockDoneClient{}
+	dates := DateRange(time.Date(2021, 6, 1, 0, 0, 0, 0, time.UTC), time.Date(2021, 6, 3, 0, 0, 0, 0, time.UTC))
+	if _, err = ledger.Done(client, dates[:2], "user"); err != nil {
+		t.Fatalf("Done() error = %v", err)
+	}
+	ledger, _ = NewDoneLedger(path)
+	if pending := ledger.PendingDates(dates, "user", nil); len(pending) != 1 || !pending[0].Equal(dates[2]) {
+		t.Errorf("PendingDates() = %v", pending)
+	}
+	if _, err = ledger.Done(client, dates, "user", option.WithDateLocation(time.UTC)); err != nil {
+		t.Fatalf("Done() error = %v", err)
+	}
+	if len(client.doneTopics) != 2 {
+		t.Errorf("Done called %d times, want 2", len(client.doneTopics))
+	}
+	if got := ledger.DoneDates("user"); len(got) != 3 || got[0] != "2021-06-01" {
+		t.Errorf("DoneDates() = %v", got)
+	}
+	if ledger.IsDone(dates[0], "product") {
+		t.Errorf("IsDone() of another topic should be false")
+	}
+}
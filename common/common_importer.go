@@ -0,0 +1,244 @@
+package common
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	. "github.com/byteplus-sdk/sdk-go/common/protocol"
+	. "github.com/byteplus-sdk/sdk-go/core"
+	"github.com/byteplus-sdk/sdk-go/core/logs"
+	"github.com/byteplus-sdk/sdk-go/core/option"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	defaultImportPollInterval = 10 * time.Second
+	defaultImportPollTimeout  = time.Hour
+	// stop reading the file if too many records are invalid,
+	// which usually means the mapping is wrong
+	defaultMaxInvalidRecords = 1000
+	importDateLayout         = "2006-01-02"
+)
+
+type ImportConfig struct {
+	// Required, the date of the data, which is set to `DateConfig.date`
+	// of import requests
+	Date time.Time
+	// If true, `DateConfig.is_end` of the last import request is true
+	DateEnd bool
+	// Max records in one import request, default is MaxImportItemCount
+	BatchSize int
+	// The operations of import requests are polled by GetOperation every
+	// PollInterval until they are done or PollTimeout, default is 10s and 1h.
+	// Negative PollTimeout means never wait for the operations
+	PollInterval time.Duration
+	PollTimeout  time.Duration
+	// Stop importing when the invalid records exceed MaxInvalidRecords, default is 1000
+	MaxInvalidRecords int
+}
+
+// InvalidRecord is a record can't be converted to the item of import request
+type InvalidRecord struct {
+	// The position of the record, see RecordReader.Line
+	Line  int
+	Error string
+}
+
+// ImportOperation is the operation of an import request
+type ImportOperation struct {
+	Name string
+	// Count of items sent in the request
+	Count int
+	Done  bool
+	// The metadata of the finished operation, including success and failure count
+	Metadata *Metadata
+	// Error of the import request, or error of polling the operation
+	Error string
+}
+
+// ImportSummary is the result of importing all records of a RecordReader
+type ImportSummary struct {
+	Records        int
+	InvalidRecords []*InvalidRecord
+	Operations     []*ImportOperation
+	// Sum of the metadata of the finished operations
+	SuccessCount int64
+	FailureCount int64
+}
+
+// RecordConvertFunc converts a record read from file to the item of import request
+type RecordConvertFunc func(record Record) (interface{}, error)
+
+// ImportBatchFunc sends the items in one import request, `dateEnd` is true for
+// the last request if ImportConfig.DateEnd is true
+type ImportBatchFunc func(items []interface{}, dateEnd bool) (*OperationResponse, error)
+
+// ImportRecords reads all records from reader, converts them and sends them by
+// importBatch in batches, then waits for all operations of the requests done.
+// The returned error is the error of reading records, the errors of import
+// requests and operations are in summary. Once reading fails, the records read
+// but not sent yet are dropped and the date end is never sent, the operations
+// already sent are returned in summary without waiting for them
+func ImportRecords(client Client, reader RecordReader, convert RecordConvertFunc,
+	importBatch ImportBatchFunc, config *ImportConfig) (*ImportSummary, error) {
+	config, err := fillDefaultImportConfig(config)
+	if err != nil {
+		return nil, err
+	}
+	summary := &ImportSummary{}
+	batch, err := readImportBatch(reader, convert, config, summary)
+	for err == nil && len(batch) > 0 {
+		// read the next batch in advance, so that the last batch can be marked as date end
+		var next []interface{}
+		if next, err = readImportBatch(reader, convert, config, summary); err != nil {
+			break
+		}
+		isLast := len(next) == 0
+		summary.Operations = append(summary.Operations, doImportBatch(batch, config.DateEnd && isLast, importBatch))
+		batch = next
+	}
+	if err != nil {
+		return summary, err
+	}
+	if len(summary.Operations) == 0 && config.DateEnd {
+		// no valid record, mark the date end by an empty request
+		summary.Operations = append(summary.Operations, doImportBatch(nil, true, importBatch))
+	}
+	if config.PollTimeout > 0 {
+		waitImportOperations(client, summary.Operations, config)
+	}
+	for _, operation := range summary.Operations {
+		summary.SuccessCount += operation.Metadata.GetSuccessCount()
+		summary.FailureCount += operation.Metadata.GetFailureCount()
+	}
+	return summary, nil
+}
+
+// NewMessageFunc creates an empty item of import request, which the record is mapped to
+type NewMessageFunc func() proto.Message
+
+// ImportMessagesFunc sends the messages in one import request, `date` is the
+// ImportConfig.Date formatted as `DateConfig.date`, and `dateEnd` is true for the
+// last request if ImportConfig.DateEnd is true
+type ImportMessagesFunc func(messages []proto.Message, date string, dateEnd bool) (*OperationResponse, error)
+
+// ImportMessages is ImportRecords whose records are mapped to the messages created by
+// newMessage with mapping, so that importMessages only builds the import request
+func ImportMessages(client Client, reader RecordReader, mapping FieldMapping, newMessage NewMessageFunc,
+	importMessages ImportMessagesFunc, config *ImportConfig) (*ImportSummary, error) {
+	convert := func(record Record) (interface{}, error) {
+		message := newMessage()
+		if err := RecordToMessage(record, mapping, message); err != nil {
+			return nil, err
+		}
+		return message, nil
+	}
+	importBatch := func(items []interface{}, dateEnd bool) (*OperationResponse, error) {
+		messages := make([]proto.Message, 0, len(items))
+		for _, item := range items {
+			messages = append(messages, item.(proto.Message))
+		}
+		return importMessages(messages, config.Date.Format(importDateLayout), dateEnd)
+	}
+	return ImportRecords(client, reader, convert, importBatch, config)
+}
+
+func fillDefaultImportConfig(config *ImportConfig) (*ImportConfig, error) {
+	if config == nil || config.Date.IsZero() {
+		return nil, errors.New("import date is empty")
+	}
+	result := *config
+	if result.BatchSize <= 0 || result.BatchSize > MaxImportItemCount {
+		result.BatchSize = MaxImportItemCount
+	}
+	if result.PollInterval <= 0 {
+		result.PollInterval = defaultImportPollInterval
+	}
+	if result.PollTimeout == 0 {
+		result.PollTimeout = defaultImportPollTimeout
+	}
+	if result.MaxInvalidRecords <= 0 {
+		result.MaxInvalidRecords = defaultMaxInvalidRecords
+	}
+	return &result, nil
+}
+
+func readImportBatch(reader RecordReader, convert RecordConvertFunc,
+	config *ImportConfig, summary *ImportSummary) ([]interface{}, error) {
+	batch := make([]interface{}, 0, config.BatchSize)
+	for len(batch) < config.BatchSize {
+		record, err := reader.Read()
+		if err == io.EOF {
+			return batch, nil
+		}
+		if err != nil {
+			return batch, err
+		}
+		summary.Records++
+		item, err := convert(record)
+		if err != nil {
+			summary.InvalidRecords = append(summary.InvalidRecords,
+				&InvalidRecord{Line: reader.Line(), Error: err.Error()})
+			if len(summary.InvalidRecords) >= config.MaxInvalidRecords {
+				return batch, fmt.Errorf("too many invalid records, the last one is at %d: %w", reader.Line(), err)
+			}
+			continue
+		}
+		batch = append(batch, item)
+	}
+	return batch, nil
+}
+
+func doImportBatch(batch []interface{}, dateEnd bool, importBatch ImportBatchFunc) *ImportOperation {
+	operation := &ImportOperation{Count: len(batch)}
+	response, err := importBatch(batch, dateEnd)
+	if err != nil {
+		logs.Error("[ImportRecords] import %d items fail, err:%s", len(batch), err.Error())
+		operation.Error = err.Error()
+		return operation
+	}
+	if status := response.GetStatus(); status.GetCode() != StatusCodeSuccess {
+		operation.Error = fmt.Sprintf("import fail, code:%d msg:%s", status.GetCode(), status.GetMessage())
+		return operation
+	}
+	operation.Name = response.GetOperation().GetName()
+	operation.Done = response.GetOperation().GetDone()
+	operation.Metadata = response.GetOperation().GetMetadata()
+	return operation
+}
+
+func waitImportOperations(client Client, operations []*ImportOperation, config *ImportConfig) {
+	deadline := time.Now().Add(config.PollTimeout)
+	for {
+		pending := 0
+		for _, operation := range operations {
+			if operation.Done || operation.Name == "" {
+				continue
+			}
+			request := &GetOperationRequest{Name: operation.Name}
+			response, err := client.GetOperation(request, option.WithTimeout(config.PollInterval))
+			if err != nil {
+				logs.Warn("[ImportRecords] get operation %s fail, err:%s", operation.Name, err.Error())
+				operation.Error = err.Error()
+				pending++
+				continue
+			}
+			operation.Error = ""
+			operation.Done = response.GetOperation().GetDone()
+			operation.Metadata = response.GetOperation().GetMetadata()
+			if !operation.Done {
+				pending++
+			}
+		}
+		if pending == 0 {
+			return
+		}
+		if time.Now().Add(config.PollInterval).After(deadline) {
+			logs.Warn("[ImportRecords] %d operations are still not done after %v", pending, config.PollTimeout)
+			return
+		}
+		time.Sleep(config.PollInterval)
+	}
+}
@@ -0,0 +1,122 @@
+package common
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"testing"
+	"time"
+
+	. "github.com/byteplus-sdk/sdk-go/common/protocol"
+	. "github.com/byteplus-sdk/sdk-go/core"
+	"google.golang.org/protobuf/proto"
+)
+
+type sliceRecordReader struct {
+	records []Record
+	line    int
+	// the error returned instead of the record at failAt
+	failAt int
+	err    error
+}
+
+func (r *sliceRecordReader) Read() (Record, error) {
+	if r.err != nil && r.line+1 == r.failAt {
+		return nil, r.err
+	}
+	if r.line >= len(r.records) {
+		return nil, io.EOF
+	}
+	r.line++
+	return r.records[r.line-1], nil
+}
+
+func (r *sliceRecordReader) Line() int {
+	return r.line
+}
+
+func (r *sliceRecordReader) Close() error {
+	return nil
+}
+
+func TestImportMessages(t *testing.T) {
+	reader := &sliceRecordReader{records: []Record{
+		{"op": "op-1"},
+		{"op": "op-2", "finished": "maybe"},
+		{"op": "op-3", "finished": "true"},
+	}}
+	mapping := FieldMapping{"op": "name", "finished": "done"}
+	newMessage := func() proto.Message {
+		return &Operation{}
+	}
+	var names, dates []string
+	var dateEnds []bool
+	importMessages := func(messages []proto.Message, date string, dateEnd bool) (*OperationResponse, error) {
+		for _, message := range messages {
+			names = append(names, message.(*Operation).GetName())
+		}
+		dates = append(dates, date)
+		dateEnds = append(dateEnds, dateEnd)
+		operation := &Operation{Done: true, Metadata: &Metadata{SuccessCount: int64(len(messages))}}
+		return &OperationResponse{Status: &Status{Code: StatusCodeSuccess}, Operation: operation}, nil
+	}
+	config := &ImportConfig{
+		Date:        time.Date(2021, 6, 10, 0, 0, 0, 0, time.Local),
+		DateEnd:     true,
+		BatchSize:   1,
+		PollTimeout: -1,
+	}
+	summary, err := ImportMessages(nil, reader, mapping, newMessage, importMessages, config)
+	if err != nil {
+		t.Fatalf("ImportMessages() error = %v", err)
+	}
+	if len(summary.InvalidRecords) != 1 || summary.InvalidRecords[0].Line != 2 {
+		t.Errorf("ImportMessages() invalid records = %v", summary.InvalidRecords)
+	}
+	if !reflect.DeepEqual(names, []string{"op-1", "op-3"}) || summary.SuccessCount != 2 {
+		t.Errorf("ImportMessages() imported %v, success %d", names, summary.SuccessCount)
+	}
+	if !reflect.DeepEqual(dates, []string{"2021-06-10", "2021-06-10"}) ||
+		!reflect.DeepEqual(dateEnds, []bool{false, true}) {
+		t.Errorf("ImportMessages() dates = %v, date ends = %v", dates, dateEnds)
+	}
+}
+
+func TestImportMessages_readFailure(t *testing.T) {
+	readErr := errors.New("read failure")
+	reader := &sliceRecordReader{failAt: 6, err: readErr}
+	for i := 1; i <= 6; i++ {
+		reader.records = append(reader.records, Record{"op": fmt.Sprintf("op-%d", i)})
+	}
+	var names []string
+	var dateEnds []bool
+	config := &ImportConfig{
+		Date:        time.Date(2021, 6, 10, 0, 0, 0, 0, time.Local),
+		DateEnd:     true,
+		BatchSize:   2,
+		PollTimeout: -1,
+	}
+	newMessage := func() proto.Message {
+		return &Operation{}
+	}
+	importMessages := func(messages []proto.Message, date string, dateEnd bool) (*OperationResponse, error) {
+		for _, message := range messages {
+			names = append(names, message.(*Operation).GetName())
+		}
+		dateEnds = append(dateEnds, dateEnd)
+		return &OperationResponse{Status: &Status{Code: StatusCodeSuccess}, Operation: &Operation{Done: true}}, nil
+	}
+	summary, err := ImportMessages(nil, reader, FieldMapping{"op": "name"}, newMessage, importMessages, config)
+	if err != readErr {
+		t.Fatalf("ImportMessages() error = %v, want %v", err, readErr)
+	}
+	// the batch read before the failure is sent, but neither the batches
+	// read after it nor the date end
+	if !reflect.DeepEqual(names, []string{"op-1", "op-2"}) || !reflect.DeepEqual(dateEnds, []bool{false}) {
+		t.Errorf("ImportMessages() imported %v, date ends = %v", names, dateEnds)
+	}
+	if len(summary.Operations) != 1 || summary.Records != 5 {
+		t.Errorf("ImportMessages() summary = %+v", summary)
+	}
+}
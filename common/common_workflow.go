@@ -0,0 +1,224 @@
+package common
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"sync"
+	"time"
+
+	. "github.com/byteplus-sdk/sdk-go/core"
+	"github.com/byteplus-sdk/sdk-go/core/logs"
+	"github.com/byteplus-sdk/sdk-go/core/option"
+)
+
+const (
+	defaultMinSuccessRatio = 0.99
+
+	workflowStateImported = "imported"
+	workflowStateDone     = "done"
+)
+
+// ImportStepFunc imports the data of a topic on the date, e.g. by calling
+// retail.ImportUsersFromReader with the file of the date
+type ImportStepFunc func(date time.Time, topic string) (*ImportSummary, error)
+
+type ImportWorkflowConfig struct {
+	// Required, the topics imported on each date, e.g. "user", "product", "user_event"
+	Topics []string
+	// Done of a topic won't be called unless the success ratio of the items imported
+	// is not less than MinSuccessRatio, the invalid records are regarded as failure,
+	// default is 0.99
+	MinSuccessRatio float64
+	// Optional, the local file recording the finished steps, so that the finished
+	// imports and Done calls are skipped when the workflow is run again
+	CheckpointPath string
+	// The options of Done calls
+	DoneOptions []option.Option
+}
+
+// ImportWorkflowResult is the result of a topic on a date
+type ImportWorkflowResult struct {
+	Date  time.Time
+	Topic string
+	// Nil if the import is skipped as it's finished in checkpoint
+	Summary *ImportSummary
+	// Whether Done of the topic on the date has been called successfully
+	Done  bool
+	Error string
+}
+
+// ImportWorkflow runs the offline data protocol for each date and topic:
+// import the data, wait for the operations, verify the success ratio, and
+// then call Done to mark the date finished
+type ImportWorkflow struct {
+	client     Client
+	config     *ImportWorkflowConfig
+	importStep ImportStepFunc
+	lock       sync.Mutex
+	// date -> topic -> state
+	checkpoint map[string]map[string]string
+}
+
+func NewImportWorkflow(client Client, config *ImportWorkflowConfig,
+	importStep ImportStepFunc) (*ImportWorkflow, error) {
+	if config == nil || len(config.Topics) == 0 {
+		return nil, errors.New("workflow topics are empty")
+	}
+	result := *config
+	if result.MinSuccessRatio <= 0 {
+		result.MinSuccessRatio = defaultMinSuccessRatio
+	}
+	workflow := &ImportWorkflow{
+		client:     client,
+		config:     &result,
+		importStep: importStep,
+		checkpoint: make(map[string]map[string]string),
+	}
+	if err := workflow.loadCheckpoint(); err != nil {
+		return nil, err
+	}
+	return workflow, nil
+}
+
+// Run imports the topics of the dates in order, Done of a topic on a date is
+// called only after its import succeeded. Once a topic fails on a date, it's
+// skipped on the later dates, as Done of them would mark the failed date finished
+// too. The returned error is the last error of the steps, the result of each step,
+// including the skipped ones, is in the returned results
+func (receiver *ImportWorkflow) Run(dates []time.Time) ([]*ImportWorkflowResult, error) {
+	var results []*ImportWorkflowResult
+	var lastErr error
+	// topic -> the first date failed
+	failed := make(map[string]time.Time)
+	for _, date := range dates {
+		for _, topic := range receiver.config.Topics {
+			if failedDate, ok := failed[topic]; ok {
+				results = append(results, &ImportWorkflowResult{Date: date, Topic: topic,
+					Error: fmt.Sprintf("skipped as %s failed", formatDate(failedDate))})
+				continue
+			}
+			result := receiver.runStep(date, topic)
+			if result.Error != "" {
+				failed[topic] = date
+				lastErr = fmt.Errorf("%s of %s fail: %s", topic, formatDate(date), result.Error)
+				logs.Error("[ImportWorkflow] %s", lastErr.Error())
+			}
+			results = append(results, result)
+		}
+	}
+	return results, lastErr
+}
+
+func (receiver *ImportWorkflow) runStep(date time.Time, topic string) *ImportWorkflowResult {
+	result := &ImportWorkflowResult{Date: date, Topic: topic}
+	state := receiver.state(date, topic)
+	if state == workflowStateDone {
+		result.Done = true
+		return result
+	}
+	if state != workflowStateImported {
+		summary, err := receiver.importStep(date, topic)
+		result.Summary = summary
+		if err == nil {
+			err = checkImportSummary(summary, receiver.config.MinSuccessRatio)
+		}
+		if err != nil {
+			result.Error = err.Error()
+			return result
+		}
+		if err = receiver.saveState(date, topic, workflowStateImported); err != nil {
+			result.Error = err.Error()
+			return result
+		}
+	}
+	response, err := receiver.client.Done([]time.Time{date}, topic, receiver.config.DoneOptions...)
+	if err == nil && response.GetStatus().GetCode() != StatusCodeSuccess {
+		err = fmt.Errorf("done fail, code:%d msg:%s", response.GetStatus().GetCode(),
+			response.GetStatus().GetMessage())
+	}
+	if err != nil {
+		result.Error = err.Error()
+		return result
+	}
+	result.Done = true
+	if err = receiver.saveState(date, topic, workflowStateDone); err != nil {
+		result.Error = err.Error()
+	}
+	return result
+}
+
+// checkImportSummary returns error if any import request or operation failed,
+// or the success ratio is lower than minSuccessRatio
+func checkImportSummary(summary *ImportSummary, minSuccessRatio float64) error {
+	if summary == nil {
+		return errors.New("import summary is empty")
+	}
+	for _, operation := range summary.Operations {
+		if operation.Error != "" {
+			return fmt.Errorf("operation %s fail: %s", operation.Name, operation.Error)
+		}
+		if !operation.Done {
+			return fmt.Errorf("operation %s is not done", operation.Name)
+		}
+	}
+	failure := summary.FailureCount + int64(len(summary.InvalidRecords))
+	total := summary.SuccessCount + failure
+	if total == 0 {
+		return nil
+	}
+	ratio := float64(summary.SuccessCount) / float64(total)
+	if ratio < minSuccessRatio {
+		return fmt.Errorf("success ratio %.4f is lower than %.4f, success:%d failure:%d",
+			ratio, minSuccessRatio, summary.SuccessCount, failure)
+	}
+	return nil
+}
+
+func formatDate(date time.Time) string {
+	return date.Format("2006-01-02")
+}
+
+func (receiver *ImportWorkflow) state(date time.Time, topic string) string {
+	receiver.lock.Lock()
+	defer receiver.lock.Unlock()
+	return receiver.checkpoint[formatDate(date)][topic]
+}
+
+func (receiver *ImportWorkflow) loadCheckpoint() error {
+	if receiver.config.CheckpointPath == "" {
+		return nil
+	}
+	content, err := ioutil.ReadFile(receiver.config.CheckpointPath)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(content, &receiver.checkpoint)
+}
+
+func (receiver *ImportWorkflow) saveState(date time.Time, topic string, state string) error {
+	receiver.lock.Lock()
+	defer receiver.lock.Unlock()
+	dateKey := formatDate(date)
+	if receiver.checkpoint[dateKey] == nil {
+		receiver.checkpoint[dateKey] = make(map[string]string)
+	}
+	receiver.checkpoint[dateKey][topic] = state
+	if receiver.config.CheckpointPath == "" {
+		return nil
+	}
+	content, err := json.MarshalIndent(receiver.checkpoint, "", "  ")
+	if err != nil {
+		return err
+	}
+	tmpPath := receiver.config.CheckpointPath + ".tmp"
+	if err = ioutil.WriteFile(tmpPath, content, 0644); err != nil {
+		return err
+	}
+	return os.Rename(tmpPath, receiver.config.CheckpointPath)
+}
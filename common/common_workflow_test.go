@@ -0,0 +1,108 @@
+package common
+
+import (
+	"errors"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	. "github.com/byteplus-sdk/sdk-go/common/protocol"
+	"github.com/byteplus-sdk/sdk-go/core/option"
+)
+
+type mockDoneClient struct {
+	Client
+	doneTopics []string
+	doneDates  []string
+}
+
+func (m *mockDoneClient) Done(dateList []time.Time, topic string, opts ...option.Option) (*DoneResponse, error) {
+	m.doneTopics = append(m.doneTopics, topic)
+	m.doneDates = append(m.doneDates, topic+"@"+formatDate(dateList[0]))
+	return &DoneResponse{Status: &Status{}}, nil
+}
+
+func TestImportWorkflow_Run(t *testing.T) {
+	client := &mockDoneClient{}
+	summaries := map[string]*ImportSummary{
+		"user":    {SuccessCount: 100, Operations: []*ImportOperation{{Name: "op-1", Done: true}}},
+		"product": {SuccessCount: 90, FailureCount: 10},
+	}
+	var imported []string
+	importStep := func(date time.Time, topic string) (*ImportSummary, error) {
+		imported = append(imported, topic)
+		if topic == "user_event" {
+			return nil, errors.New("file not found")
+		}
+		return summaries[topic], nil
+	}
+	config := &ImportWorkflowConfig{
+		Topics:         []string{"user", "product", "user_event"},
+		CheckpointPath: filepath.Join(t.TempDir(), "checkpoint.json"),
+	}
+	workflow, err := NewImportWorkflow(client, config, importStep)
+	if err != nil {
+		t.Fatalf("NewImportWorkflow() error = %v", err)
+	}
+	date := time.Date(2021, 6, 10, 0, 0, 0, 0, time.UTC)
+	results, err := workflow.Run([]time.Time{date})
+	if err == nil || len(results) != 3 {
+		t.Fatalf("Run() = %v, %v", results, err)
+	}
+	if len(client.doneTopics) != 1 || client.doneTopics[0] != "user" {
+		t.Errorf("done topics = %v, want [user]", client.doneTopics)
+	}
+
+	// the finished topic is skipped after resuming from checkpoint
+	imported = nil
+	summaries["product"].FailureCount = 0
+	workflow, err = NewImportWorkflow(client, config, importStep)
+	if err != nil {
+		t.Fatalf("NewImportWorkflow() error = %v", err)
+	}
+	results, _ = workflow.Run([]time.Time{date})
+	if len(imported) != 2 || imported[0] != "product" {
+		t.Errorf("imported topics after resuming = %v, want [product user_event]", imported)
+	}
+	if !results[0].Done || results[0].Summary != nil || !results[1].Done || results[2].Done {
+		t.Errorf("unexpected results after resuming")
+	}
+}
+
+func TestImportWorkflow_Run_failedDate(t *testing.T) {
+	client := &mockDoneClient{}
+	var imported []string
+	importStep := func(date time.Time, topic string) (*ImportSummary, error) {
+		imported = append(imported, topic+"@"+formatDate(date))
+		if topic == "product" && date.Day() == 11 {
+			return nil, errors.New("file not found")
+		}
+		return &ImportSummary{SuccessCount: 100}, nil
+	}
+	workflow, err := NewImportWorkflow(client, &ImportWorkflowConfig{Topics: []string{"user", "product"}}, importStep)
+	if err != nil {
+		t.Fatalf("NewImportWorkflow() error = %v", err)
+	}
+	var dates []time.Time
+	for day := 10; day <= 12; day++ {
+		dates = append(dates, time.Date(2021, 6, day, 0, 0, 0, 0, time.UTC))
+	}
+	results, err := workflow.Run(dates)
+	if err == nil || len(results) != 6 {
+		t.Fatalf("Run() = %v, %v", results, err)
+	}
+	// product is neither imported nor done after the failed date
+	wantImported := []string{"user@2021-06-10", "product@2021-06-10", "user@2021-06-11",
+		"product@2021-06-11", "user@2021-06-12"}
+	if !reflect.DeepEqual(imported, wantImported) {
+		t.Errorf("imported = %v, want %v", imported, wantImported)
+	}
+	wantDone := []string{"user@2021-06-10", "product@2021-06-10", "user@2021-06-11", "user@2021-06-12"}
+	if !reflect.DeepEqual(client.doneDates, wantDone) {
+		t.Errorf("done = %v, want %v", client.doneDates, wantDone)
+	}
+	if last := results[5]; last.Topic != "product" || last.Done || last.Error != "skipped as 2021-06-11 failed" {
+		t.Errorf("result of product on 2021-06-12 = %+v", last)
+	}
+}
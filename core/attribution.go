@@ -0,0 +1,126 @@
+package core
+
+import (
+	"container/list"
+	"sync"
+	"time"
+)
+
+const (
+	defaultAttributionMaxEntries = 100000
+	defaultAttributionTTL        = 30 * time.Minute
+)
+
+// AttributionConfig when it's set, the items returned by Predict are remembered
+// for each user, and the empty fields of the user events written later for these
+// items are filled with the request id, rec_info and scene of the predict request.
+// The events are filled in place, i.e. the request passed to WriteUserEvents is
+// modified
+type AttributionConfig struct {
+	// Max count of remembered user and item pairs, the least recently used one
+	// is evicted when exceeding, default is 100000
+	MaxEntries int
+	// The predict result is used to attribute the events within TTL, default is 30min
+	TTL time.Duration
+}
+
+func fillDefaultAttributionConfig(config *AttributionConfig) *AttributionConfig {
+	if config == nil {
+		return nil
+	}
+	result := *config
+	if result.MaxEntries <= 0 {
+		result.MaxEntries = defaultAttributionMaxEntries
+	}
+	if result.TTL <= 0 {
+		result.TTL = defaultAttributionTTL
+	}
+	return &result
+}
+
+// Attribution is the predict result of an item shown to a user
+type Attribution struct {
+	RequestId  string
+	RecInfo    string
+	SceneName  string
+	PageNumber int32
+	// The rank of the item in the predict result
+	Offset int32
+}
+
+type attributionEntry struct {
+	key         string
+	attribution *Attribution
+	trackTime   time.Time
+}
+
+// AttributionTracker remembers the recent predict results by user and item,
+// it's nil if AttributionConfig is not set
+type AttributionTracker struct {
+	config  *AttributionConfig
+	lock    sync.Mutex
+	entries map[string]*list.Element
+	lru     *list.List
+}
+
+func NewAttributionTracker(config *AttributionConfig) *AttributionTracker {
+	if config == nil {
+		return nil
+	}
+	return &AttributionTracker{
+		config:  config,
+		entries: make(map[string]*list.Element),
+		lru:     list.New(),
+	}
+}
+
+func attributionKey(userId string, itemId string) string {
+	return userId + "\x00" + itemId
+}
+
+// Track remembers the item is shown to the user by the predict request
+func (receiver *AttributionTracker) Track(userId string, itemId string, attribution *Attribution) {
+	if receiver == nil || userId == "" || itemId == "" {
+		return
+	}
+	entry := &attributionEntry{
+		key:         attributionKey(userId, itemId),
+		attribution: attribution,
+		trackTime:   time.Now(),
+	}
+	receiver.lock.Lock()
+	defer receiver.lock.Unlock()
+	if element, exist := receiver.entries[entry.key]; exist {
+		element.Value = entry
+		receiver.lru.MoveToFront(element)
+		return
+	}
+	receiver.entries[entry.key] = receiver.lru.PushFront(entry)
+	for receiver.lru.Len() > receiver.config.MaxEntries {
+		oldest := receiver.lru.Back()
+		receiver.lru.Remove(oldest)
+		delete(receiver.entries, oldest.Value.(*attributionEntry).key)
+	}
+}
+
+// Lookup returns the latest predict result which showed the item to the user
+// within TTL, nil if there is none
+func (receiver *AttributionTracker) Lookup(userId string, itemId string) *Attribution {
+	if receiver == nil || userId == "" || itemId == "" {
+		return nil
+	}
+	key := attributionKey(userId, itemId)
+	receiver.lock.Lock()
+	defer receiver.lock.Unlock()
+	element, exist := receiver.entries[key]
+	if !exist {
+		return nil
+	}
+	entry := element.Value.(*attributionEntry)
+	if time.Since(entry.trackTime) >= receiver.config.TTL {
+		receiver.lru.Remove(element)
+		delete(receiver.entries, key)
+		return nil
+	}
+	return entry.attribution
+}
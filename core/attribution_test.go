@@ -0,0 +1,36 @@
+package core
+
+import (
+	"testing"
+	"time"
+)
+
+func TestAttributionTracker(t *testing.T) {
+	tracker := NewAttributionTracker(fillDefaultAttributionConfig(&AttributionConfig{
+		MaxEntries: 2,
+		TTL:        50 * time.Millisecond,
+	}))
+	tracker.Track("u1", "p1", &Attribution{RequestId: "req-1", Offset: 1})
+	tracker.Track("u1", "p2", &Attribution{RequestId: "req-1", Offset: 2})
+	tracker.Track("u1", "p1", &Attribution{RequestId: "req-2", Offset: 3})
+	if got := tracker.Lookup("u1", "p1"); got == nil || got.RequestId != "req-2" || got.Offset != 3 {
+		t.Errorf("Lookup() = %v, want the latest predict", got)
+	}
+	if got := tracker.Lookup("u2", "p1"); got != nil {
+		t.Errorf("Lookup() of another user = %v", got)
+	}
+	tracker.Track("u1", "p3", &Attribution{RequestId: "req-3"})
+	// p2 is evicted as the least recently used
+	if got := tracker.Lookup("u1", "p2"); got != nil {
+		t.Errorf("Lookup() of evicted item = %v", got)
+	}
+	time.Sleep(60 * time.Millisecond)
+	if got := tracker.Lookup("u1", "p3"); got != nil {
+		t.Errorf("Lookup() of expired item = %v", got)
+	}
+	var nilTracker *AttributionTracker
+	nilTracker.Track("u1", "p1", &Attribution{})
+	if got := nilTracker.Lookup("u1", "p1"); got != nil {
+		t.Errorf("nil AttributionTracker Lookup() = %v", got)
+	}
+}
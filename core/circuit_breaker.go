@@ -0,0 +1,295 @@
+package core
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/byteplus-sdk/sdk-go/core/logs"
+	"github.com/byteplus-sdk/sdk-go/core/metrics"
+)
+
+const (
+	defaultCircuitErrorRateThreshold = 0.5
+	defaultCircuitWindowSize         = 100
+	defaultCircuitMinimumCalls       = 20
+	defaultCircuitOpenDuration       = 10 * time.Second
+	defaultCircuitHalfOpenCalls      = 5
+)
+
+type CircuitState int
+
+const (
+	// CircuitClosed requests are sent normally
+	CircuitClosed CircuitState = iota
+	// CircuitOpen requests fail fast with CircuitOpenError, or are sent to another host
+	CircuitOpen
+	// CircuitHalfOpen a limited number of requests are sent to detect whether the host has recovered
+	CircuitHalfOpen
+)
+
+func (s CircuitState) String() string {
+	switch s {
+	case CircuitOpen:
+		return "open"
+	case CircuitHalfOpen:
+		return "half_open"
+	default:
+		return "closed"
+	}
+}
+
+// CircuitBreakerConfig circuit breakers are isolated by host and endpoint
+// class (predict, write, import, callback), the rates are calculated in a
+// window of the most recent calls. Only transport errors, 5xx and 429 are
+// counted as errors, other 4xx are caused by the requests rather than the host
+type CircuitBreakerConfig struct {
+	// The circuit opens when the error rate reaches it, default is 0.5
+	ErrorRateThreshold float64
+	// Optional, a call costs longer than it is regarded as a slow call,
+	// slow calls are not checked if it's not set
+	SlowCallDuration time.Duration
+	// Optional, the circuit opens when the slow call rate reaches it, default is 1
+	SlowCallRateThreshold float64
+	// Count of recent calls used to calculate rates, default is 100
+	WindowSize int
+	// The rates are not checked until the window has this count of calls, default is 20
+	MinimumCalls int
+	// How long the circuit stays open before turning to half-open, default is 10s
+	OpenDuration time.Duration
+	// Count of calls permitted in half-open state, the circuit is closed
+	// after all of them succeed, default is 5
+	HalfOpenCalls int
+}
+
+func fillDefaultCircuitBreakerConfig(config *CircuitBreakerConfig) *CircuitBreakerConfig {
+	if config == nil {
+		return nil
+	}
+	if config.ErrorRateThreshold <= 0 {
+		config.ErrorRateThreshold = defaultCircuitErrorRateThreshold
+	}
+	if config.SlowCallRateThreshold <= 0 {
+		config.SlowCallRateThreshold = 1
+	}
+	if config.WindowSize <= 0 {
+		config.WindowSize = defaultCircuitWindowSize
+	}
+	if config.MinimumCalls <= 0 {
+		config.MinimumCalls = defaultCircuitMinimumCalls
+	}
+	if config.MinimumCalls > config.WindowSize {
+		config.MinimumCalls = config.WindowSize
+	}
+	if config.OpenDuration <= 0 {
+		config.OpenDuration = defaultCircuitOpenDuration
+	}
+	if config.HalfOpenCalls <= 0 {
+		config.HalfOpenCalls = defaultCircuitHalfOpenCalls
+	}
+	return config
+}
+
+// CircuitOpenError is returned when the circuits of all hosts are open for the request
+type CircuitOpenError struct {
+	Host     string
+	Endpoint string
+}
+
+func (e *CircuitOpenError) Error() string {
+	return fmt.Sprintf("circuit breaker is open, host:%s endpoint:%s", e.Host, e.Endpoint)
+}
+
+func IsCircuitOpenError(err error) bool {
+	_, ok := err.(*CircuitOpenError)
+	return ok
+}
+
+func newCircuitBreakers(config *CircuitBreakerConfig, tenant string) *circuitBreakers {
+	return &circuitBreakers{
+		config:   config,
+		tenant:   tenant,
+		breakers: make(map[string]*circuitBreaker),
+	}
+}
+
+type circuitBreakers struct {
+	config   *CircuitBreakerConfig
+	tenant   string
+	lock     sync.Mutex
+	breakers map[string]*circuitBreaker
+}
+
+// get returns nil when circuit breaker is disabled
+func (r *circuitBreakers) get(host string, endpoint string) *circuitBreaker {
+	if r == nil {
+		return nil
+	}
+	key := host + "|" + endpoint
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	breaker, exist := r.breakers[key]
+	if !exist {
+		breaker = &circuitBreaker{
+			config:   r.config,
+			tenant:   r.tenant,
+			host:     host,
+			endpoint: endpoint,
+			calls:    make([]callResult, r.config.WindowSize),
+		}
+		r.breakers[key] = breaker
+	}
+	return breaker
+}
+
+func (r *circuitBreakers) isOpen(host string, endpoint string) bool {
+	return r.get(host, endpoint).currentState() == CircuitOpen
+}
+
+type callResult struct {
+	failed bool
+	slow   bool
+}
+
+type circuitBreaker struct {
+	config   *CircuitBreakerConfig
+	tenant   string
+	host     string
+	endpoint string
+	lock     sync.Mutex
+	state    CircuitState
+	openedAt time.Time
+	// ring buffer of the recent calls in closed state
+	calls       []callResult
+	next        int
+	count       int
+	failedCount int
+	slowCount   int
+	// calls permitted and succeeded in half-open state
+	halfOpenPermitted int
+	halfOpenSucceeded int
+}
+
+func (b *circuitBreaker) currentState() CircuitState {
+	if b == nil {
+		return CircuitClosed
+	}
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	if b.state == CircuitOpen && time.Now().Sub(b.openedAt) >= b.config.OpenDuration {
+		return CircuitHalfOpen
+	}
+	return b.state
+}
+
+// allow reports whether a call is permitted, every permitted call
+// should be followed by a `record`, or a `cancel` if its result is unknown
+func (b *circuitBreaker) allow() bool {
+	if b == nil {
+		return true
+	}
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	if b.state == CircuitOpen {
+		if time.Now().Sub(b.openedAt) < b.config.OpenDuration {
+			return false
+		}
+		b.transitTo(CircuitHalfOpen)
+	}
+	if b.state == CircuitHalfOpen {
+		if b.halfOpenPermitted >= b.config.HalfOpenCalls {
+			return false
+		}
+		b.halfOpenPermitted++
+	}
+	return true
+}
+
+func (b *circuitBreaker) record(success bool, cost time.Duration) {
+	if b == nil {
+		return
+	}
+	slow := b.config.SlowCallDuration > 0 && cost >= b.config.SlowCallDuration
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	switch b.state {
+	case CircuitHalfOpen:
+		if !success || slow {
+			b.transitTo(CircuitOpen)
+			return
+		}
+		b.halfOpenSucceeded++
+		if b.halfOpenSucceeded >= b.config.HalfOpenCalls {
+			b.transitTo(CircuitClosed)
+		}
+	case CircuitClosed:
+		b.putCall(callResult{failed: !success, slow: slow})
+		if b.count < b.config.MinimumCalls {
+			return
+		}
+		errorRate := float64(b.failedCount) / float64(b.count)
+		slowRate := float64(b.slowCount) / float64(b.count)
+		if errorRate >= b.config.ErrorRateThreshold || slowRate >= b.config.SlowCallRateThreshold {
+			logs.Warn("circuit breaker open, host:%s endpoint:%s error rate:%.2f slow rate:%.2f",
+				b.host, b.endpoint, errorRate, slowRate)
+			b.transitTo(CircuitOpen)
+		}
+	}
+}
+
+// cancel gives up a permitted call without recording it, e.g. the call is abandoned
+// before its response, so that it's neither a success nor a failure of the host,
+// and the permit in half-open state is returned for another call
+func (b *circuitBreaker) cancel() {
+	if b == nil {
+		return
+	}
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	if b.state == CircuitHalfOpen && b.halfOpenPermitted > 0 {
+		b.halfOpenPermitted--
+	}
+}
+
+func (b *circuitBreaker) putCall(call callResult) {
+	if b.count == len(b.calls) {
+		removing := b.calls[b.next]
+		if removing.failed {
+			b.failedCount--
+		}
+		if removing.slow {
+			b.slowCount--
+		}
+	} else {
+		b.count++
+	}
+	b.calls[b.next] = call
+	b.next = (b.next + 1) % len(b.calls)
+	if call.failed {
+		b.failedCount++
+	}
+	if call.slow {
+		b.slowCount++
+	}
+}
+
+func (b *circuitBreaker) transitTo(state CircuitState) {
+	logs.Info("circuit breaker turns from %s to %s, host:%s endpoint:%s",
+		b.state, state, b.host, b.endpoint)
+	b.state = state
+	b.halfOpenPermitted = 0
+	b.halfOpenSucceeded = 0
+	switch state {
+	case CircuitOpen:
+		b.openedAt = time.Now()
+	case CircuitClosed:
+		b.next, b.count, b.failedCount, b.slowCount = 0, 0, 0, 0
+	}
+	metricsTags := []string{
+		"state:" + state.String(),
+		"tenant:" + b.tenant,
+		"host:" + b.host,
+		"endpoint:" + b.endpoint,
+	}
+	metrics.Counter(metricsKeyCircuitBreakerTransition, 1, metricsTags...)
+}
@@ -0,0 +1,130 @@
+package core
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func Test_endpointOf(t *testing.T) {
+	tests := []struct {
+		url  string
+		want string
+	}{
+		{url: "https://host/predict/api/retail/demo/home?stage=pre", want: EndpointPredict},
+		{url: "https://host/predict/api/retail/demo/ack_server_impressions", want: EndpointCallback},
+		{url: "https://host/predict/api/demo/callback", want: EndpointCallback},
+		{url: "https://host/data/api/retail/demo/user?method=write", want: EndpointWrite},
+		{url: "https://host/data/api/retail/demo/user_event?method=import", want: EndpointImport},
+		{url: "https://host/data/api/demo/operation?method=get", want: EndpointOther},
+		{url: "https://host/data/api/demo/done?topic=user", want: EndpointOther},
+	}
+	for _, tt := range tests {
+		t.Run(tt.url, func(t *testing.T) {
+			if got := endpointOf(tt.url); got != tt.want {
+				t.Errorf("endpointOf() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCircuitBreaker(t *testing.T) {
+	breakers := newCircuitBreakers(fillDefaultCircuitBreakerConfig(&CircuitBreakerConfig{
+		ErrorRateThreshold: 0.5,
+		WindowSize:         10,
+		MinimumCalls:       4,
+		OpenDuration:       50 * time.Millisecond,
+		HalfOpenCalls:      2,
+	}), "demo")
+	breaker := breakers.get("host-a", EndpointPredict)
+	if breakers.get("host-a", EndpointWrite) == breaker {
+		t.Fatalf("breakers of different endpoints should be isolated")
+	}
+	for i := 0; i < 3; i++ {
+		breaker.allow()
+		breaker.record(false, time.Millisecond)
+	}
+	if state := breaker.currentState(); state != CircuitClosed {
+		t.Fatalf("state before reaching minimum calls = %v, want closed", state)
+	}
+	breaker.allow()
+	breaker.record(true, time.Millisecond)
+	if !breakers.isOpen("host-a", EndpointPredict) || breaker.allow() {
+		t.Fatalf("breaker should be open after error rate reaches threshold")
+	}
+
+	time.Sleep(60 * time.Millisecond)
+	if !breaker.allow() || !breaker.allow() || breaker.allow() {
+		t.Fatalf("half-open breaker should permit exactly %d calls", 2)
+	}
+	breaker.record(true, time.Millisecond)
+	breaker.record(true, time.Millisecond)
+	if state := breaker.currentState(); state != CircuitClosed {
+		t.Fatalf("state after half-open calls succeed = %v, want closed", state)
+	}
+
+	var nilBreakers *circuitBreakers
+	if nilBreakers.isOpen("host-a", EndpointPredict) || !nilBreakers.get("host-a", EndpointPredict).allow() {
+		t.Errorf("disabled circuit breaker should permit all calls")
+	}
+}
+
+func TestCircuitBreaker_cancel(t *testing.T) {
+	breakers := newCircuitBreakers(fillDefaultCircuitBreakerConfig(&CircuitBreakerConfig{
+		ErrorRateThreshold: 0.5,
+		WindowSize:         10,
+		MinimumCalls:       2,
+		OpenDuration:       10 * time.Millisecond,
+		HalfOpenCalls:      1,
+	}), "demo")
+	breaker := breakers.get("host-a", EndpointPredict)
+	// the cancelled calls don't dilute the error rate
+	for i := 0; i < 3; i++ {
+		breaker.allow()
+		breaker.cancel()
+	}
+	breaker.allow()
+	breaker.record(false, time.Millisecond)
+	breaker.allow()
+	breaker.record(true, time.Millisecond)
+	if !breakers.isOpen("host-a", EndpointPredict) {
+		t.Fatalf("breaker should be open as the cancelled calls aren't recorded")
+	}
+
+	time.Sleep(20 * time.Millisecond)
+	if !breaker.allow() || breaker.allow() {
+		t.Fatalf("half-open breaker should permit exactly 1 call")
+	}
+	// the permit of the cancelled call is returned
+	breaker.cancel()
+	if !breaker.allow() {
+		t.Fatalf("half-open breaker should permit another call after cancel")
+	}
+	breaker.record(true, time.Millisecond)
+	if state := breaker.currentState(); state != CircuitClosed {
+		t.Errorf("state = %v, want closed", state)
+	}
+}
+
+func Test_isHostFailure(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{name: "success", err: nil, want: false},
+		{name: "transport_error", err: &transportError{err: errors.New("connection refused")}, want: true},
+		{name: "internal_server_error", err: &httpStatusError{code: 500}, want: true},
+		{name: "service_unavailable", err: &httpStatusError{code: 503}, want: true},
+		{name: "too_many_requests", err: &httpStatusError{code: 429}, want: true},
+		{name: "bad_request", err: &httpStatusError{code: 400}, want: false},
+		{name: "unauthorized", err: &httpStatusError{code: 401}, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := isHostFailure(tt.err); got != tt.want {
+				t.Errorf("isHostFailure() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -52,4 +52,11 @@ const (
 	metricsKeyCommonError      = "common.err"
 	metricsKeyRequestTotalCost = "request.total.cost"
 	metricsKeyRequestCount     = "request.count"
+	metricsKeyHedgeCount       = "request.hedge.count"
+
+	metricsKeyCircuitBreakerTransition = "circuit.breaker.transition"
+
+	metricsKeyShadowPredictCount           = "predict.shadow.count"
+	metricsKeyShadowPredictJaccard         = "predict.shadow.jaccard"
+	metricsKeyShadowPredictRankCorrelation = "predict.shadow.rank.correlation"
 )
@@ -9,19 +9,27 @@ import (
 )
 
 type ContextParam struct {
-	Tenant               string
-	TenantId             string
-	Token                string
-	AK                   string
-	SK                   string
-	Schema               string
-	HostHeader           string
-	Hosts                []string
-	Headers              map[string]string
-	Region               Region
-	UseAirAuth           bool
-	MetricsConfig        *metrics.Config
-	HostAvailablerConfig *HostAvailablerConfig
+	Tenant                string
+	TenantId              string
+	Token                 string
+	AK                    string
+	SK                    string
+	Schema                string
+	HostHeader            string
+	Hosts                 []string
+	Headers               map[string]string
+	Region                Region
+	UseAirAuth            bool
+	MetricsConfig         *metrics.Config
+	HostAvailablerConfig  *HostAvailablerConfig
+	HedgeConfig           *HedgeConfig
+	CircuitBreakerConfig  *CircuitBreakerConfig
+	RateLimitConfig       *RateLimitConfig
+	StrictStatusConfig    *StrictStatusConfig
+	PredictCacheConfig    *PredictCacheConfig
+	PredictFallbackConfig *PredictFallbackConfig
+	AttributionConfig     *AttributionConfig
+	DeadLetterSink        DeadLetterSink
 }
 
 func (receiver *ContextParam) checkRequiredField(param *ContextParam) error {
@@ -58,16 +66,24 @@ func NewContext(param *ContextParam) (*Context, error) {
 		return nil, err
 	}
 	result := &Context{
-		tenant:               param.Tenant,
-		tenantId:             param.TenantId,
-		token:                param.Token,
-		schema:               param.Schema,
-		hostHeader:           param.HostHeader,
-		hosts:                param.Hosts,
-		customerHeaders:      param.Headers,
-		useAirAuth:           param.UseAirAuth,
-		metricsConfig:        param.MetricsConfig,
-		hostAvailablerConfig: param.HostAvailablerConfig,
+		tenant:                param.Tenant,
+		tenantId:              param.TenantId,
+		token:                 param.Token,
+		schema:                param.Schema,
+		hostHeader:            param.HostHeader,
+		hosts:                 param.Hosts,
+		customerHeaders:       param.Headers,
+		useAirAuth:            param.UseAirAuth,
+		metricsConfig:         param.MetricsConfig,
+		hostAvailablerConfig:  param.HostAvailablerConfig,
+		hedgeConfig:           fillDefaultHedgeConfig(param.HedgeConfig),
+		circuitBreakerConfig:  fillDefaultCircuitBreakerConfig(param.CircuitBreakerConfig),
+		rateLimitConfig:       fillDefaultRateLimitConfig(param.RateLimitConfig),
+		strictStatusConfig:    param.StrictStatusConfig,
+		predictCacheConfig:    fillDefaultPredictCacheConfig(param.PredictCacheConfig),
+		predictFallbackConfig: param.PredictFallbackConfig,
+		attributionConfig:     fillDefaultAttributionConfig(param.AttributionConfig),
+		deadLetterSink:        param.DeadLetterSink,
 	}
 	result.fillHosts(param)
 	result.fillVolcCredentials(param)
@@ -123,6 +139,34 @@ type Context struct {
 	metricsConfig *metrics.Config
 
 	hostAvailablerConfig *HostAvailablerConfig
+
+	// hedging is disabled when it's nil
+	hedgeConfig *HedgeConfig
+
+	// circuit breaker is disabled when it's nil
+	circuitBreakerConfig *CircuitBreakerConfig
+
+	// rate limit is disabled when it's nil
+	rateLimitConfig *RateLimitConfig
+
+	// response status is not checked when it's nil
+	strictStatusConfig *StrictStatusConfig
+
+	// predict responses are not cached when it's nil
+	predictCacheConfig *PredictCacheConfig
+
+	// failed predict requests have no fallback when it's nil
+	predictFallbackConfig *PredictFallbackConfig
+
+	// user events are not attributed to predict requests when it's nil
+	attributionConfig *AttributionConfig
+
+	// the items failed in Write methods are dropped when it's nil
+	deadLetterSink DeadLetterSink
+
+	// set when HostAvailabler is created, HTTPCaller use it to choose host
+	// when load balance is enabled
+	hostAvailabler *HostAvailabler
 }
 
 func (receiver *Context) Tenant() string {
@@ -177,6 +221,38 @@ func (receiver *Context) HostAvailablerConfig() *HostAvailablerConfig {
 	return receiver.hostAvailablerConfig
 }
 
+func (receiver *Context) HedgeConfig() *HedgeConfig {
+	return receiver.hedgeConfig
+}
+
+func (receiver *Context) CircuitBreakerConfig() *CircuitBreakerConfig {
+	return receiver.circuitBreakerConfig
+}
+
+func (receiver *Context) RateLimitConfig() *RateLimitConfig {
+	return receiver.rateLimitConfig
+}
+
+func (receiver *Context) StrictStatusConfig() *StrictStatusConfig {
+	return receiver.strictStatusConfig
+}
+
+func (receiver *Context) PredictCacheConfig() *PredictCacheConfig {
+	return receiver.predictCacheConfig
+}
+
+func (receiver *Context) PredictFallbackConfig() *PredictFallbackConfig {
+	return receiver.predictFallbackConfig
+}
+
+func (receiver *Context) AttributionConfig() *AttributionConfig {
+	return receiver.attributionConfig
+}
+
+func (receiver *Context) DeadLetterSink() DeadLetterSink {
+	return receiver.deadLetterSink
+}
+
 func (receiver *Context) fillHosts(param *ContextParam) {
 	if len(param.Hosts) > 0 {
 		receiver.hosts = param.Hosts
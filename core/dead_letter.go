@@ -0,0 +1,383 @@
+package core
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/byteplus-sdk/sdk-go/core/logs"
+	"github.com/byteplus-sdk/sdk-go/core/option"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+)
+
+type DeadLetterFormat int
+
+const (
+	// DeadLetterFormatJSON writes one json object per line (JSON Lines)
+	DeadLetterFormatJSON DeadLetterFormat = iota
+	// DeadLetterFormatProtobuf writes varint length-delimited protobuf records
+	DeadLetterFormatProtobuf
+)
+
+// DeadLetterTypeJSON is the Type of dead letter whose Item isn't a proto message,
+// e.g. the data of general solution
+const DeadLetterTypeJSON = "json"
+
+// field numbers of the protobuf record of dead letter
+const (
+	deadLetterFieldType protowire.Number = iota + 1
+	deadLetterFieldTopic
+	deadLetterFieldItem
+	deadLetterFieldError
+	deadLetterFieldRequestId
+	deadLetterFieldTime
+)
+
+var errIncompleteDeadLetter = errors.New("incomplete dead letter record")
+
+// DeadLetter is an item failed to be written permanently
+type DeadLetter struct {
+	// Full name of the proto message of Item, e.g. "bytedance.byteplus.retail.User",
+	// or DeadLetterTypeJSON for the data of general solution
+	Type string
+	// Topic of the data of general solution, empty for other solutions
+	Topic string
+	// The original item, e.g. *retail/protocol.User, or map[string]interface{}
+	// of general solution
+	Item      interface{}
+	Error     string
+	RequestId string
+	Time      time.Time
+}
+
+// DeadLetterSink stores the items failed to be written permanently,
+// it may be called concurrently
+type DeadLetterSink interface {
+	Write(letters []*DeadLetter) error
+}
+
+func newDeadLetters(failed []*FailedItem) []*DeadLetter {
+	now := time.Now()
+	letters := make([]*DeadLetter, 0, len(failed))
+	for _, item := range failed {
+		letters = append(letters, &DeadLetter{
+			Type:      deadLetterType(item.Item),
+			Item:      item.Item,
+			Error:     item.Message,
+			RequestId: item.RequestId,
+			Time:      now,
+		})
+	}
+	return letters
+}
+
+func deadLetterType(item interface{}) string {
+	if message, ok := item.(proto.Message); ok {
+		return string(message.ProtoReflect().Descriptor().FullName())
+	}
+	return DeadLetterTypeJSON
+}
+
+// FileDeadLetterSink appends dead letters to a local file
+type FileDeadLetterSink struct {
+	lock   sync.Mutex
+	file   *os.File
+	format DeadLetterFormat
+}
+
+func NewFileDeadLetterSink(path string, format DeadLetterFormat) (*FileDeadLetterSink, error) {
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return nil, err
+	}
+	return &FileDeadLetterSink{file: file, format: format}, nil
+}
+
+func (receiver *FileDeadLetterSink) Write(letters []*DeadLetter) error {
+	var buf []byte
+	for _, letter := range letters {
+		record, err := encodeDeadLetter(letter, receiver.format)
+		if err != nil {
+			return err
+		}
+		buf = append(buf, record...)
+	}
+	receiver.lock.Lock()
+	defer receiver.lock.Unlock()
+	_, err := receiver.file.Write(buf)
+	return err
+}
+
+func (receiver *FileDeadLetterSink) Close() error {
+	receiver.lock.Lock()
+	defer receiver.lock.Unlock()
+	return receiver.file.Close()
+}
+
+// ReadDeadLetters reads the dead letters written by FileDeadLetterSink, the proto
+// message of Item is found by Type, so the protocol package of the solution
+// must be imported
+func ReadDeadLetters(path string, format DeadLetterFormat) ([]*DeadLetter, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var letters []*DeadLetter
+	for len(data) > 0 {
+		var letter *DeadLetter
+		var size int
+		if format == DeadLetterFormatProtobuf {
+			letter, size, err = decodeProtobufDeadLetter(data)
+		} else {
+			letter, size, err = decodeJSONDeadLetter(data)
+		}
+		if err != nil {
+			return letters, fmt.Errorf("read dead letter %d fail, %w", len(letters), err)
+		}
+		if letter != nil {
+			letters = append(letters, letter)
+		}
+		data = data[size:]
+	}
+	return letters, nil
+}
+
+type jsonDeadLetter struct {
+	Type      string          `json:"type"`
+	Topic     string          `json:"topic,omitempty"`
+	Item      json.RawMessage `json:"item"`
+	Error     string          `json:"error"`
+	RequestId string          `json:"request_id"`
+	Time      time.Time       `json:"time"`
+}
+
+func encodeDeadLetter(letter *DeadLetter, format DeadLetterFormat) ([]byte, error) {
+	item, err := encodeDeadLetterItem(letter, format)
+	if err != nil {
+		return nil, err
+	}
+	if format != DeadLetterFormatProtobuf {
+		record, err := json.Marshal(&jsonDeadLetter{
+			Type:      letter.Type,
+			Topic:     letter.Topic,
+			Item:      item,
+			Error:     letter.Error,
+			RequestId: letter.RequestId,
+			Time:      letter.Time,
+		})
+		if err != nil {
+			return nil, err
+		}
+		return append(record, '\n'), nil
+	}
+	var record []byte
+	record = appendStringField(record, deadLetterFieldType, letter.Type)
+	record = appendStringField(record, deadLetterFieldTopic, letter.Topic)
+	record = protowire.AppendTag(record, deadLetterFieldItem, protowire.BytesType)
+	record = protowire.AppendBytes(record, item)
+	record = appendStringField(record, deadLetterFieldError, letter.Error)
+	record = appendStringField(record, deadLetterFieldRequestId, letter.RequestId)
+	record = protowire.AppendTag(record, deadLetterFieldTime, protowire.VarintType)
+	record = protowire.AppendVarint(record, uint64(letter.Time.UnixNano()))
+	return protowire.AppendBytes(nil, record), nil
+}
+
+func appendStringField(record []byte, number protowire.Number, value string) []byte {
+	if value == "" {
+		return record
+	}
+	record = protowire.AppendTag(record, number, protowire.BytesType)
+	return protowire.AppendString(record, value)
+}
+
+func encodeDeadLetterItem(letter *DeadLetter, format DeadLetterFormat) ([]byte, error) {
+	message, ok := letter.Item.(proto.Message)
+	if !ok {
+		return json.Marshal(letter.Item)
+	}
+	if format == DeadLetterFormatProtobuf {
+		return proto.Marshal(message)
+	}
+	return protojson.Marshal(message)
+}
+
+func decodeJSONDeadLetter(data []byte) (*DeadLetter, int, error) {
+	size := bytes.IndexByte(data, '\n') + 1
+	if size == 0 {
+		size = len(data)
+	}
+	line := bytes.TrimSpace(data[:size])
+	if len(line) == 0 {
+		return nil, size, nil
+	}
+	record := &jsonDeadLetter{}
+	if err := json.Unmarshal(line, record); err != nil {
+		return nil, 0, err
+	}
+	letter := &DeadLetter{
+		Type:      record.Type,
+		Topic:     record.Topic,
+		Error:     record.Error,
+		RequestId: record.RequestId,
+		Time:      record.Time,
+	}
+	var err error
+	letter.Item, err = decodeDeadLetterItem(letter.Type, record.Item, DeadLetterFormatJSON)
+	if err != nil {
+		return nil, 0, err
+	}
+	return letter, size, nil
+}
+
+func decodeProtobufDeadLetter(data []byte) (*DeadLetter, int, error) {
+	record, size := protowire.ConsumeBytes(data)
+	if size < 0 {
+		return nil, 0, errIncompleteDeadLetter
+	}
+	letter := &DeadLetter{}
+	var item []byte
+	for len(record) > 0 {
+		number, wireType, tagSize := protowire.ConsumeTag(record)
+		if tagSize < 0 {
+			return nil, 0, errIncompleteDeadLetter
+		}
+		record = record[tagSize:]
+		var valueSize int
+		switch {
+		case wireType == protowire.BytesType:
+			var value []byte
+			value, valueSize = protowire.ConsumeBytes(record)
+			switch number {
+			case deadLetterFieldType:
+				letter.Type = string(value)
+			case deadLetterFieldTopic:
+				letter.Topic = string(value)
+			case deadLetterFieldItem:
+				item = value
+			case deadLetterFieldError:
+				letter.Error = string(value)
+			case deadLetterFieldRequestId:
+				letter.RequestId = string(value)
+			}
+		case wireType == protowire.VarintType && number == deadLetterFieldTime:
+			var value uint64
+			value, valueSize = protowire.ConsumeVarint(record)
+			letter.Time = time.Unix(0, int64(value))
+		default:
+			valueSize = protowire.ConsumeFieldValue(number, wireType, record)
+		}
+		if valueSize < 0 {
+			return nil, 0, errIncompleteDeadLetter
+		}
+		record = record[valueSize:]
+	}
+	var err error
+	letter.Item, err = decodeDeadLetterItem(letter.Type, item, DeadLetterFormatProtobuf)
+	if err != nil {
+		return nil, 0, err
+	}
+	return letter, size, nil
+}
+
+func decodeDeadLetterItem(letterType string, data []byte, format DeadLetterFormat) (interface{}, error) {
+	if letterType == DeadLetterTypeJSON {
+		decoder := json.NewDecoder(bytes.NewReader(data))
+		// keep the precision of big integers, e.g. id
+		decoder.UseNumber()
+		var item interface{}
+		if err := decoder.Decode(&item); err != nil {
+			return nil, err
+		}
+		return item, nil
+	}
+	messageType, err := protoregistry.GlobalTypes.FindMessageByName(protoreflect.FullName(letterType))
+	if err != nil {
+		return nil, err
+	}
+	message := messageType.New().Interface()
+	if format == DeadLetterFormatProtobuf {
+		err = proto.Unmarshal(data, message)
+	} else {
+		err = protojson.Unmarshal(data, message)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return message, nil
+}
+
+// ResubmitDeadLetters writes the items of letters by `write` once without retry,
+// at most MaxWriteItemCount items in a request, and returns the dead letters of
+// the items still failed. The returned error is the last error of the requests.
+// The "Request-Id" of each request is requestIdPrefix followed by a generated id
+func ResubmitDeadLetters(letters []*DeadLetter, requestIdPrefix string, write PartialWriteFunc,
+	failedItems FailedItemsFunc) ([]*DeadLetter, error) {
+	var stillFailed []*DeadLetter
+	var lastErr error
+	for start := 0; start < len(letters); start += MaxWriteItemCount {
+		end := start + MaxWriteItemCount
+		if end > len(letters) {
+			end = len(letters)
+		}
+		batch := letters[start:end]
+		items := make([]interface{}, 0, len(batch))
+		for _, letter := range batch {
+			items = append(items, letter.Item)
+		}
+		failed, err := writeOnce(items, requestIdPrefix, write, failedItems, nil)
+		if err != nil {
+			lastErr = err
+		}
+		for _, letter := range newDeadLetters(failed) {
+			letter.Topic = batch[0].Topic
+			stillFailed = append(stillFailed, letter)
+		}
+	}
+	return stillFailed, lastErr
+}
+
+// PrepareDeadLetters sets a generated "Request-Id" to options if it's not set
+// and the failed items of the request will be written to sink, so that their
+// dead letters can record it
+func PrepareDeadLetters(sink DeadLetterSink, options *option.Options) {
+	if sink != nil && !options.SkipDeadLetter && options.RequestId == "" {
+		options.RequestId = uuid.NewString()
+	}
+}
+
+// WriteDeadLetters writes the items failed permanently in a request of Write
+// methods to sink, i.e. the items which are not retryable by RetryPartialFailures
+// with the default RetryablePatterns, as the caller gets the error of the request
+// and usually retries the retryable ones, e.g. timeouts and 5xx. It does nothing
+// if sink is nil or the request is sent with option.WithoutDeadLetter(), and the
+// items are only built by `items` otherwise
+func WriteDeadLetters(sink DeadLetterSink, options *option.Options, items func() []interface{},
+	response proto.Message, err error, failedItems FailedItemsFunc) {
+	if sink == nil || options.SkipDeadLetter {
+		return
+	}
+	failed, _ := checkWriteResult(items(), response, err, failedItems, defaultRetryableRegexps)
+	permanent := make([]*FailedItem, 0, len(failed))
+	for _, item := range failed {
+		if item.Retryable {
+			continue
+		}
+		item.RequestId = options.RequestId
+		permanent = append(permanent, item)
+	}
+	if len(permanent) == 0 {
+		return
+	}
+	if err = sink.Write(newDeadLetters(permanent)); err != nil {
+		logs.Error("write %d dead letters fail, err:%s", len(permanent), err.Error())
+	}
+}
@@ -0,0 +1,158 @@
+package core
+
+import (
+	"encoding/json"
+	"errors"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/byteplus-sdk/sdk-go/common/protocol"
+	"github.com/byteplus-sdk/sdk-go/core/option"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestFileDeadLetterSink(t *testing.T) {
+	formats := []struct {
+		name   string
+		format DeadLetterFormat
+	}{
+		{name: "json", format: DeadLetterFormatJSON},
+		{name: "protobuf", format: DeadLetterFormatProtobuf},
+	}
+	for _, tt := range formats {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "dead_letters")
+			sink, err := NewFileDeadLetterSink(path, tt.format)
+			if err != nil {
+				t.Fatalf("NewFileDeadLetterSink() error = %v", err)
+			}
+			letters := newDeadLetters([]*FailedItem{
+				{Item: &protocol.Status{Code: 400, Message: "bad"}, Message: "invalid", RequestId: "r1"},
+				{Item: map[string]interface{}{"id": 12345678901234567}, Message: "invalid", RequestId: "r2"},
+			})
+			letters[1].Topic = "user"
+			if err = sink.Write(letters[:1]); err != nil {
+				t.Fatalf("Write() error = %v", err)
+			}
+			if err = sink.Write(letters[1:]); err != nil {
+				t.Fatalf("Write() error = %v", err)
+			}
+			_ = sink.Close()
+
+			got, err := ReadDeadLetters(path, tt.format)
+			if err != nil || len(got) != 2 {
+				t.Fatalf("ReadDeadLetters() = %v, %v", got, err)
+			}
+			if !proto.Equal(got[0].Item.(proto.Message), letters[0].Item.(proto.Message)) ||
+				got[0].RequestId != "r1" || got[0].Error != "invalid" || !got[0].Time.Equal(letters[0].Time) {
+				t.Errorf("unexpected proto dead letter %+v", got[0])
+			}
+			data, ok := got[1].Item.(map[string]interface{})
+			if !ok || got[1].Type != DeadLetterTypeJSON || got[1].Topic != "user" ||
+				data["id"] != json.Number("12345678901234567") {
+				t.Errorf("unexpected json dead letter %+v", got[1])
+			}
+		})
+	}
+}
+
+type memoryDeadLetterSink struct {
+	letters []*DeadLetter
+}
+
+func (m *memoryDeadLetterSink) Write(letters []*DeadLetter) error {
+	m.letters = append(m.letters, letters...)
+	return nil
+}
+
+func TestRetryPartialFailures_deadLetter(t *testing.T) {
+	var requestIds []string
+	write := func(items []interface{}, requestId string) (proto.Message, error) {
+		requestIds = append(requestIds, requestId)
+		return &protocol.Status{Code: StatusCodeSuccess}, nil
+	}
+	failedItems := func(items []interface{}, response proto.Message) []*FailedItem {
+		return []*FailedItem{{Item: "a", Message: "timeout"}}
+	}
+	sink := &memoryDeadLetterSink{}
+	config := &PartialRetryConfig{MaxRetries: 1, InitialBackoff: time.Millisecond, DeadLetterSink: sink}
+	if _, err := RetryPartialFailures(config, []interface{}{"a"}, write, failedItems); err != nil {
+		t.Fatalf("RetryPartialFailures() error = %v", err)
+	}
+	if len(requestIds) != 2 || requestIds[0] == requestIds[1] {
+		t.Errorf("request ids = %v, want 2 different ids", requestIds)
+	}
+	if len(sink.letters) != 1 || sink.letters[0].RequestId != requestIds[1] || sink.letters[0].Item != "a" {
+		t.Errorf("unexpected dead letters %+v", sink.letters)
+	}
+}
+
+func TestRetryPartialFailures_requestIdPrefix(t *testing.T) {
+	var requestIds []string
+	write := func(items []interface{}, requestId string) (proto.Message, error) {
+		requestIds = append(requestIds, requestId)
+		return &protocol.Status{Code: StatusCodeSuccess}, nil
+	}
+	noFailedItems := func(items []interface{}, response proto.Message) []*FailedItem {
+		return nil
+	}
+	if RetryConfigWithRequestId(nil, nil) != nil {
+		t.Errorf("RetryConfigWithRequestId() without request id should return config")
+	}
+	config := RetryConfigWithRequestId(nil, []option.Option{option.WithRequestId("caller")})
+	if _, err := RetryPartialFailures(config, []interface{}{"a"}, write, noFailedItems); err != nil {
+		t.Fatalf("RetryPartialFailures() error = %v", err)
+	}
+	if len(requestIds) != 1 || !strings.HasPrefix(requestIds[0], "caller-") || len(requestIds[0]) <= len("caller-") {
+		t.Errorf("request ids = %v, want prefixed by caller id", requestIds)
+	}
+}
+
+func TestWriteDeadLetters(t *testing.T) {
+	items := func() []interface{} {
+		return []interface{}{"a", "b"}
+	}
+	failedItems := func(items []interface{}, response proto.Message) []*FailedItem {
+		if response.(*protocol.Status).GetMessage() == "retryable" {
+			return []*FailedItem{{Item: "a", Message: "internal error"}, {Item: "b", Message: "invalid"}}
+		}
+		return []*FailedItem{{Item: "b", Message: "invalid"}}
+	}
+	tests := []struct {
+		name     string
+		opts     []option.Option
+		response proto.Message
+		err      error
+		want     []interface{}
+	}{
+		{name: "partial_failure", response: &protocol.Status{Code: StatusCodeSuccess}, want: []interface{}{"b"}},
+		{name: "retryable_item_failure", response: &protocol.Status{Message: "retryable"}, want: []interface{}{"b"}},
+		{name: "request_failure", err: errors.New("invalid token"), want: []interface{}{"a", "b"}},
+		{name: "retryable_request_failure", err: errors.New("timeout")},
+		{name: "skipped", opts: []option.Option{option.WithoutDeadLetter()}, err: errors.New("invalid token")},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sink := &memoryDeadLetterSink{}
+			options := option.Conv2Options(tt.opts...)
+			PrepareDeadLetters(sink, options)
+			WriteDeadLetters(sink, options, items, tt.response, tt.err, failedItems)
+			if len(sink.letters) != len(tt.want) {
+				t.Fatalf("dead letters = %+v, want items %v", sink.letters, tt.want)
+			}
+			for i, letter := range sink.letters {
+				if letter.Item != tt.want[i] || letter.RequestId == "" || letter.RequestId != options.RequestId {
+					t.Errorf("unexpected dead letter %+v", letter)
+				}
+			}
+		})
+	}
+	var nilSink DeadLetterSink
+	options := option.Conv2Options()
+	PrepareDeadLetters(nilSink, options)
+	if options.RequestId != "" {
+		t.Errorf("PrepareDeadLetters() without sink should not set request id")
+	}
+}
@@ -0,0 +1,42 @@
+package core
+
+import "strings"
+
+// Endpoint classes of requests, which are used to isolate the
+// circuit breakers and rate limiters of different kinds of requests
+const (
+	EndpointPredict  = "predict"
+	EndpointWrite    = "write"
+	EndpointImport   = "import"
+	EndpointCallback = "callback"
+	EndpointOther    = "other"
+)
+
+// endpointOf returns the endpoint class of the request url, e.g.
+// https://host/data/api/retail/demo/user?method=write is EndpointWrite,
+// https://host/predict/api/retail/demo/home is EndpointPredict
+func endpointOf(url string) string {
+	path := url
+	query := ""
+	if queryStart := strings.IndexByte(url, '?'); queryStart >= 0 {
+		path, query = url[:queryStart], url[queryStart+1:]
+	}
+	if strings.Contains(path, "/data/api/") {
+		for _, param := range strings.Split(query, "&") {
+			switch param {
+			case "method=write":
+				return EndpointWrite
+			case "method=import":
+				return EndpointImport
+			}
+		}
+		return EndpointOther
+	}
+	if strings.Contains(path, "/predict/api/") {
+		if strings.HasSuffix(path, "/callback") || strings.HasSuffix(path, "/ack_server_impressions") {
+			return EndpointCallback
+		}
+		return EndpointPredict
+	}
+	return EndpointOther
+}
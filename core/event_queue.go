@@ -0,0 +1,241 @@
+package core
+
+import (
+	"sync"
+	"time"
+
+	"github.com/byteplus-sdk/sdk-go/core/logs"
+)
+
+const (
+	defaultEventQueueBatchSize       = 1000
+	defaultEventQueueFlushInterval   = time.Second
+	defaultEventQueueRetryBackoff    = time.Second
+	defaultEventQueueMaxRetryBackoff = 30 * time.Second
+)
+
+type EventQueueConfig struct {
+	// Required, the write-ahead log persisting the events
+	WAL *WALConfig
+	// Max events sent in one request, default is 1000
+	BatchSize int
+	// Events are sent at least every FlushInterval even if they are less
+	// than BatchSize, default is 1s
+	FlushInterval time.Duration
+	// Optional, the retry of the failed items in each request, the items still
+	// failed are written to PartialRetryConfig.DeadLetterSink if it's set
+	RetryConfig *PartialRetryConfig
+	// If the whole request failed with retryable errors, the events in it are
+	// resent after backoff, which is doubled from RetryBackoff until
+	// MaxRetryBackoff, default is 1s and 30s. The backoff is interrupted by Close
+	RetryBackoff    time.Duration
+	MaxRetryBackoff time.Duration
+}
+
+// EventDecodeFunc decodes an event persisted in WAL to the item written by PartialWriteFunc
+type EventDecodeFunc func(record []byte) (interface{}, error)
+
+// EventQueue appends the events to a local write-ahead log before Append returns,
+// and sends them asynchronously. The events are committed after the server
+// accepted them, the events not committed are resent after restart, so that
+// every event is delivered at least once
+type EventQueue struct {
+	config      *EventQueueConfig
+	wal         *WAL
+	decode      EventDecodeFunc
+	write       PartialWriteFunc
+	failedItems FailedItemsFunc
+	notify      chan struct{}
+	closing     chan struct{}
+	closeOnce   sync.Once
+	done        chan struct{}
+}
+
+func NewEventQueue(config *EventQueueConfig, decode EventDecodeFunc,
+	write PartialWriteFunc, failedItems FailedItemsFunc) (*EventQueue, error) {
+	config = fillDefaultEventQueueConfig(config)
+	wal, err := OpenWAL(config.WAL)
+	if err != nil {
+		return nil, err
+	}
+	queue := &EventQueue{
+		config:      config,
+		wal:         wal,
+		decode:      decode,
+		write:       write,
+		failedItems: failedItems,
+		notify:      make(chan struct{}, 1),
+		closing:     make(chan struct{}),
+		done:        make(chan struct{}),
+	}
+	if pending := wal.Pending(); pending > 0 {
+		logs.Info("[EventQueue] replay %d events not sent before", pending)
+	}
+	AsyncExecute(queue.sendLoop)
+	return queue, nil
+}
+
+func fillDefaultEventQueueConfig(config *EventQueueConfig) *EventQueueConfig {
+	result := &EventQueueConfig{}
+	if config != nil {
+		*result = *config
+	}
+	if result.BatchSize <= 0 {
+		result.BatchSize = defaultEventQueueBatchSize
+	}
+	if result.BatchSize > MaxWriteItemCount {
+		result.BatchSize = MaxWriteItemCount
+	}
+	if result.FlushInterval <= 0 {
+		result.FlushInterval = defaultEventQueueFlushInterval
+	}
+	if result.RetryBackoff <= 0 {
+		result.RetryBackoff = defaultEventQueueRetryBackoff
+	}
+	if result.MaxRetryBackoff <= 0 {
+		result.MaxRetryBackoff = defaultEventQueueMaxRetryBackoff
+	}
+	if result.MaxRetryBackoff < result.RetryBackoff {
+		result.MaxRetryBackoff = result.RetryBackoff
+	}
+	return result
+}
+
+// Append persists the events, they will be sent asynchronously after it
+// returns without error. WALFullErr is returned if the events not sent
+// exceed WALConfig.MaxDiskSize
+func (receiver *EventQueue) Append(records [][]byte) error {
+	if err := receiver.wal.Append(records); err != nil {
+		return err
+	}
+	if receiver.wal.Unread() >= uint64(receiver.config.BatchSize) {
+		select {
+		case receiver.notify <- struct{}{}:
+		default:
+		}
+	}
+	return nil
+}
+
+// Pending returns the count of the events not accepted by server yet
+func (receiver *EventQueue) Pending() uint64 {
+	return receiver.wal.Pending()
+}
+
+// Close stops sending and closes the WAL, the events not sent yet are kept
+// in WAL and will be sent after the queue is created again with the same dir
+func (receiver *EventQueue) Close() error {
+	var err error
+	receiver.closeOnce.Do(func() {
+		close(receiver.closing)
+		<-receiver.done
+		err = receiver.wal.Close()
+	})
+	return err
+}
+
+func (receiver *EventQueue) sendLoop() {
+	defer close(receiver.done)
+	ticker := time.NewTicker(receiver.config.FlushInterval)
+	defer ticker.Stop()
+	for {
+		if !receiver.sendAll() {
+			return
+		}
+		select {
+		case <-receiver.closing:
+			return
+		case <-receiver.notify:
+		case <-ticker.C:
+		}
+	}
+}
+
+// sendAll sends all the events not read from WAL, returns false if the queue is closing
+func (receiver *EventQueue) sendAll() bool {
+	for {
+		records, nextOffset, err := receiver.wal.Read(receiver.config.BatchSize)
+		// the records read before the failure are still sent
+		if err != nil {
+			logs.Error("[EventQueue] read wal fail, err:%s", err.Error())
+		}
+		if len(records) == 0 {
+			return true
+		}
+		items := make([]interface{}, 0, len(records))
+		for _, record := range records {
+			item, err := receiver.decode(record)
+			if err != nil {
+				logs.Error("[EventQueue] drop the event can't be decoded, err:%s", err.Error())
+				continue
+			}
+			items = append(items, item)
+		}
+		if !receiver.sendWithBackoff(items) {
+			return false
+		}
+		if err = receiver.wal.Commit(nextOffset); err != nil {
+			logs.Error("[EventQueue] commit wal fail, err:%s", err.Error())
+		}
+	}
+}
+
+// sendWithBackoff resends the items failed with retryable errors until they are
+// accepted by server, or failed with errors not retryable. The items accepted
+// or failed permanently are never resent. Returns false if the queue is closing
+func (receiver *EventQueue) sendWithBackoff(items []interface{}) bool {
+	// the failed items are written to dead letter sink only if they won't be resent
+	retryConfig := &PartialRetryConfig{}
+	if receiver.config.RetryConfig != nil {
+		*retryConfig = *receiver.config.RetryConfig
+	}
+	deadLetterSink := retryConfig.DeadLetterSink
+	retryConfig.DeadLetterSink = nil
+	backoff := receiver.config.RetryBackoff
+	var failed []*FailedItem
+	for {
+		report, err := retryPartialFailures(retryConfig, items, receiver.write, receiver.failedItems, receiver.closing)
+		if err == errRetryStopped {
+			return false
+		}
+		if err == nil || !isRetryableWriteError(err) {
+			if err != nil {
+				logs.Error("[EventQueue] send %d events fail, err:%s", len(items), err.Error())
+			}
+			if report != nil {
+				failed = append(failed, report.Failed...)
+			}
+			break
+		}
+		// the last request failed entirely, only its items are resent
+		items = items[:0:0]
+		for _, item := range report.Failed {
+			if item.Retryable {
+				items = append(items, item.Item)
+				continue
+			}
+			failed = append(failed, item)
+		}
+		logs.Warn("[EventQueue] send %d events fail, retry after %v, err:%s", len(items), backoff, err.Error())
+		if !sleepOrStop(backoff, receiver.closing) {
+			return false
+		}
+		backoff *= 2
+		if backoff > receiver.config.MaxRetryBackoff {
+			backoff = receiver.config.MaxRetryBackoff
+		}
+	}
+	if deadLetterSink != nil && len(failed) > 0 {
+		if err := deadLetterSink.Write(newDeadLetters(failed)); err != nil {
+			logs.Error("[EventQueue] write %d dead letters fail, err:%s", len(failed), err.Error())
+		}
+	}
+	return true
+}
+
+func isRetryableWriteError(err error) bool {
+	if statusErr, ok := AsStatusError(err); ok {
+		return isRetryableStatusCode(statusErr.Code)
+	}
+	return isRetryableRequestError(err)
+}
@@ -0,0 +1,170 @@
+package core
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/byteplus-sdk/sdk-go/core/logs"
+	"github.com/byteplus-sdk/sdk-go/core/metrics"
+)
+
+const (
+	defaultHedgeDelay = 100 * time.Millisecond
+	// count of recent latencies used to calculate the percentile
+	hedgeLatencySampleSize = 1000
+	// the percentile is used only after collecting enough latencies,
+	// before that the `Delay` is used
+	hedgeMinLatencySamples = 100
+)
+
+type HedgeConfig struct {
+	// If the first request doesn't receive response after `Delay`,
+	// a hedged request will be sent to another host, default is 100ms
+	Delay time.Duration
+	// Optional, range (0, 1), use the percentile of recent latencies as
+	// the delay instead of `Delay`, e.g. 0.95 means p95
+	Percentile float64
+	// Optional, the lower bound of the delay calculated by `Percentile`
+	MinDelay time.Duration
+}
+
+func fillDefaultHedgeConfig(config *HedgeConfig) *HedgeConfig {
+	if config == nil {
+		return nil
+	}
+	if config.Delay <= 0 {
+		config.Delay = defaultHedgeDelay
+	}
+	if config.Percentile >= 1 {
+		config.Percentile = 0
+	}
+	return config
+}
+
+func newHedger(config *HedgeConfig) *hedger {
+	return &hedger{
+		config:    config,
+		latencies: make([]time.Duration, 0, hedgeLatencySampleSize),
+	}
+}
+
+type hedger struct {
+	config    *HedgeConfig
+	lock      sync.Mutex
+	latencies []time.Duration
+	next      int
+}
+
+func (h *hedger) record(latency time.Duration) {
+	if h.config.Percentile <= 0 {
+		return
+	}
+	h.lock.Lock()
+	defer h.lock.Unlock()
+	if len(h.latencies) < hedgeLatencySampleSize {
+		h.latencies = append(h.latencies, latency)
+		return
+	}
+	h.latencies[h.next] = latency
+	h.next = (h.next + 1) % hedgeLatencySampleSize
+}
+
+func (h *hedger) delay() time.Duration {
+	if h.config.Percentile <= 0 {
+		return h.config.Delay
+	}
+	h.lock.Lock()
+	if len(h.latencies) < hedgeMinLatencySamples {
+		h.lock.Unlock()
+		return h.config.Delay
+	}
+	sorted := append([]time.Duration(nil), h.latencies...)
+	h.lock.Unlock()
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i] < sorted[j]
+	})
+	result := sorted[int(float64(len(sorted)-1)*h.config.Percentile)]
+	if result < h.config.MinDelay {
+		return h.config.MinDelay
+	}
+	return result
+}
+
+type hedgeResult struct {
+	rspBytes []byte
+	err      error
+	hedged   bool
+}
+
+func (c *HTTPCaller) doHedgedHttpRequest(reqID, url string, headers map[string]string,
+	reqBytes []byte, timeout time.Duration) ([]byte, error) {
+	primaryHost, ok := c.chooseHost(url, make(map[string]bool))
+	if !ok {
+		primaryHost = urlHost(url)
+	}
+	start := time.Now()
+	// buffered, so that the abandoned request won't block
+	results := make(chan *hedgeResult, 2)
+	// closed on return, the request still in flight stops waiting for its
+	// response, which is bounded by the same deadline
+	abandoned := make(chan struct{})
+	defer close(abandoned)
+	sendRequest := func(host string, hedged bool, timeout time.Duration) {
+		AsyncExecute(func() {
+			attemptStart := time.Now()
+			rspBytes, err := c.doHttpRequestOnHost(reqID, url, host, headers, reqBytes, timeout, abandoned)
+			if err == errRequestAbandoned {
+				return
+			}
+			if err == nil {
+				c.hedger.record(time.Now().Sub(attemptStart))
+			}
+			results <- &hedgeResult{rspBytes: rspBytes, err: unwrapTransportError(err), hedged: hedged}
+		})
+	}
+	sendRequest(primaryHost, false, timeout)
+	hedgeTimer := time.NewTimer(c.hedger.delay())
+	defer hedgeTimer.Stop()
+	pending := 1
+	for {
+		select {
+		case result := <-results:
+			pending--
+			if result.err == nil {
+				if result.hedged {
+					c.emitHedgeMetrics(url, "hedge_win")
+				}
+				return result.rspBytes, nil
+			}
+			if pending == 0 {
+				return nil, result.err
+			}
+		case <-hedgeTimer.C:
+			hedgeTimeout := timeout
+			if timeout > 0 {
+				hedgeTimeout = timeout - time.Now().Sub(start)
+				if hedgeTimeout <= 0 {
+					continue
+				}
+			}
+			hedgeHost, ok := c.chooseHost(url, map[string]bool{primaryHost: true})
+			if !ok {
+				hedgeHost = primaryHost
+			}
+			logs.Debug("predict not respond in time, send hedged request to host:%s, reqID:%s", hedgeHost, reqID)
+			c.emitHedgeMetrics(url, "sent")
+			sendRequest(hedgeHost, true, hedgeTimeout)
+			pending++
+		}
+	}
+}
+
+func (c *HTTPCaller) emitHedgeMetrics(url string, hedgeType string) {
+	metricsTags := []string{
+		"type:" + hedgeType,
+		"tenant:" + c.context.Tenant(),
+		"url:" + escapeMetricsTagValue(url),
+	}
+	metrics.Counter(metricsKeyHedgeCount, 1, metricsTags...)
+}
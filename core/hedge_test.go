@@ -0,0 +1,89 @@
+package core
+
+import (
+	"net"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/byteplus-sdk/sdk-go/common/protocol"
+	"github.com/byteplus-sdk/sdk-go/core/option"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestHedger_delay(t *testing.T) {
+	h := newHedger(fillDefaultHedgeConfig(&HedgeConfig{
+		Delay:      time.Second,
+		Percentile: 0.9,
+		MinDelay:   5 * time.Millisecond,
+	}))
+	if got := h.delay(); got != time.Second {
+		t.Errorf("delay() without samples = %v, want %v", got, time.Second)
+	}
+	for i := 1; i <= hedgeMinLatencySamples; i++ {
+		h.record(time.Duration(i) * time.Millisecond)
+	}
+	if got := h.delay(); got != 90*time.Millisecond {
+		t.Errorf("delay() = %v, want %v", got, 90*time.Millisecond)
+	}
+}
+
+func startTestServer(t *testing.T, delay time.Duration, code int32) string {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listen fail, err:%v", err)
+	}
+	rspBytes, _ := proto.Marshal(&protocol.Status{Code: code})
+	server := &http.Server{Handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(delay)
+		_, _ = w.Write(rspBytes)
+	})}
+	go func() {
+		_ = server.Serve(listener)
+	}()
+	t.Cleanup(func() {
+		_ = server.Close()
+	})
+	return listener.Addr().String()
+}
+
+func TestHTTPCaller_DoHedgedPBRequest(t *testing.T) {
+	slowHost := startTestServer(t, time.Second, 1)
+	fastHost := startTestServer(t, 0, 2)
+	context, err := NewContext(&ContextParam{
+		Tenant:      "demo",
+		TenantId:    "demo",
+		AK:          "ak",
+		SK:          "sk",
+		Schema:      "http",
+		Hosts:       []string{slowHost, fastHost},
+		Region:      RegionSg,
+		HedgeConfig: &HedgeConfig{Delay: 20 * time.Millisecond},
+	})
+	if err != nil {
+		t.Fatalf("new context fail, err:%v", err)
+	}
+	hostAvailabler := NewHostAvailabler(&mockURLCenter{}, context)
+	defer hostAvailabler.Shutdown()
+	caller := NewHTTPCaller(context)
+
+	start := time.Now()
+	response := &protocol.Status{}
+	err = caller.DoHedgedPBRequest("http://"+slowHost+"/predict/api/demo/home",
+		&protocol.Status{}, response, &option.Options{Timeout: 3 * time.Second})
+	if err != nil {
+		t.Fatalf("DoHedgedPBRequest() err:%v", err)
+	}
+	if response.Code != 2 {
+		t.Errorf("response from host with code %d, want the fast host", response.Code)
+	}
+	if cost := time.Now().Sub(start); cost >= time.Second {
+		t.Errorf("DoHedgedPBRequest() cost %v, hedged request not sent", cost)
+	}
+	// the losing request on the slow host is abandoned rather than waited
+	time.Sleep(50 * time.Millisecond)
+	if outstanding := atomic.LoadInt64(hostAvailabler.hostOutstanding[slowHost]); outstanding != 0 {
+		t.Errorf("outstanding requests of slow host = %d, want the losing request abandoned", outstanding)
+	}
+}
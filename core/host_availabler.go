@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"sort"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/google/uuid"
@@ -20,8 +21,31 @@ const (
 	defaultPingTimeout   = 300 * time.Millisecond
 	defaultPingInterval  = time.Second
 	failureRateThreshold = 0.1
+	// count of recent ping latencies kept for each host
+	defaultLatencyHistorySize = 10
 )
 
+const (
+	// HostSwitchReasonUnavailable the current host's failure rate exceeded the threshold
+	HostSwitchReasonUnavailable = "unavailable"
+	// HostSwitchReasonLowerFailureRate another available host has a lower failure rate than current host
+	HostSwitchReasonLowerFailureRate = "lower_failure_rate"
+	// HostSwitchReasonNoAvailableHost all hosts are unavailable, fall back to the first configured host
+	HostSwitchReasonNoAvailableHost = "no_available_host"
+)
+
+// HostSwitchEvent describes a change of the host which requests are sent to
+type HostSwitchEvent struct {
+	OldHost string
+	NewHost string
+	Reason  string
+	Time    time.Time
+}
+
+// HostSwitchListener will be called synchronously in the ping goroutine each time
+// the host is switched, so it should return quickly
+type HostSwitchListener func(event *HostSwitchEvent)
+
 type HostAvailablerConfig struct {
 	// host availabler used to test the latency, example {}://%s/predict/api/ping
 	// {} will be replaced by schema which set in context
@@ -33,6 +57,38 @@ type HostAvailablerConfig struct {
 	PingTimeout time.Duration
 	// The time interval for pingHostAvailabler to do ping
 	PingInterval time.Duration
+	// Optional, will be called each time the host is switched
+	HostSwitchListener HostSwitchListener
+	// Optional, spread requests across all available hosts instead of
+	// sending all of them to the current host, default is LoadBalanceNone.
+	// Predict requests failed by transport errors are retried on another host
+	// within the same timeout
+	LoadBalanceStrategy LoadBalanceStrategy
+	// Optional, weight of each host used by LoadBalanceWeightedRandom,
+	// hosts not in the map have weight 1
+	HostWeights map[string]int
+}
+
+// HostHealth is the health state of a single host observed by ping
+type HostHealth struct {
+	Host string
+	// failure rate of the pings in the window
+	FailureRate float64
+	// latencies of the recent pings, from oldest to newest
+	RecentLatencies []time.Duration
+	// error of the last failed ping, empty if no ping failed yet
+	LastPingError string
+	// time of the last failed ping
+	LastPingErrorTime time.Time
+	Available         bool
+}
+
+// HealthSnapshot is a point-in-time copy of the routing state of HostAvailabler
+type HealthSnapshot struct {
+	CurrentHost    string
+	AvailableHosts []string
+	Hosts          []*HostHealth
+	Time           time.Time
 }
 
 func NewHostAvailabler(urlCenter URLCenter, context *Context) *HostAvailabler {
@@ -44,13 +100,17 @@ func NewHostAvailabler(urlCenter URLCenter, context *Context) *HostAvailabler {
 	availabler.currentHost = context.hosts[0]
 	availabler.availableHosts = context.hosts
 	availabler.pingUrlFormat = strings.ReplaceAll(availabler.config.PingUrlFormat, "{}", context.Schema())
+	context.hostAvailabler = availabler
+	if availabler.loadBalanceEnabled() || context.hedgeConfig != nil || context.circuitBreakerConfig != nil {
+		availabler.initRequestClients()
+	}
 	if len(context.hosts) <= 1 {
 		return availabler
 	}
 	hostWindowMap := make(map[string]*window, len(context.hosts))
 	hostHttpCliMap := make(map[string]*fasthttp.HostClient, len(context.hosts))
 	for _, host := range context.hosts {
-		hostWindowMap[host] = newWindow(availabler.config.WindowSize)
+		hostWindowMap[host] = newWindow(availabler.config.WindowSize, defaultLatencyHistorySize)
 		hostHttpCliMap[host] = &fasthttp.HostClient{Addr: host}
 	}
 	availabler.hostWindowMap = hostWindowMap
@@ -83,11 +143,16 @@ type HostAvailabler struct {
 	context        *Context
 	urlCenter      URLCenter
 	config         *HostAvailablerConfig
+	lock           sync.RWMutex
 	currentHost    string
 	availableHosts []string
 	hostWindowMap  map[string]*window
 	hostHTTPCliMap map[string]*fasthttp.HostClient
 	pingUrlFormat  string
+	// only used when load balance, hedging or circuit breaker is enabled
+	hostRequestCliMap map[string]*fasthttp.HostClient
+	hostOutstanding   map[string]*int64
+	roundRobinIndex   uint64
 }
 
 func (receiver *HostAvailabler) Shutdown() {
@@ -110,10 +175,21 @@ func (receiver *HostAvailabler) scheduleFunc() func() {
 }
 
 func (receiver *HostAvailabler) checkHost() {
+	pingResults := make(map[string]error, len(receiver.context.hosts))
+	pingCosts := make(map[string]time.Duration, len(receiver.context.hosts))
+	for _, host := range receiver.context.hosts {
+		pingCosts[host], pingResults[host] = receiver.ping(host)
+	}
+	receiver.lock.Lock()
+	defer receiver.lock.Unlock()
 	availableHosts := make([]string, 0, len(receiver.context.hosts))
 	for _, host := range receiver.context.hosts {
 		winObj := receiver.hostWindowMap[host]
-		winObj.put(receiver.ping(host))
+		winObj.put(pingResults[host] == nil, pingCosts[host])
+		if pingResults[host] != nil {
+			winObj.lastErr = pingResults[host]
+			winObj.lastErrTime = time.Now()
+		}
 		if winObj.failureRate() < failureRateThreshold {
 			availableHosts = append(availableHosts, host)
 		}
@@ -122,14 +198,14 @@ func (receiver *HostAvailabler) checkHost() {
 	if len(availableHosts) <= 1 {
 		return
 	}
-	sort.Slice(availableHosts, func(i, j int) bool {
+	sort.SliceStable(availableHosts, func(i, j int) bool {
 		failureRateI := receiver.hostWindowMap[availableHosts[i]].failureRate()
 		failureRateJ := receiver.hostWindowMap[availableHosts[j]].failureRate()
 		return failureRateI < failureRateJ
 	})
 }
 
-func (receiver *HostAvailabler) ping(host string) bool {
+func (receiver *HostAvailabler) ping(host string) (time.Duration, error) {
 	start := time.Now()
 	request := fasthttp.AcquireRequest()
 	response := fasthttp.AcquireResponse()
@@ -156,50 +232,128 @@ func (receiver *HostAvailabler) ping(host string) bool {
 		metrics.Warn(reqID, "[ByteplusSDK] ping find err, tenant:%s, host:%s, cost:%dms, err:%v",
 			receiver.context.Tenant(), host, cost.Milliseconds(), err)
 		logs.Warn("ping find err, host:%s cost:%dms err:%v", host, cost.Milliseconds(), err)
-		return false
+		return cost, err
 	}
 	if response.StatusCode() == fasthttp.StatusOK {
 		metrics.Info(reqID, "[ByteplusSDK] ping success, tenant:%s, host:%s, cost:%dms",
 			receiver.context.Tenant(), host, cost.Milliseconds())
 		logs.Debug("ping success host:'%s' cost:'%s'", host, cost)
-		return true
+		return cost, nil
 	}
 	metrics.Warn(reqID, "[ByteplusSDK] ping fail, tenant:%s, host:%s, cost:%dms, status:%d",
 		receiver.context.Tenant(), host, cost.Milliseconds(), response.StatusCode())
 	logs.Warn("ping fail, host:%s cost:%s status:%d err:%v",
 		host, cost, response.StatusCode(), err)
-	return false
+	return cost, fmt.Errorf("ping status not 200, status:%d", response.StatusCode())
 }
 
 func (receiver *HostAvailabler) switchHost() {
-	var newHost string
+	event := receiver.doSwitchHost()
+	if event == nil {
+		return
+	}
+	logs.Warn("switch host to '%s', origin is '%s', reason:%s",
+		event.NewHost, event.OldHost, event.Reason)
+	receiver.notifyHostSwitch(event)
+}
+
+func (receiver *HostAvailabler) doSwitchHost() *HostSwitchEvent {
+	receiver.lock.Lock()
+	defer receiver.lock.Unlock()
+	var newHost, reason string
 	if len(receiver.availableHosts) == 0 {
 		newHost = receiver.context.hosts[0]
+		reason = HostSwitchReasonNoAvailableHost
 	} else {
 		newHost = receiver.availableHosts[0]
-	}
-	if newHost != receiver.currentHost {
-		logs.Warn("switch host to '%s', origin is '%s'",
-			newHost, receiver.currentHost)
-		receiver.currentHost = newHost
-		receiver.urlCenter.Refresh(newHost)
-		if receiver.context.hostHeader != "" {
-			receiver.context.hostHTTPCli = &fasthttp.HostClient{Addr: newHost}
+		reason = HostSwitchReasonLowerFailureRate
+		if !containsHost(receiver.availableHosts, receiver.currentHost) {
+			reason = HostSwitchReasonUnavailable
 		}
 	}
+	if newHost == receiver.currentHost {
+		return nil
+	}
+	event := &HostSwitchEvent{
+		OldHost: receiver.currentHost,
+		NewHost: newHost,
+		Reason:  reason,
+		Time:    time.Now(),
+	}
+	receiver.currentHost = newHost
+	receiver.urlCenter.Refresh(newHost)
+	if receiver.context.hostHeader != "" {
+		receiver.context.hostHTTPCli = &fasthttp.HostClient{Addr: newHost}
+	}
+	return event
+}
+
+func (receiver *HostAvailabler) notifyHostSwitch(event *HostSwitchEvent) {
+	listener := receiver.config.HostSwitchListener
+	if listener == nil {
+		return
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			logs.Error("host switch listener occur panic, err:%v", r)
+		}
+	}()
+	listener(event)
 }
 
 func (receiver *HostAvailabler) GetHost() string {
+	receiver.lock.RLock()
+	defer receiver.lock.RUnlock()
 	return receiver.currentHost
 }
 
-func newWindow(size int) *window {
+// HealthSnapshot returns a copy of the current routing state, it is safe
+// to be called concurrently, e.g. to render a debug page
+func (receiver *HostAvailabler) HealthSnapshot() *HealthSnapshot {
+	receiver.lock.RLock()
+	defer receiver.lock.RUnlock()
+	snapshot := &HealthSnapshot{
+		CurrentHost:    receiver.currentHost,
+		AvailableHosts: append([]string(nil), receiver.availableHosts...),
+		Hosts:          make([]*HostHealth, 0, len(receiver.context.hosts)),
+		Time:           time.Now(),
+	}
+	for _, host := range receiver.context.hosts {
+		health := &HostHealth{
+			Host:      host,
+			Available: containsHost(receiver.availableHosts, host),
+		}
+		if winObj, exist := receiver.hostWindowMap[host]; exist {
+			health.FailureRate = winObj.failureRate()
+			health.RecentLatencies = winObj.recentLatencies()
+			if winObj.lastErr != nil {
+				health.LastPingError = winObj.lastErr.Error()
+				health.LastPingErrorTime = winObj.lastErrTime
+			}
+		}
+		snapshot.Hosts = append(snapshot.Hosts, health)
+	}
+	return snapshot
+}
+
+func containsHost(hosts []string, host string) bool {
+	for _, h := range hosts {
+		if h == host {
+			return true
+		}
+	}
+	return false
+}
+
+func newWindow(size int, latencySize int) *window {
 	result := &window{
 		size:         size,
 		items:        make([]bool, size),
 		head:         size - 1,
 		tail:         0,
 		failureCount: 0,
+		latencies:    make([]time.Duration, 0, latencySize),
+		latencySize:  latencySize,
 	}
 	for i := range result.items {
 		result.items[i] = true
@@ -213,9 +367,15 @@ type window struct {
 	head         int
 	tail         int
 	failureCount float64
+	// ring buffer of recent latencies, latencyHead points to the oldest one when full
+	latencies   []time.Duration
+	latencySize int
+	latencyHead int
+	lastErr     error
+	lastErrTime time.Time
 }
 
-func (receiver *window) put(success bool) {
+func (receiver *window) put(success bool, latency time.Duration) {
 	if !success {
 		receiver.failureCount++
 	}
@@ -226,6 +386,25 @@ func (receiver *window) put(success bool) {
 	if !removingItem {
 		receiver.failureCount--
 	}
+	receiver.putLatency(latency)
+}
+
+func (receiver *window) putLatency(latency time.Duration) {
+	if receiver.latencySize <= 0 {
+		return
+	}
+	if len(receiver.latencies) < receiver.latencySize {
+		receiver.latencies = append(receiver.latencies, latency)
+		return
+	}
+	receiver.latencies[receiver.latencyHead] = latency
+	receiver.latencyHead = (receiver.latencyHead + 1) % receiver.latencySize
+}
+
+func (receiver *window) recentLatencies() []time.Duration {
+	result := make([]time.Duration, 0, len(receiver.latencies))
+	result = append(result, receiver.latencies[receiver.latencyHead:]...)
+	return append(result, receiver.latencies[:receiver.latencyHead]...)
 }
 
 func (receiver *window) failureRate() float64 {
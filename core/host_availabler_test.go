@@ -0,0 +1,113 @@
+package core
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+type mockURLCenter struct {
+	host string
+}
+
+func (m *mockURLCenter) Refresh(host string) {
+	m.host = host
+}
+
+func newTestHostAvailabler(hosts []string, listener HostSwitchListener) *HostAvailabler {
+	availabler := &HostAvailabler{
+		context:        &Context{hosts: hosts},
+		urlCenter:      &mockURLCenter{},
+		config:         fillDefaultConfig(&HostAvailablerConfig{HostSwitchListener: listener}),
+		currentHost:    hosts[0],
+		availableHosts: hosts,
+		hostWindowMap:  make(map[string]*window, len(hosts)),
+	}
+	for _, host := range hosts {
+		availabler.hostWindowMap[host] = newWindow(availabler.config.WindowSize, defaultLatencyHistorySize)
+	}
+	return availabler
+}
+
+func TestHostAvailabler_switchHost(t *testing.T) {
+	var events []*HostSwitchEvent
+	availabler := newTestHostAvailabler([]string{"host-a", "host-b"}, func(event *HostSwitchEvent) {
+		events = append(events, event)
+	})
+	tests := []struct {
+		name           string
+		availableHosts []string
+		wantHost       string
+		wantReason     string
+	}{
+		{
+			name:           "no_change",
+			availableHosts: []string{"host-a", "host-b"},
+			wantHost:       "host-a",
+		},
+		{
+			name:           "current_unavailable",
+			availableHosts: []string{"host-b"},
+			wantHost:       "host-b",
+			wantReason:     HostSwitchReasonUnavailable,
+		},
+		{
+			name:           "no_available_host",
+			availableHosts: []string{},
+			wantHost:       "host-a",
+			wantReason:     HostSwitchReasonNoAvailableHost,
+		},
+		{
+			name:           "lower_failure_rate",
+			availableHosts: []string{"host-b", "host-a"},
+			wantHost:       "host-b",
+			wantReason:     HostSwitchReasonLowerFailureRate,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			events = nil
+			availabler.availableHosts = tt.availableHosts
+			availabler.switchHost()
+			if got := availabler.GetHost(); got != tt.wantHost {
+				t.Errorf("GetHost() = %v, want %v", got, tt.wantHost)
+			}
+			if tt.wantReason == "" {
+				if len(events) != 0 {
+					t.Errorf("unexpected switch event %+v", events[0])
+				}
+				return
+			}
+			if len(events) != 1 || events[0].Reason != tt.wantReason || events[0].NewHost != tt.wantHost {
+				t.Errorf("switch events = %+v, want reason %v", events, tt.wantReason)
+			}
+		})
+	}
+}
+
+func TestHostAvailabler_HealthSnapshot(t *testing.T) {
+	availabler := newTestHostAvailabler([]string{"host-a", "host-b"}, nil)
+	winObj := availabler.hostWindowMap["host-b"]
+	for i := 0; i < defaultLatencyHistorySize+2; i++ {
+		winObj.put(true, time.Duration(i)*time.Millisecond)
+	}
+	winObj.put(false, time.Second)
+	winObj.lastErr = errors.New("timeout")
+	availabler.availableHosts = []string{"host-a"}
+
+	snapshot := availabler.HealthSnapshot()
+	if len(snapshot.Hosts) != 2 {
+		t.Fatalf("len(Hosts) = %d, want 2", len(snapshot.Hosts))
+	}
+	health := snapshot.Hosts[1]
+	if health.Available || health.LastPingError != "timeout" || health.FailureRate <= 0 {
+		t.Errorf("unexpected host health %+v", health)
+	}
+	latencies := health.RecentLatencies
+	if len(latencies) != defaultLatencyHistorySize || latencies[len(latencies)-1] != time.Second {
+		t.Errorf("RecentLatencies = %v", latencies)
+	}
+	if latencies[0] != 3*time.Millisecond {
+		t.Errorf("oldest latency = %v, want 3ms", latencies[0])
+	}
+}
@@ -20,12 +20,29 @@ import (
 
 const netErrMark = "[netErr]"
 
+// errRequestAbandoned is returned by the request whose response is no longer
+// needed, e.g. the request losing a hedge
+var errRequestAbandoned = errors.New("request abandoned")
+
 func NewHTTPCaller(context *Context) *HTTPCaller {
-	return &HTTPCaller{context: context}
+	caller := &HTTPCaller{context: context}
+	if context.hedgeConfig != nil {
+		caller.hedger = newHedger(context.hedgeConfig)
+	}
+	if context.circuitBreakerConfig != nil {
+		caller.breakers = newCircuitBreakers(context.circuitBreakerConfig, context.Tenant())
+	}
+	if context.rateLimitConfig != nil {
+		caller.rateLimiters = newRateLimiters(context.rateLimitConfig)
+	}
+	return caller
 }
 
 type HTTPCaller struct {
-	context *Context
+	context      *Context
+	hedger       *hedger
+	breakers     *circuitBreakers
+	rateLimiters map[string]*RateLimiter
 }
 
 func (c *HTTPCaller) DoJSONRequest(url string, request interface{},
@@ -46,8 +63,12 @@ func (c *HTTPCaller) DoJSONRequest(url string, request interface{},
 		return err
 	}
 	url = c.withOptionQueries(options, url)
+	if err = c.acquireRateLimit(url, options.Timeout); err != nil {
+		return err
+	}
 	rspBytes, err := c.doHttpRequest(reqID, url, headers, reqBytes, options.Timeout)
 	if err != nil {
+		c.checkThrottled(url, nil, err)
 		return err
 	}
 	err = proto.Unmarshal(rspBytes, response)
@@ -63,7 +84,8 @@ func (c *HTTPCaller) DoJSONRequest(url string, request interface{},
 		logs.Error("unmarshal response fail, err:%s url:%s", err.Error(), url)
 		return err
 	}
-	return nil
+	c.checkThrottled(url, response, nil)
+	return c.checkStatus(reqID, response)
 }
 
 func (c *HTTPCaller) jsonMarshal(request interface{}) ([]byte, error) {
@@ -77,6 +99,27 @@ func (c *HTTPCaller) jsonMarshal(request interface{}) ([]byte, error) {
 
 func (c *HTTPCaller) DoPBRequest(url string, request proto.Message,
 	response proto.Message, options *option.Options) error {
+	return c.doPBRequest(url, request, response, options, c.doHttpRequest)
+}
+
+// DoHedgedPBRequest is the same as DoPBRequest, but when HedgeConfig is set
+// and the request doesn't receive response within the hedge delay, a duplicate
+// request will be sent to another host, and the first response will be used,
+// the other request is abandoned without waiting for its response.
+// It should only be used by idempotent requests, such as predict
+func (c *HTTPCaller) DoHedgedPBRequest(url string, request proto.Message,
+	response proto.Message, options *option.Options) error {
+	if c.hedger == nil || c.context.hostAvailabler == nil {
+		return c.DoPBRequest(url, request, response, options)
+	}
+	return c.doPBRequest(url, request, response, options, c.doHedgedHttpRequest)
+}
+
+type httpRequestFunc func(reqID, url string, headers map[string]string,
+	reqBytes []byte, timeout time.Duration) ([]byte, error)
+
+func (c *HTTPCaller) doPBRequest(url string, request proto.Message,
+	response proto.Message, options *option.Options, doRequest httpRequestFunc) error {
 	reqBytes, err := c.marshal(request)
 	headers := c.buildHeaders(options, "application/x-protobuf")
 	reqID, _ := headers["Request-Id"]
@@ -93,8 +136,12 @@ func (c *HTTPCaller) DoPBRequest(url string, request proto.Message,
 		return err
 	}
 	url = c.withOptionQueries(options, url)
-	rspBytes, err := c.doHttpRequest(reqID, url, headers, reqBytes, options.Timeout)
+	if err = c.acquireRateLimit(url, options.Timeout); err != nil {
+		return err
+	}
+	rspBytes, err := doRequest(reqID, url, headers, reqBytes, options.Timeout)
 	if err != nil {
+		c.checkThrottled(url, nil, err)
 		return err
 	}
 	err = proto.Unmarshal(rspBytes, response)
@@ -110,7 +157,8 @@ func (c *HTTPCaller) DoPBRequest(url string, request proto.Message,
 		logs.Error("unmarshal response fail, err:%s url:%s", err.Error(), url)
 		return err
 	}
-	return nil
+	c.checkThrottled(url, response, nil)
+	return c.checkStatus(reqID, response)
 }
 
 func (c *HTTPCaller) marshal(request proto.Message) ([]byte, error) {
@@ -223,8 +271,152 @@ func (c *HTTPCaller) withOptionQueries(options *option.Options, url string) stri
 	return url
 }
 
+// transportError means the request failed before receiving http response,
+// which is allowed to be retried on another host
+type transportError struct {
+	err error
+}
+
+func (e *transportError) Error() string {
+	return e.err.Error()
+}
+
+func (e *transportError) Unwrap() error {
+	return e.err
+}
+
 func (c *HTTPCaller) doHttpRequest(reqID, url string, headers map[string]string,
 	reqBytes []byte, timeout time.Duration) ([]byte, error) {
+	hostAva := c.context.hostAvailabler
+	if hostAva == nil || (!hostAva.loadBalanceEnabled() && c.breakers == nil) {
+		rspBytes, err := c.doHttpRequestWithClient(reqID, url, headers, reqBytes, timeout, nil, nil)
+		return rspBytes, unwrapTransportError(err)
+	}
+	var err error
+	triedHosts := make(map[string]bool, len(c.context.hosts))
+	// the attempts on all hosts share the timeout of request
+	deadline := time.Now().Add(timeout)
+	for {
+		host, ok := c.chooseHost(url, triedHosts)
+		if !ok {
+			if err == nil {
+				err = &CircuitOpenError{Host: urlHost(url), Endpoint: endpointOf(url)}
+			}
+			return nil, unwrapTransportError(err)
+		}
+		attemptTimeout := timeout
+		if timeout > 0 && len(triedHosts) > 0 {
+			attemptTimeout = time.Until(deadline)
+			if attemptTimeout <= 0 {
+				return nil, unwrapTransportError(err)
+			}
+		}
+		triedHosts[host] = true
+		var rspBytes []byte
+		rspBytes, err = c.doHttpRequestOnHost(reqID, url, host, headers, reqBytes, attemptTimeout, nil)
+		if !c.shouldTryAnotherHost(url, err) {
+			return rspBytes, unwrapTransportError(err)
+		}
+		logs.Warn("request fail on host:%s, try another host, err:%v", host, err)
+	}
+}
+
+// chooseHost returns the host to send request to, hosts in `excluded` and hosts
+// whose circuit is open are skipped. The chosen host is picked by HostAvailabler
+// when load balance is enabled, otherwise the host in url is preferred
+func (c *HTTPCaller) chooseHost(url string, excluded map[string]bool) (string, bool) {
+	hostAva := c.context.hostAvailabler
+	endpoint := endpointOf(url)
+	for {
+		var host string
+		if !hostAva.loadBalanceEnabled() && len(excluded) == 0 {
+			host = urlHost(url)
+		} else {
+			var ok bool
+			host, ok = hostAva.pickHost(excluded)
+			if !ok {
+				return "", false
+			}
+		}
+		if !c.breakers.isOpen(host, endpoint) {
+			return host, true
+		}
+		logs.Debug("circuit of host:%s endpoint:%s is open, try another host", host, endpoint)
+		excluded[host] = true
+	}
+}
+
+// shouldTryAnotherHost reports whether the failed request can be sent to another
+// host. Requests rejected by circuit breaker are never sent, so they can always
+// try another host. Requests failed by transport error may have been received,
+// so only predict requests, which are idempotent, are retried
+func (c *HTTPCaller) shouldTryAnotherHost(url string, err error) bool {
+	if IsCircuitOpenError(err) {
+		return true
+	}
+	_, isTransportErr := err.(*transportError)
+	return isTransportErr && endpointOf(url) == EndpointPredict &&
+		c.context.hostAvailabler.loadBalanceEnabled()
+}
+
+// doHttpRequestOnHost send request to the host, it stops waiting for the response
+// and returns errRequestAbandoned once `abandoned` is closed
+func (c *HTTPCaller) doHttpRequestOnHost(reqID, url string, host string, headers map[string]string,
+	reqBytes []byte, timeout time.Duration, abandoned <-chan struct{}) ([]byte, error) {
+	breaker := c.breakers.get(host, endpointOf(url))
+	if !breaker.allow() {
+		return nil, &CircuitOpenError{Host: host, Endpoint: endpointOf(url)}
+	}
+	hostAva := c.context.hostAvailabler
+	release := hostAva.acquireHost(host)
+	defer release()
+	url = replaceURLHost(url, host)
+	start := time.Now()
+	rspBytes, err := c.doHttpRequestWithClient(reqID, url, headers, reqBytes, timeout,
+		hostAva.requestClient(host), abandoned)
+	if err == errRequestAbandoned {
+		// the result of the call is unknown, it's not regarded as a fast success
+		breaker.cancel()
+		return nil, err
+	}
+	breaker.record(!isHostFailure(err), time.Now().Sub(start))
+	return rspBytes, err
+}
+
+// httpStatusError means the http status of response is not 200
+type httpStatusError struct {
+	code int
+}
+
+func (e *httpStatusError) Error() string {
+	return netErrMark + "http status not 200"
+}
+
+// isHostFailure reports whether err is caused by the host rather than the
+// request, which are transport errors, 5xx and 429. Other 4xx are errors of the
+// caller, which shouldn't open the circuit of the host
+func isHostFailure(err error) bool {
+	if err == nil {
+		return false
+	}
+	if statusErr, ok := err.(*httpStatusError); ok {
+		return statusErr.code >= fasthttp.StatusInternalServerError ||
+			statusErr.code == fasthttp.StatusTooManyRequests
+	}
+	return true
+}
+
+func unwrapTransportError(err error) error {
+	if transportErr, ok := err.(*transportError); ok {
+		return transportErr.err
+	}
+	return err
+}
+
+// doHttpRequestWithClient send request by httpCli, if httpCli is nil,
+// the client in context will be used
+func (c *HTTPCaller) doHttpRequestWithClient(reqID, url string, headers map[string]string,
+	reqBytes []byte, timeout time.Duration, httpCli *fasthttp.HostClient, abandoned <-chan struct{}) ([]byte, error) {
 	request := c.acquireRequest(url, headers, reqBytes)
 	response := fasthttp.AcquireResponse()
 	defer func() {
@@ -234,7 +426,18 @@ func (c *HTTPCaller) doHttpRequest(reqID, url string, headers map[string]string,
 	c.withAuthHeaders(request, reqBytes)
 	start := time.Now()
 	logs.Trace("http request header:\n%s", string(request.Header.Header()))
-	err := c.smartDoRequest(timeout, request, response)
+	var err error
+	if httpCli != nil && abandoned != nil {
+		err = doAbandonableRequest(httpCli, timeout, request, response, abandoned)
+		if err == errRequestAbandoned {
+			logs.Debug("http request abandoned, url:%s", url)
+			return nil, err
+		}
+	} else if httpCli != nil {
+		err = doRequestWithHostClient(httpCli, timeout, request, response)
+	} else {
+		err = c.smartDoRequest(timeout, request, response)
+	}
 	cost := time.Now().Sub(start)
 	defer func() {
 		metricsTags := []string{
@@ -258,7 +461,7 @@ func (c *HTTPCaller) doHttpRequest(reqID, url string, headers map[string]string,
 			metrics.Error(reqID, "[ByteplusSDK] do http request timeout, tenant:%s, url:%s, cost:%dms, err:%v",
 				c.context.Tenant(), url, cost.Milliseconds(), err)
 			logs.Error("do http request timeout, msg:%s url:%s", err.Error(), url)
-			return nil, errors.New(netErrMark + " timeout")
+			return nil, &transportError{err: errors.New(netErrMark + " timeout")}
 		}
 		metricsTags := []string{
 			"type:request_occur_err",
@@ -269,12 +472,12 @@ func (c *HTTPCaller) doHttpRequest(reqID, url string, headers map[string]string,
 		metrics.Error(reqID, "[ByteplusSDK] do http request occur err, tenant:%s, url:%s, err:%v",
 			c.context.Tenant(), url, err)
 		logs.Error("do http request occur error, msg:%s url:%s", err.Error(), url)
-		return nil, err
+		return nil, &transportError{err: err}
 	}
 	logs.Trace("http response headers:\n%s", string(response.Header.Header()))
 	if response.StatusCode() != fasthttp.StatusOK {
 		c.logHttpResponse(reqID, url, response)
-		return nil, errors.New(netErrMark + "http status not 200")
+		return nil, &httpStatusError{code: response.StatusCode()}
 	}
 	return decompressResponse(url, response)
 }
@@ -315,6 +518,45 @@ func (c *HTTPCaller) smartDoRequest(timeout time.Duration,
 	return err
 }
 
+func doRequestWithHostClient(httpCli *fasthttp.HostClient, timeout time.Duration,
+	request *fasthttp.Request, response *fasthttp.Response) error {
+	if timeout > 0 {
+		return httpCli.DoTimeout(request, response, timeout)
+	}
+	return httpCli.Do(request, response)
+}
+
+// doAbandonableRequest is the same as doRequestWithHostClient, but returns
+// errRequestAbandoned as soon as `abandoned` is closed. The abandoned request is
+// still bounded by timeout, it's sent with copies of request and response, which
+// are released when it finishes
+func doAbandonableRequest(httpCli *fasthttp.HostClient, timeout time.Duration,
+	request *fasthttp.Request, response *fasthttp.Response, abandoned <-chan struct{}) error {
+	requestCopy := fasthttp.AcquireRequest()
+	request.CopyTo(requestCopy)
+	responseCopy := fasthttp.AcquireResponse()
+	done := make(chan error, 1)
+	AsyncExecute(func() {
+		done <- doRequestWithHostClient(httpCli, timeout, requestCopy, responseCopy)
+	})
+	release := func() {
+		fasthttp.ReleaseRequest(requestCopy)
+		fasthttp.ReleaseResponse(responseCopy)
+	}
+	select {
+	case err := <-done:
+		responseCopy.CopyTo(response)
+		release()
+		return err
+	case <-abandoned:
+		AsyncExecute(func() {
+			<-done
+			release()
+		})
+		return errRequestAbandoned
+	}
+}
+
 func (c *HTTPCaller) logHttpResponse(reqID, url string, response *fasthttp.Response) {
 	metricsTags := []string{
 		"type:rsp_status_not_ok",
@@ -350,7 +592,8 @@ func decompressResponse(url string, response *fasthttp.Response) ([]byte, error)
 		}
 		return respBodyBytes, nil
 	case "":
-		return response.Body(), nil
+		// response will be released after return, so the body should be copied
+		return append([]byte(nil), response.Body()...), nil
 	default:
 		logs.Error("receive unsupported response content encoding:%s url:%s header:\n%s",
 			contentEncoding, url, &response.Header)
@@ -0,0 +1,74 @@
+package core
+
+const (
+	AlteredReasonKept     = "kept"
+	AlteredReasonInserted = "inserted"
+	AlteredReasonFiltered = "filtered"
+	AlteredReasonFilled   = "filled"
+)
+
+// AlteredItem is an altered item of AckServerImpressionsRequest
+type AlteredItem struct {
+	Id     string
+	Reason string
+	// 1-based rank in the displayed list, 0 for the filtered items
+	Rank int32
+}
+
+// DiffImpressions compares the predicted items with the displayed ones, the items
+// displayed are returned in the displayed order with their final ranks, which are
+// "kept" if they are predicted, otherwise "inserted". Then the predicted items not
+// displayed are returned in the predicted order with rank 0, whose reason is in
+// filteredReasons or "filtered" by default. Duplicated displayed items are
+// regarded as displayed only at the first position
+func DiffImpressions(predictedIds []string, displayedIds []string,
+	filteredReasons map[string]string) []*AlteredItem {
+	return diffImpressions(predictedIds, displayedIds, filteredReasons, false)
+}
+
+// DiffFilledImpressions is the same as DiffImpressions, except that the items
+// displayed after the last "kept" one are "filled" instead of "inserted", as they
+// fill in the list when the predicted items are insufficient
+func DiffFilledImpressions(predictedIds []string, displayedIds []string,
+	filteredReasons map[string]string) []*AlteredItem {
+	return diffImpressions(predictedIds, displayedIds, filteredReasons, true)
+}
+
+func diffImpressions(predictedIds []string, displayedIds []string,
+	filteredReasons map[string]string, fill bool) []*AlteredItem {
+	predicted := make(map[string]bool, len(predictedIds))
+	for _, id := range predictedIds {
+		predicted[id] = true
+	}
+	displayed := make(map[string]bool, len(displayedIds))
+	items := make([]*AlteredItem, 0, len(displayedIds)+len(predictedIds))
+	for _, id := range displayedIds {
+		if displayed[id] {
+			continue
+		}
+		displayed[id] = true
+		reason := AlteredReasonInserted
+		if predicted[id] {
+			reason = AlteredReasonKept
+		}
+		items = append(items, &AlteredItem{Id: id, Reason: reason, Rank: int32(len(displayed))})
+	}
+	if fill {
+		for i := len(items) - 1; i >= 0 && items[i].Reason != AlteredReasonKept; i-- {
+			items[i].Reason = AlteredReasonFilled
+		}
+	}
+	for _, id := range predictedIds {
+		if displayed[id] {
+			continue
+		}
+		// avoid adding the duplicated predicted items twice
+		displayed[id] = true
+		reason := filteredReasons[id]
+		if reason == "" {
+			reason = AlteredReasonFiltered
+		}
+		items = append(items, &AlteredItem{Id: id, Reason: reason})
+	}
+	return items
+}
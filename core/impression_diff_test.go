@@ -0,0 +1,102 @@
+package core
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestDiffImpressions(t *testing.T) {
+	tests := []struct {
+		name            string
+		predicted       []string
+		displayed       []string
+		filteredReasons map[string]string
+		want            []*AlteredItem
+	}{
+		{
+			name:      "kept_inserted_filtered",
+			predicted: []string{"1", "2", "3", "4"},
+			displayed: []string{"1", "10", "2", "4"},
+			want: []*AlteredItem{
+				{Id: "1", Reason: AlteredReasonKept, Rank: 1},
+				{Id: "10", Reason: AlteredReasonInserted, Rank: 2},
+				{Id: "2", Reason: AlteredReasonKept, Rank: 3},
+				{Id: "4", Reason: AlteredReasonKept, Rank: 4},
+				{Id: "3", Reason: AlteredReasonFiltered},
+			},
+		},
+		{
+			name:            "custom_reason_and_duplicates",
+			predicted:       []string{"1", "2", "2"},
+			displayed:       []string{"2", "2"},
+			filteredReasons: map[string]string{"1": "sold_out"},
+			want: []*AlteredItem{
+				{Id: "2", Reason: AlteredReasonKept, Rank: 1},
+				{Id: "1", Reason: "sold_out"},
+			},
+		},
+		{
+			name:      "nothing_displayed",
+			predicted: []string{"1"},
+			want:      []*AlteredItem{{Id: "1", Reason: AlteredReasonFiltered}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := DiffImpressions(tt.predicted, tt.displayed, tt.filteredReasons)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("DiffImpressions() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDiffFilledImpressions(t *testing.T) {
+	tests := []struct {
+		name      string
+		predicted []string
+		displayed []string
+		want      []*AlteredItem
+	}{
+		{
+			name:      "filled_after_last_kept",
+			predicted: []string{"1", "2", "3", "4"},
+			displayed: []string{"1", "10", "2", "4", "20", "21"},
+			want: []*AlteredItem{
+				{Id: "1", Reason: AlteredReasonKept, Rank: 1},
+				{Id: "10", Reason: AlteredReasonInserted, Rank: 2},
+				{Id: "2", Reason: AlteredReasonKept, Rank: 3},
+				{Id: "4", Reason: AlteredReasonKept, Rank: 4},
+				{Id: "20", Reason: AlteredReasonFilled, Rank: 5},
+				{Id: "21", Reason: AlteredReasonFilled, Rank: 6},
+				{Id: "3", Reason: AlteredReasonFiltered},
+			},
+		},
+		{
+			name:      "nothing_kept",
+			predicted: []string{"1"},
+			displayed: []string{"20"},
+			want: []*AlteredItem{
+				{Id: "20", Reason: AlteredReasonFilled, Rank: 1},
+				{Id: "1", Reason: AlteredReasonFiltered},
+			},
+		},
+		{
+			name:      "last_kept",
+			predicted: []string{"1"},
+			displayed: []string{"10", "1"},
+			want: []*AlteredItem{
+				{Id: "10", Reason: AlteredReasonInserted, Rank: 1},
+				{Id: "1", Reason: AlteredReasonKept, Rank: 2},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := DiffFilledImpressions(tt.predicted, tt.displayed, nil)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("DiffFilledImpressions() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,175 @@
+package core
+
+import (
+	"math/rand"
+	"strings"
+	"sync/atomic"
+
+	"github.com/valyala/fasthttp"
+)
+
+type LoadBalanceStrategy int
+
+const (
+	// LoadBalanceNone send all requests to the current host, other hosts
+	// are only used when the current host is unavailable
+	LoadBalanceNone LoadBalanceStrategy = iota
+	// LoadBalanceRoundRobin send requests to the available hosts in turn
+	LoadBalanceRoundRobin
+	// LoadBalanceWeightedRandom choose a random available host, the probability
+	// is proportional to the host weight and the success rate of its pings
+	LoadBalanceWeightedRandom
+	// LoadBalanceLeastOutstanding choose the available host with the least requests in flight
+	LoadBalanceLeastOutstanding
+)
+
+// the weight of a host with high failure rate won't be lower than this ratio,
+// so that it still has a chance to be chosen before it is removed from available hosts
+const minHealthWeightRatio = 0.01
+
+func (receiver *HostAvailabler) loadBalanceEnabled() bool {
+	return receiver.config.LoadBalanceStrategy != LoadBalanceNone
+}
+
+func (receiver *HostAvailabler) initRequestClients() {
+	hosts := receiver.context.hosts
+	receiver.hostRequestCliMap = make(map[string]*fasthttp.HostClient, len(hosts))
+	receiver.hostOutstanding = make(map[string]*int64, len(hosts))
+	for _, host := range hosts {
+		receiver.hostRequestCliMap[host] = &fasthttp.HostClient{
+			Addr:  host,
+			IsTLS: receiver.context.Schema() == "https",
+		}
+		receiver.hostOutstanding[host] = new(int64)
+	}
+}
+
+// pickHost choose a host for the next request according to LoadBalanceStrategy,
+// hosts in `excluded` will be skipped. The second return value is false when
+// there is no host can be chosen
+func (receiver *HostAvailabler) pickHost(excluded map[string]bool) (string, bool) {
+	candidates := receiver.loadBalanceCandidates(excluded)
+	if len(candidates) == 0 {
+		return "", false
+	}
+	switch receiver.config.LoadBalanceStrategy {
+	case LoadBalanceWeightedRandom:
+		return receiver.pickWeightedRandom(candidates), true
+	case LoadBalanceLeastOutstanding:
+		return receiver.pickLeastOutstanding(candidates), true
+	default:
+		index := atomic.AddUint64(&receiver.roundRobinIndex, 1)
+		return candidates[index%uint64(len(candidates))], true
+	}
+}
+
+func (receiver *HostAvailabler) loadBalanceCandidates(excluded map[string]bool) []string {
+	receiver.lock.RLock()
+	defer receiver.lock.RUnlock()
+	hosts := receiver.availableHosts
+	if len(hosts) == 0 {
+		// all hosts are unavailable, try all of them instead of failing directly
+		hosts = receiver.context.hosts
+	}
+	candidates := make([]string, 0, len(hosts))
+	for _, host := range hosts {
+		if !excluded[host] {
+			candidates = append(candidates, host)
+		}
+	}
+	return candidates
+}
+
+func (receiver *HostAvailabler) pickWeightedRandom(candidates []string) string {
+	weights := make([]float64, len(candidates))
+	var totalWeight float64
+	receiver.lock.RLock()
+	for i, host := range candidates {
+		weight := 1.0
+		if configWeight, exist := receiver.config.HostWeights[host]; exist {
+			weight = float64(configWeight)
+		}
+		if winObj, exist := receiver.hostWindowMap[host]; exist {
+			healthRatio := 1 - winObj.failureRate()
+			if healthRatio < minHealthWeightRatio {
+				healthRatio = minHealthWeightRatio
+			}
+			weight *= healthRatio
+		}
+		if weight < 0 {
+			weight = 0
+		}
+		weights[i] = weight
+		totalWeight += weight
+	}
+	receiver.lock.RUnlock()
+	if totalWeight <= 0 {
+		return candidates[rand.Intn(len(candidates))]
+	}
+	point := rand.Float64() * totalWeight
+	for i, weight := range weights {
+		point -= weight
+		if point < 0 {
+			return candidates[i]
+		}
+	}
+	return candidates[len(candidates)-1]
+}
+
+func (receiver *HostAvailabler) pickLeastOutstanding(candidates []string) string {
+	result := candidates[0]
+	least := atomic.LoadInt64(receiver.hostOutstanding[result])
+	for _, host := range candidates[1:] {
+		outstanding := atomic.LoadInt64(receiver.hostOutstanding[host])
+		if outstanding < least {
+			result, least = host, outstanding
+		}
+	}
+	return result
+}
+
+// acquireHost mark a request is sent to the host, the returned function
+// should be called after the request finished
+func (receiver *HostAvailabler) acquireHost(host string) func() {
+	outstanding, exist := receiver.hostOutstanding[host]
+	if !exist {
+		return func() {}
+	}
+	atomic.AddInt64(outstanding, 1)
+	return func() {
+		atomic.AddInt64(outstanding, -1)
+	}
+}
+
+func (receiver *HostAvailabler) requestClient(host string) *fasthttp.HostClient {
+	return receiver.hostRequestCliMap[host]
+}
+
+// replaceURLHost replace the host part of url, e.g.
+// replaceURLHost("https://a.com/predict/api?x=1", "b.com") returns "https://b.com/predict/api?x=1"
+func replaceURLHost(url string, host string) string {
+	schemaEnd := strings.Index(url, "://")
+	if schemaEnd < 0 {
+		return url
+	}
+	hostStart := schemaEnd + len("://")
+	pathStart := strings.IndexByte(url[hostStart:], '/')
+	if pathStart < 0 {
+		return url[:hostStart] + host
+	}
+	return url[:hostStart] + host + url[hostStart+pathStart:]
+}
+
+// urlHost returns the host part of url, e.g.
+// urlHost("https://a.com/predict/api?x=1") returns "a.com"
+func urlHost(url string) string {
+	schemaEnd := strings.Index(url, "://")
+	if schemaEnd < 0 {
+		return ""
+	}
+	host := url[schemaEnd+len("://"):]
+	if pathStart := strings.IndexByte(host, '/'); pathStart >= 0 {
+		host = host[:pathStart]
+	}
+	return host
+}
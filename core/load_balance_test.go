@@ -0,0 +1,127 @@
+package core
+
+import (
+	"errors"
+	"testing"
+)
+
+func Test_replaceURLHost(t *testing.T) {
+	tests := []struct {
+		name string
+		url  string
+		host string
+		want string
+	}{
+		{
+			name: "with_path_and_query",
+			url:  "https://a.com/predict/api/retail/demo/home?stage=pre",
+			host: "b.com",
+			want: "https://b.com/predict/api/retail/demo/home?stage=pre",
+		},
+		{
+			name: "without_path",
+			url:  "http://a.com",
+			host: "b.com",
+			want: "http://b.com",
+		},
+		{
+			name: "without_schema",
+			url:  "a.com/predict",
+			host: "b.com",
+			want: "a.com/predict",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := replaceURLHost(tt.url, tt.host); got != tt.want {
+				t.Errorf("replaceURLHost() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func newTestLoadBalancer(strategy LoadBalanceStrategy, hosts []string) *HostAvailabler {
+	availabler := newTestHostAvailabler(hosts, nil)
+	availabler.context.schema = "https"
+	availabler.config.LoadBalanceStrategy = strategy
+	availabler.initRequestClients()
+	return availabler
+}
+
+func TestHostAvailabler_pickHost(t *testing.T) {
+	hosts := []string{"host-a", "host-b"}
+
+	roundRobin := newTestLoadBalancer(LoadBalanceRoundRobin, hosts)
+	first, _ := roundRobin.pickHost(nil)
+	second, _ := roundRobin.pickHost(nil)
+	if first == second {
+		t.Errorf("round robin picked %v twice", first)
+	}
+
+	leastOutstanding := newTestLoadBalancer(LoadBalanceLeastOutstanding, hosts)
+	release := leastOutstanding.acquireHost("host-a")
+	if got, _ := leastOutstanding.pickHost(nil); got != "host-b" {
+		t.Errorf("least outstanding picked %v, want host-b", got)
+	}
+	release()
+
+	weightedRandom := newTestLoadBalancer(LoadBalanceWeightedRandom, hosts)
+	weightedRandom.config.HostWeights = map[string]int{"host-a": 0}
+	for i := 0; i < 10; i++ {
+		if got, _ := weightedRandom.pickHost(nil); got != "host-b" {
+			t.Fatalf("weighted random picked %v, want host-b", got)
+		}
+	}
+
+	if got, _ := roundRobin.pickHost(map[string]bool{"host-a": true}); got != "host-b" {
+		t.Errorf("pickHost() with excluded host-a = %v, want host-b", got)
+	}
+	if _, ok := roundRobin.pickHost(map[string]bool{"host-a": true, "host-b": true}); ok {
+		t.Errorf("pickHost() with all hosts excluded should fail")
+	}
+}
+
+func TestHTTPCaller_shouldTryAnotherHost(t *testing.T) {
+	availabler := newTestLoadBalancer(LoadBalanceRoundRobin, []string{"host-a", "host-b"})
+	availabler.context.hostAvailabler = availabler
+	caller := &HTTPCaller{context: availabler.context}
+	transportErr := &transportError{err: errors.New("connection reset")}
+	tests := []struct {
+		name string
+		url  string
+		err  error
+		want bool
+	}{
+		{
+			name: "predict_transport_error",
+			url:  "https://host-a/predict/api/retail/demo/home",
+			err:  transportErr,
+			want: true,
+		},
+		{
+			name: "write_transport_error",
+			url:  "https://host-a/data/api/retail/demo/user?method=write",
+			err:  transportErr,
+			want: false,
+		},
+		{
+			name: "write_circuit_open",
+			url:  "https://host-a/data/api/retail/demo/user?method=write",
+			err:  &CircuitOpenError{Host: "host-a", Endpoint: EndpointWrite},
+			want: true,
+		},
+		{
+			name: "predict_status_error",
+			url:  "https://host-a/predict/api/retail/demo/home",
+			err:  &httpStatusError{code: 500},
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := caller.shouldTryAnotherHost(tt.url, tt.err); got != tt.want {
+				t.Errorf("shouldTryAnotherHost() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
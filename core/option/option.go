@@ -67,3 +67,24 @@ func WithScene(scene string) Option {
 		options.Scene = scene
 	}
 }
+
+func WithDateLocation(location *time.Location) Option {
+	return func(options *Options) {
+		options.DateLocation = location
+	}
+}
+
+func WithValidation() Option {
+	return func(options *Options) {
+		options.Validate = true
+	}
+}
+
+// WithoutDeadLetter skips writing the items failed in the request to the
+// DeadLetterSink of client, it's used by the retry helpers which write the items
+// never succeeded after retries by themselves
+func WithoutDeadLetter() Option {
+	return func(options *Options) {
+		options.SkipDeadLetter = true
+	}
+}
@@ -3,13 +3,16 @@ package option
 import "time"
 
 type Options struct {
-	Timeout       time.Duration
-	RequestId     string
-	Headers       map[string]string
-	DataDate      time.Time
-	DataIsEnd     bool
-	ServerTimeout time.Duration
-	Stage         string
-	Queries       map[string]string
-	Scene         string
+	Timeout        time.Duration
+	RequestId      string
+	Headers        map[string]string
+	DataDate       time.Time
+	DataIsEnd      bool
+	ServerTimeout  time.Duration
+	Stage          string
+	Queries        map[string]string
+	Scene          string
+	DateLocation   *time.Location
+	Validate       bool
+	SkipDeadLetter bool
 }
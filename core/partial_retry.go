@@ -0,0 +1,335 @@
+package core
+
+import (
+	"errors"
+	"regexp"
+	"time"
+
+	"github.com/byteplus-sdk/sdk-go/core/logs"
+	"github.com/byteplus-sdk/sdk-go/core/option"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	defaultPartialMaxRetries     = 3
+	defaultPartialInitialBackoff = 500 * time.Millisecond
+	defaultPartialMaxBackoff     = 10 * time.Second
+)
+
+// the error messages of items which are retryable by default
+var defaultRetryablePatterns = []string{
+	"(?i)timeout",
+	"(?i)time out",
+	"(?i)too many",
+	"(?i)internal",
+	"(?i)unavailable",
+	"(?i)busy",
+	"(?i)try again",
+}
+
+// defaultRetryableRegexps is the compiled defaultRetryablePatterns
+var defaultRetryableRegexps, _ = compileRetryablePatterns(defaultRetryablePatterns)
+
+type PartialRetryConfig struct {
+	// Regular expressions of item error message, an item is retried only
+	// when its error message matches one of them, otherwise it's regarded
+	// as permanent failure. Default patterns match messages like timeout,
+	// too many requests, internal error, service unavailable and busy
+	RetryablePatterns []string
+	// Max times to resubmit the retryable items, default is 3,
+	// negative value means never retry
+	MaxRetries int
+	// The backoff before each retry is doubled from InitialBackoff until
+	// reaching MaxBackoff, default is 500ms and 10s
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+	// The items never succeeded are written to DeadLetterSink if it's set,
+	// so that they can be replayed later
+	DeadLetterSink DeadLetterSink
+	// Optional, prepended to the "Request-Id" generated for each request, the
+	// retry helpers of solutions set it by the "Request-Id" in their options,
+	// see RetryConfigWithRequestId
+	RequestIdPrefix string
+}
+
+// RequestIdPrefix returns the "Request-Id" set in opts followed by "-", or empty
+// if it's not set. A new "Request-Id" is required for each resubmitting, so the
+// caller's id is kept as the prefix of the generated ones to trace the requests
+func RequestIdPrefix(opts []option.Option) string {
+	requestId := option.Conv2Options(opts...).RequestId
+	if requestId == "" {
+		return ""
+	}
+	return requestId + "-"
+}
+
+// RetryOptions appends the options of a request sent by the retry helpers of
+// solutions after opts without modifying opts. The requestId generated for the
+// request overrides the one in opts, which is kept as its prefix by RequestIdPrefix.
+// The failed items are written to dead letters by the helpers after retries
+// rather than the client
+func RetryOptions(opts []option.Option, requestId string) []option.Option {
+	return append(opts[:len(opts):len(opts)], option.WithRequestId(requestId), option.WithoutDeadLetter())
+}
+
+// RetryConfigWithRequestId returns a copy of config whose RequestIdPrefix is set
+// by RequestIdPrefix(opts), or config itself if opts has no "Request-Id"
+func RetryConfigWithRequestId(config *PartialRetryConfig, opts []option.Option) *PartialRetryConfig {
+	prefix := RequestIdPrefix(opts)
+	if prefix == "" {
+		return config
+	}
+	result := &PartialRetryConfig{}
+	if config != nil {
+		*result = *config
+	}
+	result.RequestIdPrefix = prefix
+	return result
+}
+
+// FailedItem is an item failed to be written
+type FailedItem struct {
+	// The item of the request, e.g. *retail/protocol.User
+	Item interface{}
+	// Error message of the item, or the error of the whole request
+	Message   string
+	Retryable bool
+	// Set by FailedItemsFunc if the item can't be resubmitted, e.g. it can't be
+	// decoded from the error, then it's never retried whatever the message is
+	Permanent bool
+	// "Request-Id" of the last request writing the item
+	RequestId string
+}
+
+// PartialRetryReport is the final result of writing with RetryPartialFailures
+type PartialRetryReport struct {
+	Total     int
+	Succeeded int
+	// Times of resubmitting
+	Retries int
+	// Items never succeeded, including the permanent failures
+	// and the retryable ones still failed after MaxRetries
+	Failed []*FailedItem
+}
+
+// PartialWriteFunc writes items in one request with the given "Request-Id",
+// a new "Request-Id" is generated for each retry, so that the server won't
+// reject the resubmitted items as duplicated request. The given "Request-Id"
+// should override the one in the options of the request
+type PartialWriteFunc func(items []interface{}, requestId string) (proto.Message, error)
+
+// FailedItemsFunc extracts the failed items from the response of PartialWriteFunc,
+// `items` are the ones written in the request, which the failures echoed by server
+// should be mapped back to, e.g. by ItemMatcher, so that the original items rather
+// than the echoes are resubmitted
+type FailedItemsFunc func(items []interface{}, response proto.Message) []*FailedItem
+
+// ItemMatcher maps the items echoed in the errors of a write response back to the
+// items of the request by key, e.g. the id of item
+type ItemMatcher struct {
+	key   func(item interface{}) string
+	items map[string][]interface{}
+}
+
+func NewItemMatcher(items []interface{}, key func(item interface{}) string) *ItemMatcher {
+	matcher := &ItemMatcher{key: key, items: make(map[string][]interface{}, len(items))}
+	for _, item := range items {
+		k := key(item)
+		matcher.items[k] = append(matcher.items[k], item)
+	}
+	return matcher
+}
+
+// Match returns the FailedItem of the item whose key is the same as echo's, the
+// items with the same key are matched in order. If echo is nil or no item is
+// left for its key, the failure is permanent as there is nothing to resubmit
+func (receiver *ItemMatcher) Match(echo proto.Message, message string) *FailedItem {
+	if echo == nil || !echo.ProtoReflect().IsValid() {
+		return &FailedItem{Message: message, Permanent: true}
+	}
+	k := receiver.key(echo)
+	items := receiver.items[k]
+	if len(items) == 0 {
+		return &FailedItem{Item: echo, Message: message, Permanent: true}
+	}
+	receiver.items[k] = items[1:]
+	return &FailedItem{Item: items[0], Message: message}
+}
+
+// RetryPartialFailures writes items by `write`, and then resubmits only
+// the items failed with retryable errors with backoff, the items succeeded
+// are never resubmitted. The returned error is the error of the last
+// request if it failed entirely
+func RetryPartialFailures(config *PartialRetryConfig, items []interface{},
+	write PartialWriteFunc, failedItems FailedItemsFunc) (*PartialRetryReport, error) {
+	return retryPartialFailures(config, items, write, failedItems, nil)
+}
+
+// errRetryStopped is returned by retryPartialFailures if it's stopped during backoff
+var errRetryStopped = errors.New("retry is stopped")
+
+// retryPartialFailures is RetryPartialFailures whose backoff is interrupted when
+// stop is closed, then it returns errRetryStopped without writing dead letters
+func retryPartialFailures(config *PartialRetryConfig, items []interface{}, write PartialWriteFunc,
+	failedItems FailedItemsFunc, stop <-chan struct{}) (*PartialRetryReport, error) {
+	config = fillDefaultPartialRetryConfig(config)
+	retryablePatterns, err := compileRetryablePatterns(config.RetryablePatterns)
+	if err != nil {
+		return nil, err
+	}
+	report := &PartialRetryReport{Total: len(items)}
+	pending := items
+	backoff := config.InitialBackoff
+	var lastErr error
+	for attempt := 0; len(pending) > 0; attempt++ {
+		if attempt > 0 {
+			if !sleepOrStop(backoff, stop) {
+				return report, errRetryStopped
+			}
+			backoff *= 2
+			if backoff > config.MaxBackoff {
+				backoff = config.MaxBackoff
+			}
+			report.Retries++
+		}
+		var failed []*FailedItem
+		failed, lastErr = writeOnce(pending, config.RequestIdPrefix, write, failedItems, retryablePatterns)
+		var retryItems []interface{}
+		var retryFailed []*FailedItem
+		for _, item := range failed {
+			if item.Retryable {
+				retryItems = append(retryItems, item.Item)
+				retryFailed = append(retryFailed, item)
+				continue
+			}
+			report.Failed = append(report.Failed, item)
+		}
+		report.Succeeded += len(pending) - len(failed)
+		if attempt >= config.MaxRetries {
+			report.Failed = append(report.Failed, retryFailed...)
+			break
+		}
+		if len(retryItems) > 0 {
+			logs.Warn("%d items failed with retryable errors, retry after %v", len(retryItems), backoff)
+		}
+		pending = retryItems
+	}
+	if config.DeadLetterSink != nil && len(report.Failed) > 0 {
+		if err := config.DeadLetterSink.Write(newDeadLetters(report.Failed)); err != nil {
+			logs.Error("write %d dead letters fail, err:%s", len(report.Failed), err.Error())
+		}
+	}
+	return report, lastErr
+}
+
+// sleepOrStop sleeps for d, returns false if stop is closed before that
+func sleepOrStop(d time.Duration, stop <-chan struct{}) bool {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-stop:
+		return false
+	case <-timer.C:
+		return true
+	}
+}
+
+func writeOnce(items []interface{}, requestIdPrefix string, write PartialWriteFunc,
+	failedItems FailedItemsFunc, retryablePatterns []*regexp.Regexp) ([]*FailedItem, error) {
+	requestId := requestIdPrefix + uuid.NewString()
+	response, err := write(items, requestId)
+	failed, err := checkWriteResult(items, response, err, failedItems, retryablePatterns)
+	for _, item := range failed {
+		item.RequestId = requestId
+	}
+	return failed, err
+}
+
+// checkWriteResult returns the failed items of a write request, which are all
+// the items if the request failed entirely, and the error of the whole request
+func checkWriteResult(items []interface{}, response proto.Message, err error,
+	failedItems FailedItemsFunc, retryablePatterns []*regexp.Regexp) ([]*FailedItem, error) {
+	if statusErr, ok := AsStatusError(err); ok {
+		response, err = statusErr.Response, nil
+	}
+	if err != nil {
+		return allItemsFailed(items, err.Error(), isRetryableRequestError(err)), err
+	}
+	failed := failedItems(items, response)
+	if len(failed) > 0 {
+		for _, item := range failed {
+			item.Retryable = !item.Permanent && matchAny(retryablePatterns, item.Message)
+		}
+		return failed, nil
+	}
+	code, message, ok := responseStatus(response)
+	if !ok || code == StatusCodeSuccess || code == StatusCodeIdempotent {
+		return nil, nil
+	}
+	// the whole request failed without per-item errors
+	statusErr := &StatusError{Code: code, Message: message, Response: response}
+	return allItemsFailed(items, statusErr.Error(), isRetryableStatusCode(code)), statusErr
+}
+
+func allItemsFailed(items []interface{}, message string, retryable bool) []*FailedItem {
+	failed := make([]*FailedItem, 0, len(items))
+	for _, item := range items {
+		failed = append(failed, &FailedItem{Item: item, Message: message, Retryable: retryable})
+	}
+	return failed
+}
+
+func isRetryableRequestError(err error) bool {
+	return IsNetError(err) || IsTimeoutError(err) || IsRateLimitError(err) || IsCircuitOpenError(err)
+}
+
+func isRetryableStatusCode(code int32) bool {
+	return code == StatusCodeTooManyRequest || code == StatusCodeOperationLoss || code >= 500
+}
+
+func fillDefaultPartialRetryConfig(config *PartialRetryConfig) *PartialRetryConfig {
+	result := &PartialRetryConfig{}
+	if config != nil {
+		*result = *config
+	}
+	if len(result.RetryablePatterns) == 0 {
+		result.RetryablePatterns = defaultRetryablePatterns
+	}
+	if result.MaxRetries < 0 {
+		result.MaxRetries = 0
+	} else if result.MaxRetries == 0 {
+		result.MaxRetries = defaultPartialMaxRetries
+	}
+	if result.InitialBackoff <= 0 {
+		result.InitialBackoff = defaultPartialInitialBackoff
+	}
+	if result.MaxBackoff <= 0 {
+		result.MaxBackoff = defaultPartialMaxBackoff
+	}
+	if result.MaxBackoff < result.InitialBackoff {
+		result.MaxBackoff = result.InitialBackoff
+	}
+	return result
+}
+
+func compileRetryablePatterns(patterns []string) ([]*regexp.Regexp, error) {
+	result := make([]*regexp.Regexp, 0, len(patterns))
+	for _, pattern := range patterns {
+		compiled, err := regexp.Compile(pattern)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, compiled)
+	}
+	return result, nil
+}
+
+func matchAny(patterns []*regexp.Regexp, message string) bool {
+	for _, pattern := range patterns {
+		if pattern.MatchString(message) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,137 @@
+package core
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/byteplus-sdk/sdk-go/common/protocol"
+	"github.com/byteplus-sdk/sdk-go/core/option"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestRetryPartialFailures(t *testing.T) {
+	// item "a" always succeeds, "b" fails with a permanent error,
+	// "c" succeeds after timed out once, "d" always times out
+	failedMessages := map[string]string{"b": "invalid user_id", "c": "write timeout", "d": "server busy"}
+	var attempts [][]interface{}
+	write := func(items []interface{}, requestId string) (proto.Message, error) {
+		attempts = append(attempts, items)
+		return &protocol.Status{Code: StatusCodeSuccess}, nil
+	}
+	failedItems := func(items []interface{}, response proto.Message) []*FailedItem {
+		var result []*FailedItem
+		for _, item := range attempts[len(attempts)-1] {
+			message, exist := failedMessages[item.(string)]
+			if !exist || (item == "c" && len(attempts) > 1) {
+				continue
+			}
+			result = append(result, &FailedItem{Item: item, Message: message})
+		}
+		return result
+	}
+	config := &PartialRetryConfig{MaxRetries: 2, InitialBackoff: time.Millisecond}
+	report, err := RetryPartialFailures(config, []interface{}{"a", "b", "c", "d"}, write, failedItems)
+	if err != nil {
+		t.Fatalf("RetryPartialFailures() error = %v", err)
+	}
+	if len(attempts) != 3 || len(attempts[1]) != 2 || len(attempts[2]) != 1 || attempts[2][0] != "d" {
+		t.Errorf("resubmitted items = %v", attempts)
+	}
+	if report.Total != 4 || report.Succeeded != 2 || report.Retries != 2 || len(report.Failed) != 2 {
+		t.Errorf("unexpected report %+v", report)
+	}
+	for _, failed := range report.Failed {
+		if (failed.Item == "b") == failed.Retryable {
+			t.Errorf("unexpected failed item %+v", failed)
+		}
+	}
+}
+
+func TestRetryPartialFailures_statusError(t *testing.T) {
+	var attempts int
+	write := func(items []interface{}, requestId string) (proto.Message, error) {
+		attempts++
+		if attempts == 1 {
+			return &protocol.Status{Code: StatusCodeTooManyRequest}, nil
+		}
+		return &protocol.Status{Code: 400}, nil
+	}
+	noFailedItems := func(items []interface{}, response proto.Message) []*FailedItem {
+		return nil
+	}
+	config := &PartialRetryConfig{InitialBackoff: time.Millisecond}
+	report, err := RetryPartialFailures(config, []interface{}{"a", "b"}, write, noFailedItems)
+	if _, ok := AsStatusError(err); !ok {
+		t.Errorf("RetryPartialFailures() error = %v, want StatusError", err)
+	}
+	if attempts != 2 || report.Succeeded != 0 || len(report.Failed) != 2 || report.Failed[0].Retryable {
+		t.Errorf("attempts = %d, unexpected report %+v", attempts, report)
+	}
+}
+
+func TestRetryPartialFailures_permanent(t *testing.T) {
+	var attempts int
+	write := func(items []interface{}, requestId string) (proto.Message, error) {
+		attempts++
+		return &protocol.Status{Code: StatusCodeSuccess}, nil
+	}
+	// the message is retryable, but the item can't be resubmitted
+	undecodable := func(items []interface{}, response proto.Message) []*FailedItem {
+		return []*FailedItem{{Item: "raw", Message: "write timeout", Permanent: true}}
+	}
+	config := &PartialRetryConfig{InitialBackoff: time.Millisecond}
+	report, err := RetryPartialFailures(config, []interface{}{"a", "b"}, write, undecodable)
+	if err != nil {
+		t.Fatalf("RetryPartialFailures() error = %v", err)
+	}
+	if attempts != 1 || report.Succeeded != 1 || len(report.Failed) != 1 || report.Failed[0].Retryable {
+		t.Errorf("attempts = %d, unexpected report %+v", attempts, report)
+	}
+}
+
+func TestItemMatcher_Match(t *testing.T) {
+	first := &protocol.Status{Code: 1, Message: "first"}
+	second := &protocol.Status{Code: 1, Message: "second"}
+	other := &protocol.Status{Code: 2}
+	codeKey := func(item interface{}) string {
+		return fmt.Sprint(item.(*protocol.Status).GetCode())
+	}
+	matcher := NewItemMatcher([]interface{}{first, other, second}, codeKey)
+	var nilEcho *protocol.Status
+	tests := []struct {
+		name          string
+		echo          proto.Message
+		wantItem      interface{}
+		wantPermanent bool
+	}{
+		// the echo may be changed by server, the original item is resubmitted
+		{name: "original", echo: &protocol.Status{Code: 1}, wantItem: first},
+		{name: "same_key_in_order", echo: &protocol.Status{Code: 1}, wantItem: second},
+		{name: "same_key_exhausted", echo: &protocol.Status{Code: 1}, wantPermanent: true},
+		{name: "nil_echo", echo: nilEcho, wantPermanent: true},
+		{name: "unknown", echo: &protocol.Status{Code: 3}, wantPermanent: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := matcher.Match(tt.echo, "invalid")
+			if got.Permanent != tt.wantPermanent || (tt.wantItem != nil && got.Item != tt.wantItem) ||
+				got.Message != "invalid" {
+				t.Errorf("Match() = %+v, want item %v, permanent %v", got, tt.wantItem, tt.wantPermanent)
+			}
+		})
+	}
+}
+
+func TestRetryOptions(t *testing.T) {
+	opts := make([]option.Option, 0, 4)
+	opts = append(opts, option.WithRequestId("caller"), option.WithStage("test"))
+	options := option.Conv2Options(RetryOptions(opts, "caller-1")...)
+	if options.RequestId != "caller-1" || !options.SkipDeadLetter || options.Stage != "test" {
+		t.Errorf("RetryOptions() = %+v", options)
+	}
+	// the spare capacity of opts isn't written
+	if extended := opts[:cap(opts)]; extended[2] != nil || extended[3] != nil {
+		t.Errorf("RetryOptions() modified the backing array of opts")
+	}
+}
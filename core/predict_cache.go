@@ -0,0 +1,273 @@
+package core
+
+import (
+	"container/list"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"hash"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/byteplus-sdk/sdk-go/core/logs"
+	"github.com/byteplus-sdk/sdk-go/core/option"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	defaultPredictCacheMaxEntries     = 10000
+	defaultPredictCacheTTL            = 10 * time.Second
+	defaultPredictCacheRefreshTimeout = time.Second
+)
+
+// PredictCacheKeyFunc returns the cache key of a predict request of the scene
+// sent with options, requests with the same key share the cached response
+type PredictCacheKeyFunc func(request proto.Message, scene string, options *option.Options) (string, error)
+
+// PredictCacheConfig when it's set, the successful responses of Predict are cached,
+// and the requests with the same key within TTL are answered by the cached
+// response, whose `request_id` is the one of the original request, so that the
+// impressions acked or called back later are still attributed to it
+type PredictCacheConfig struct {
+	// Max count of cached responses, the least recently used one is evicted
+	// when exceeding, default is 10000
+	MaxEntries int
+	// The cached response is fresh within TTL since it's stored, default is 10s
+	TTL time.Duration
+	// Optional, the expired response is still returned within StaleTTL after TTL,
+	// meanwhile a request is sent in background to refresh it
+	StaleTTL time.Duration
+	// The timeout of the background request refreshing a stale response, which
+	// is sent with its own "Request-Id", default is 1s
+	RefreshTimeout time.Duration
+	// Optional, default key is the scene and the hash of the request serialized
+	// deterministically, with the stage, queries and headers of the options
+	KeyFunc PredictCacheKeyFunc
+}
+
+func fillDefaultPredictCacheConfig(config *PredictCacheConfig) *PredictCacheConfig {
+	if config == nil {
+		return nil
+	}
+	result := *config
+	if result.MaxEntries <= 0 {
+		result.MaxEntries = defaultPredictCacheMaxEntries
+	}
+	if result.TTL <= 0 {
+		result.TTL = defaultPredictCacheTTL
+	}
+	if result.StaleTTL < 0 {
+		result.StaleTTL = 0
+	}
+	if result.RefreshTimeout <= 0 {
+		result.RefreshTimeout = defaultPredictCacheRefreshTimeout
+	}
+	if result.KeyFunc == nil {
+		result.KeyFunc = defaultPredictCacheKey
+	}
+	return &result
+}
+
+func defaultPredictCacheKey(request proto.Message, scene string, options *option.Options) (string, error) {
+	bytes, err := proto.MarshalOptions{Deterministic: true}.Marshal(request)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.New()
+	sum.Write(bytes)
+	if options != nil {
+		// the same request sent to another stage or with other queries and
+		// headers may be answered differently
+		_, _ = fmt.Fprintf(sum, "\nstage=%q", options.Stage)
+		writeSortedPairs(sum, "query", options.Queries)
+		writeSortedPairs(sum, "header", options.Headers)
+	}
+	return scene + ":" + hex.EncodeToString(sum.Sum(nil)), nil
+}
+
+func writeSortedPairs(sum hash.Hash, kind string, pairs map[string]string) {
+	keys := make([]string, 0, len(pairs))
+	for key := range pairs {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		_, _ = fmt.Fprintf(sum, "\n%s %q=%q", kind, key, pairs[key])
+	}
+}
+
+// PredictLoadFunc sends the predict request with options and fills the response
+type PredictLoadFunc func(options *option.Options, response proto.Message) error
+
+type predictCacheEntry struct {
+	key       string
+	response  proto.Message
+	storeTime time.Time
+}
+
+// predictCall is a request in flight, the identical requests arriving
+// meanwhile wait for its result instead of sending their own
+type predictCall struct {
+	done     chan struct{}
+	response proto.Message
+	err      error
+}
+
+// PredictCache is a LRU cache of predict responses, it's nil if
+// PredictCacheConfig is not set
+type PredictCache struct {
+	config   *PredictCacheConfig
+	lock     sync.Mutex
+	entries  map[string]*list.Element
+	lru      *list.List
+	inflight map[string]*predictCall
+}
+
+func NewPredictCache(config *PredictCacheConfig) *PredictCache {
+	if config == nil {
+		return nil
+	}
+	return &PredictCache{
+		config:   config,
+		entries:  make(map[string]*list.Element),
+		lru:      list.New(),
+		inflight: make(map[string]*predictCall),
+	}
+}
+
+// Load fills response with the cached response of the request if it's fresh or
+// stale, otherwise by calling load with options, concurrent identical requests
+// are collapsed into one call. The options of the collapsed requests, e.g. timeout
+// and Request-Id, are not used. The stale response is refreshed in background
+// by calling load with a copy of options, whose "Request-Id" is generated and
+// timeout is PredictCacheConfig.RefreshTimeout
+func (receiver *PredictCache) Load(request proto.Message, scene string, options *option.Options,
+	response proto.Message, load PredictLoadFunc) error {
+	if receiver == nil {
+		return load(options, response)
+	}
+	key, err := receiver.config.KeyFunc(request, scene, options)
+	if err != nil {
+		logs.Warn("[PredictCache] build key fail, err:%s", err.Error())
+		return load(options, response)
+	}
+	cached, fresh := receiver.get(key)
+	if cached != nil {
+		if !fresh {
+			refreshOptions := receiver.refreshOptions(options)
+			refresh := func(response proto.Message) error {
+				return load(refreshOptions, response)
+			}
+			AsyncExecute(func() {
+				_, _ = receiver.loadOnce(key, response.ProtoReflect().New().Interface(), refresh)
+			})
+		}
+		proto.Merge(response, cached)
+		return nil
+	}
+	result, err := receiver.loadOnce(key, response, func(response proto.Message) error {
+		return load(options, response)
+	})
+	if err != nil {
+		return err
+	}
+	if result != response {
+		proto.Merge(response, result)
+	}
+	return nil
+}
+
+// refreshOptions returns the options of the background request refreshing a stale response
+func (receiver *PredictCache) refreshOptions(options *option.Options) *option.Options {
+	result := &option.Options{}
+	if options != nil {
+		*result = *options
+	}
+	result.RequestId = uuid.NewString()
+	result.Timeout = receiver.config.RefreshTimeout
+	return result
+}
+
+// Invalidate removes all cached responses
+func (receiver *PredictCache) Invalidate() {
+	if receiver == nil {
+		return
+	}
+	receiver.lock.Lock()
+	defer receiver.lock.Unlock()
+	receiver.entries = make(map[string]*list.Element)
+	receiver.lru.Init()
+}
+
+// get returns the cached response of key and whether it's fresh,
+// the response is nil if it's missing or expired
+func (receiver *PredictCache) get(key string) (proto.Message, bool) {
+	receiver.lock.Lock()
+	defer receiver.lock.Unlock()
+	element, exist := receiver.entries[key]
+	if !exist {
+		return nil, false
+	}
+	entry := element.Value.(*predictCacheEntry)
+	age := time.Since(entry.storeTime)
+	if age >= receiver.config.TTL+receiver.config.StaleTTL {
+		receiver.lru.Remove(element)
+		delete(receiver.entries, key)
+		return nil, false
+	}
+	receiver.lru.MoveToFront(element)
+	return entry.response, age < receiver.config.TTL
+}
+
+// loadOnce calls load if there is no identical request in flight, otherwise waits
+// for the result of the one in flight. The result is shared by the callers,
+// which should copy it rather than modify it
+func (receiver *PredictCache) loadOnce(key string, response proto.Message,
+	load func(response proto.Message) error) (proto.Message, error) {
+	receiver.lock.Lock()
+	if call, exist := receiver.inflight[key]; exist {
+		receiver.lock.Unlock()
+		<-call.done
+		return call.response, call.err
+	}
+	call := &predictCall{done: make(chan struct{})}
+	receiver.inflight[key] = call
+	receiver.lock.Unlock()
+
+	call.err = load(response)
+	if call.err == nil {
+		call.response = proto.Clone(response)
+	}
+	receiver.lock.Lock()
+	delete(receiver.inflight, key)
+	if call.err == nil {
+		receiver.store(key, call.response)
+	}
+	receiver.lock.Unlock()
+	close(call.done)
+	if call.err != nil {
+		return nil, call.err
+	}
+	return response, nil
+}
+
+// store caches the successful response, must be called with lock held
+func (receiver *PredictCache) store(key string, response proto.Message) {
+	if code, _, ok := responseStatus(response); !ok || code != StatusCodeSuccess {
+		return
+	}
+	entry := &predictCacheEntry{key: key, response: response, storeTime: time.Now()}
+	if element, exist := receiver.entries[key]; exist {
+		element.Value = entry
+		receiver.lru.MoveToFront(element)
+		return
+	}
+	receiver.entries[key] = receiver.lru.PushFront(entry)
+	for receiver.lru.Len() > receiver.config.MaxEntries {
+		oldest := receiver.lru.Back()
+		receiver.lru.Remove(oldest)
+		delete(receiver.entries, oldest.Value.(*predictCacheEntry).key)
+	}
+}
@@ -0,0 +1,162 @@
+package core
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/byteplus-sdk/sdk-go/common/protocol"
+	"github.com/byteplus-sdk/sdk-go/core/option"
+	"google.golang.org/protobuf/proto"
+)
+
+// countingLoad answers with the message "req-<n>", n is the count of calls
+func countingLoad(calls *int32, code int32) PredictLoadFunc {
+	return func(options *option.Options, response proto.Message) error {
+		n := atomic.AddInt32(calls, 1)
+		response.(*protocol.DoneResponse).Status = &protocol.Status{Code: code, Message: fmt.Sprintf("req-%d", n)}
+		return nil
+	}
+}
+
+func loadMessage(t *testing.T, cache *PredictCache, day int32, load PredictLoadFunc,
+	opts ...option.Option) string {
+	response := &protocol.DoneResponse{}
+	if err := cache.Load(&protocol.Date{Day: day}, "home", option.Conv2Options(opts...), response, load); err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	return response.GetStatus().GetMessage()
+}
+
+func TestPredictCache_Load(t *testing.T) {
+	cache := NewPredictCache(fillDefaultPredictCacheConfig(&PredictCacheConfig{
+		MaxEntries: 2,
+		TTL:        50 * time.Millisecond,
+	}))
+	var calls int32
+	load := countingLoad(&calls, StatusCodeSuccess)
+	if got := loadMessage(t, cache, 1, load); got != "req-1" {
+		t.Errorf("first Load() = %v", got)
+	}
+	if got := loadMessage(t, cache, 1, load); got != "req-1" {
+		t.Errorf("cached Load() = %v, want the original response", got)
+	}
+	loadMessage(t, cache, 2, load)
+	loadMessage(t, cache, 3, load)
+	// day 1 is evicted as the least recently used
+	if got := loadMessage(t, cache, 1, load); got != "req-4" {
+		t.Errorf("evicted Load() = %v", got)
+	}
+	time.Sleep(60 * time.Millisecond)
+	if got := loadMessage(t, cache, 1, load); got != "req-5" {
+		t.Errorf("expired Load() = %v", got)
+	}
+
+	var failCalls int32
+	failLoad := countingLoad(&failCalls, StatusCodeTooManyRequest)
+	loadMessage(t, cache, 9, failLoad)
+	loadMessage(t, cache, 9, failLoad)
+	if failCalls != 2 {
+		t.Errorf("failed responses should not be cached, calls = %d", failCalls)
+	}
+	errLoad := func(options *option.Options, response proto.Message) error { return errors.New("timeout") }
+	if err := cache.Load(&protocol.Date{Day: 9}, "home", nil, &protocol.DoneResponse{}, errLoad); err == nil {
+		t.Errorf("Load() should return the error of load")
+	}
+}
+
+func TestPredictCache_staleWhileRevalidate(t *testing.T) {
+	cache := NewPredictCache(fillDefaultPredictCacheConfig(&PredictCacheConfig{
+		TTL:      30 * time.Millisecond,
+		StaleTTL: time.Second,
+	}))
+	var calls int32
+	load := countingLoad(&calls, StatusCodeSuccess)
+	loadMessage(t, cache, 1, load)
+	time.Sleep(40 * time.Millisecond)
+	if got := loadMessage(t, cache, 1, load); got != "req-1" {
+		t.Errorf("stale Load() = %v, want the stale response", got)
+	}
+	time.Sleep(20 * time.Millisecond)
+	if got := loadMessage(t, cache, 1, load); got != "req-2" {
+		t.Errorf("Load() after revalidation = %v", got)
+	}
+
+	// the refresh is sent with its own request id and timeout
+	refreshed := make(chan *option.Options, 1)
+	recordingLoad := func(options *option.Options, response proto.Message) error {
+		if options.RequestId != "caller" {
+			refreshed <- options
+		}
+		return countingLoad(&calls, StatusCodeSuccess)(options, response)
+	}
+	callerOpts := []option.Option{option.WithRequestId("caller"), option.WithTimeout(time.Hour)}
+	loadMessage(t, cache, 2, recordingLoad, callerOpts...)
+	time.Sleep(40 * time.Millisecond)
+	loadMessage(t, cache, 2, recordingLoad, callerOpts...)
+	select {
+	case options := <-refreshed:
+		if options.RequestId == "" || options.Timeout != defaultPredictCacheRefreshTimeout {
+			t.Errorf("refresh options = %+v, want new request id and refresh timeout", options)
+		}
+	case <-time.After(time.Second):
+		t.Errorf("stale response is not refreshed")
+	}
+}
+
+func TestPredictCache_keyOfOptions(t *testing.T) {
+	cache := NewPredictCache(fillDefaultPredictCacheConfig(&PredictCacheConfig{}))
+	var calls int32
+	load := countingLoad(&calls, StatusCodeSuccess)
+	tests := []struct {
+		name   string
+		opts   []option.Option
+		cached bool
+	}{
+		{name: "first", opts: []option.Option{option.WithStage("online")}},
+		{name: "other_request_id", opts: []option.Option{option.WithStage("online"), option.WithRequestId("2")}, cached: true},
+		{name: "other_stage", opts: []option.Option{option.WithStage("debug")}},
+		{name: "queries", opts: []option.Option{option.WithStage("online"), option.WithQueries(map[string]string{"a": "1"})}},
+		{name: "headers", opts: []option.Option{option.WithStage("online"), option.WithHeaders(map[string]string{"a": "1"})}},
+	}
+	for _, tt := range tests {
+		before := atomic.LoadInt32(&calls)
+		loadMessage(t, cache, 1, load, tt.opts...)
+		if cached := atomic.LoadInt32(&calls) == before; cached != tt.cached {
+			t.Errorf("%s: cached = %v, want %v", tt.name, cached, tt.cached)
+		}
+	}
+}
+
+func TestPredictCache_singleFlight(t *testing.T) {
+	cache := NewPredictCache(fillDefaultPredictCacheConfig(&PredictCacheConfig{}))
+	var calls int32
+	release := make(chan struct{})
+	load := func(options *option.Options, response proto.Message) error {
+		<-release
+		return countingLoad(&calls, StatusCodeSuccess)(options, response)
+	}
+	var wg sync.WaitGroup
+	messages := make([]string, 10)
+	for i := range messages {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			messages[i] = loadMessage(t, cache, 1, load)
+		}(i)
+	}
+	time.Sleep(20 * time.Millisecond)
+	close(release)
+	wg.Wait()
+	if calls != 1 {
+		t.Errorf("concurrent identical requests should be collapsed, calls = %d", calls)
+	}
+	for _, message := range messages {
+		if message != "req-1" {
+			t.Errorf("collapsed Load() = %v", message)
+		}
+	}
+}
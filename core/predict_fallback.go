@@ -0,0 +1,266 @@
+package core
+
+import (
+	"container/list"
+	"sync"
+
+	"github.com/byteplus-sdk/sdk-go/core/logs"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+const (
+	// PredictFallbackExtraKey is set in `PredictResponse.value.extra` of the fallback
+	// response, whose value is the Source of the provider. The items of fallback
+	// response are not recommended by BytePlus, so they shouldn't be acked or
+	// called back, and the `request_id` of the response is cleared
+	PredictFallbackExtraKey = "byteplus_sdk_fallback"
+
+	PredictFallbackSourceStatic      = "static"
+	PredictFallbackSourceLastSuccess = "last_success"
+	PredictFallbackSourceCustom      = "custom"
+
+	defaultLastSuccessMaxUsers = 10000
+)
+
+// PredictFallbackProvider supplies the response of a failed predict request
+type PredictFallbackProvider interface {
+	// Source is the value of PredictFallbackExtraKey in the fallback response
+	Source() string
+	// Fallback returns the response of the predict request of the scene,
+	// nil if it has none, the returned response is copied before modified
+	Fallback(request proto.Message, scene string) proto.Message
+}
+
+// PredictSuccessRecorder is implemented by the providers which need the successful
+// responses of Predict, e.g. LastSuccessPredictFallback
+type PredictSuccessRecorder interface {
+	Record(request proto.Message, scene string, response proto.Message)
+}
+
+// PredictFallbackConfig when it's set, the failed Predict returns the fallback
+// response instead of error if any provider has one
+type PredictFallbackConfig struct {
+	// Tried in order, the response of the first provider which has one is returned
+	Providers []PredictFallbackProvider
+	// Also use the fallback response when the status of response is not success,
+	// by default only errors, e.g. timeout, trigger the fallback
+	FallbackOnStatusError bool
+}
+
+// PredictFallbackFunc is a PredictFallbackProvider whose source is PredictFallbackSourceCustom
+type PredictFallbackFunc func(request proto.Message, scene string) proto.Message
+
+func (receiver PredictFallbackFunc) Source() string {
+	return PredictFallbackSourceCustom
+}
+
+func (receiver PredictFallbackFunc) Fallback(request proto.Message, scene string) proto.Message {
+	return receiver(request, scene)
+}
+
+// StaticPredictFallback supplies a fixed response of each scene, e.g. popular items
+type StaticPredictFallback map[string]proto.Message
+
+func (receiver StaticPredictFallback) Source() string {
+	return PredictFallbackSourceStatic
+}
+
+func (receiver StaticPredictFallback) Fallback(request proto.Message, scene string) proto.Message {
+	return receiver[scene]
+}
+
+// PredictUserKeyFunc returns the user of the predict request
+type PredictUserKeyFunc func(request proto.Message) string
+
+type lastSuccessEntry struct {
+	key      string
+	response proto.Message
+}
+
+// LastSuccessPredictFallback supplies the last successful response of the user
+// in the scene, the responses are kept in memory for at most MaxUsers users
+type LastSuccessPredictFallback struct {
+	maxUsers int
+	userKey  PredictUserKeyFunc
+	lock     sync.Mutex
+	entries  map[string]*list.Element
+	lru      *list.List
+}
+
+// NewLastSuccessPredictFallback maxUsers default is 10000, userKey default is
+// `user_id` of the request, or `user.uid` of general request
+func NewLastSuccessPredictFallback(maxUsers int, userKey PredictUserKeyFunc) *LastSuccessPredictFallback {
+	if maxUsers <= 0 {
+		maxUsers = defaultLastSuccessMaxUsers
+	}
+	if userKey == nil {
+		userKey = predictUserId
+	}
+	return &LastSuccessPredictFallback{
+		maxUsers: maxUsers,
+		userKey:  userKey,
+		entries:  make(map[string]*list.Element),
+		lru:      list.New(),
+	}
+}
+
+func (receiver *LastSuccessPredictFallback) Source() string {
+	return PredictFallbackSourceLastSuccess
+}
+
+func (receiver *LastSuccessPredictFallback) Fallback(request proto.Message, scene string) proto.Message {
+	user := receiver.userKey(request)
+	if user == "" {
+		return nil
+	}
+	receiver.lock.Lock()
+	defer receiver.lock.Unlock()
+	element, exist := receiver.entries[scene+":"+user]
+	if !exist {
+		return nil
+	}
+	receiver.lru.MoveToFront(element)
+	return element.Value.(*lastSuccessEntry).response
+}
+
+func (receiver *LastSuccessPredictFallback) Record(request proto.Message, scene string, response proto.Message) {
+	user := receiver.userKey(request)
+	if user == "" {
+		return
+	}
+	entry := &lastSuccessEntry{key: scene + ":" + user, response: proto.Clone(response)}
+	receiver.lock.Lock()
+	defer receiver.lock.Unlock()
+	if element, exist := receiver.entries[entry.key]; exist {
+		element.Value = entry
+		receiver.lru.MoveToFront(element)
+		return
+	}
+	receiver.entries[entry.key] = receiver.lru.PushFront(entry)
+	for receiver.lru.Len() > receiver.maxUsers {
+		oldest := receiver.lru.Back()
+		receiver.lru.Remove(oldest)
+		delete(receiver.entries, oldest.Value.(*lastSuccessEntry).key)
+	}
+}
+
+func predictUserId(request proto.Message) string {
+	msg := request.ProtoReflect()
+	fields := msg.Descriptor().Fields()
+	if field := fields.ByName("user_id"); field != nil && field.Kind() == protoreflect.StringKind {
+		return msg.Get(field).String()
+	}
+	field := fields.ByName("user")
+	if field == nil || field.Kind() != protoreflect.MessageKind || !msg.Has(field) {
+		return ""
+	}
+	user := msg.Get(field).Message()
+	if uid := user.Descriptor().Fields().ByName("uid"); uid != nil && uid.Kind() == protoreflect.StringKind {
+		return user.Get(uid).String()
+	}
+	return ""
+}
+
+// PredictFallback applies the fallback providers to the result of Predict,
+// it's nil if PredictFallbackConfig is not set
+type PredictFallback struct {
+	config *PredictFallbackConfig
+}
+
+func NewPredictFallback(config *PredictFallbackConfig) *PredictFallback {
+	if config == nil || len(config.Providers) == 0 {
+		return nil
+	}
+	return &PredictFallback{config: config}
+}
+
+// Apply records the successful response to the providers, or replaces the failed
+// one with the fallback response. It returns err if there is no fallback response
+func (receiver *PredictFallback) Apply(request proto.Message, scene string,
+	response proto.Message, err error) error {
+	if receiver == nil {
+		return err
+	}
+	if err == nil {
+		code, _, ok := responseStatus(response)
+		if !ok || code == StatusCodeSuccess {
+			receiver.record(request, scene, response)
+			return nil
+		}
+		if !receiver.config.FallbackOnStatusError {
+			return nil
+		}
+	}
+	for _, provider := range receiver.config.Providers {
+		fallback := provider.Fallback(request, scene)
+		if fallback == nil {
+			continue
+		}
+		proto.Reset(response)
+		proto.Merge(response, fallback)
+		markPredictFallback(response, provider.Source())
+		if err != nil {
+			logs.Warn("[PredictFallback] predict %s fail, use %s fallback, err:%s",
+				scene, provider.Source(), err.Error())
+		} else {
+			logs.Warn("[PredictFallback] predict %s status not success, use %s fallback",
+				scene, provider.Source())
+		}
+		return nil
+	}
+	return err
+}
+
+func (receiver *PredictFallback) record(request proto.Message, scene string, response proto.Message) {
+	for _, provider := range receiver.config.Providers {
+		if recorder, ok := provider.(PredictSuccessRecorder); ok {
+			recorder.Record(request, scene, response)
+		}
+	}
+}
+
+// markPredictFallback sets PredictFallbackExtraKey in `value.extra` of response,
+// sets the status of response success, and clears its `request_id`, which is
+// the one of the response supplying the fallback, e.g. the last successful one
+func markPredictFallback(response proto.Message, source string) {
+	msg := response.ProtoReflect()
+	fields := msg.Descriptor().Fields()
+	if requestIdField := fields.ByName("request_id"); requestIdField != nil {
+		msg.Clear(requestIdField)
+	}
+	if statusField := fields.ByName("status"); statusField != nil && statusField.Kind() == protoreflect.MessageKind {
+		status := msg.Mutable(statusField).Message()
+		if codeField := status.Descriptor().Fields().ByName("code"); codeField != nil {
+			status.Set(codeField, protoreflect.ValueOfInt32(StatusCodeSuccess))
+		}
+	} else if codeField := fields.ByName("code"); codeField != nil && codeField.Kind() == protoreflect.Int32Kind {
+		msg.Set(codeField, protoreflect.ValueOfInt32(StatusCodeSuccess))
+	}
+	valueField := fields.ByName("value")
+	if valueField == nil || valueField.Kind() != protoreflect.MessageKind {
+		return
+	}
+	value := msg.Mutable(valueField).Message()
+	extraField := value.Descriptor().Fields().ByName("extra")
+	if extraField == nil || !extraField.IsMap() {
+		return
+	}
+	value.Mutable(extraField).Map().Set(protoreflect.ValueOfString(PredictFallbackExtraKey).MapKey(),
+		protoreflect.ValueOfString(source))
+}
+
+// isPredictFallback returns whether PredictFallbackExtraKey is in `value.extra` of response
+func isPredictFallback(response proto.Message) bool {
+	msg := response.ProtoReflect()
+	valueField := msg.Descriptor().Fields().ByName("value")
+	if valueField == nil || valueField.Kind() != protoreflect.MessageKind || !msg.Has(valueField) {
+		return false
+	}
+	value := msg.Get(valueField).Message()
+	extraField := value.Descriptor().Fields().ByName("extra")
+	if extraField == nil || !extraField.IsMap() {
+		return false
+	}
+	return value.Get(extraField).Map().Has(protoreflect.ValueOfString(PredictFallbackExtraKey).MapKey())
+}
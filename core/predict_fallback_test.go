@@ -0,0 +1,62 @@
+package core
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/byteplus-sdk/sdk-go/general/protocol"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestPredictFallback_Apply(t *testing.T) {
+	lastSuccess := NewLastSuccessPredictFallback(0, nil)
+	static := StaticPredictFallback{
+		"home": &protocol.PredictResponse{Value: &protocol.PredictResult{
+			Items: []*protocol.PredictItem{{Id: "popular"}},
+		}},
+	}
+	fallback := NewPredictFallback(&PredictFallbackConfig{
+		Providers: []PredictFallbackProvider{lastSuccess, static},
+	})
+	timeout := errors.New("timeout")
+	request := func(uid string) proto.Message {
+		return &protocol.PredictRequest{User: &protocol.PredictUser{Uid: uid}}
+	}
+
+	response := &protocol.PredictResponse{Code: 500}
+	if err := fallback.Apply(request("u1"), "home", response, nil); err != nil || response.Code != 500 {
+		t.Errorf("status error shouldn't fallback by default, err = %v, code = %v", err, response.Code)
+	}
+	response = &protocol.PredictResponse{}
+	if err := fallback.Apply(request("u1"), "home", response, timeout); err != nil {
+		t.Fatalf("Apply() error = %v", err)
+	}
+	if response.GetValue().GetItems()[0].GetId() != "popular" ||
+		response.GetValue().GetExtra()[PredictFallbackExtraKey] != PredictFallbackSourceStatic {
+		t.Errorf("Apply() = %v, want static fallback", response)
+	}
+	if _, marked := static["home"].(*protocol.PredictResponse).GetValue().GetExtra()[PredictFallbackExtraKey]; marked {
+		t.Errorf("Apply() should not modify the fallback response of provider")
+	}
+
+	success := &protocol.PredictResponse{RequestId: "req-1", Value: &protocol.PredictResult{
+		Items: []*protocol.PredictItem{{Id: "personal"}},
+	}}
+	if err := fallback.Apply(request("u1"), "home", success, nil); err != nil {
+		t.Fatalf("Apply() error = %v", err)
+	}
+	response = &protocol.PredictResponse{}
+	_ = fallback.Apply(request("u1"), "home", response, timeout)
+	if response.GetRequestId() != "" || response.GetValue().GetItems()[0].GetId() != "personal" ||
+		response.GetValue().GetExtra()[PredictFallbackExtraKey] != PredictFallbackSourceLastSuccess {
+		t.Errorf("Apply() = %v, want last success fallback", response)
+	}
+
+	if err := fallback.Apply(request("u2"), "detail", &protocol.PredictResponse{}, timeout); err != timeout {
+		t.Errorf("Apply() without fallback error = %v, want %v", err, timeout)
+	}
+	var nilFallback *PredictFallback
+	if err := nilFallback.Apply(request("u1"), "home", &protocol.PredictResponse{}, timeout); err != timeout {
+		t.Errorf("nil PredictFallback Apply() error = %v, want %v", err, timeout)
+	}
+}
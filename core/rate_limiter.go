@@ -0,0 +1,268 @@
+package core
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/byteplus-sdk/sdk-go/core/logs"
+	"github.com/byteplus-sdk/sdk-go/core/metrics"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	defaultRateDecreaseFactor  = 0.5
+	defaultMinRateRatio        = 0.1
+	defaultRateRecoverInterval = 5 * time.Second
+	defaultRateRecoverRatio    = 0.1
+	// the rate is decreased at most once in this duration,
+	// to avoid a burst of 429 responses cut the rate to the minimum
+	rateDecreaseCoolDown = time.Second
+)
+
+type RateLimit struct {
+	// Requests permitted per second
+	QPS float64
+	// Max requests permitted in a burst, default is QPS and at least 1
+	Burst int
+	// Requests fail with RateLimitError immediately instead of waiting
+	// when exceeding the rate limit
+	FailFast bool
+}
+
+// RateLimitConfig the rate limiters are isolated by endpoint class, the rate
+// is cut when receiving 429 from server, and then increased gradually
+type RateLimitConfig struct {
+	// Key is endpoint class, e.g. EndpointWrite, EndpointImport, EndpointPredict,
+	// requests of endpoints not in the map are not limited
+	Limits map[string]*RateLimit
+	// The rate is multiplied by it when receiving 429, default is 0.5
+	DecreaseFactor float64
+	// The rate won't be lower than QPS * MinRateRatio, default is 0.1
+	MinRateRatio float64
+	// If no 429 is received during the interval, the rate will be increased
+	// by QPS * RecoverRatio until reaching QPS, default is 5s and 0.1
+	RecoverInterval time.Duration
+	RecoverRatio    float64
+}
+
+func fillDefaultRateLimitConfig(config *RateLimitConfig) *RateLimitConfig {
+	if config == nil {
+		return nil
+	}
+	if config.DecreaseFactor <= 0 || config.DecreaseFactor >= 1 {
+		config.DecreaseFactor = defaultRateDecreaseFactor
+	}
+	if config.MinRateRatio <= 0 || config.MinRateRatio > 1 {
+		config.MinRateRatio = defaultMinRateRatio
+	}
+	if config.RecoverInterval <= 0 {
+		config.RecoverInterval = defaultRateRecoverInterval
+	}
+	if config.RecoverRatio <= 0 {
+		config.RecoverRatio = defaultRateRecoverRatio
+	}
+	return config
+}
+
+// RateLimitError is returned when the request is rejected by the client-side rate limiter
+type RateLimitError struct {
+	Endpoint string
+}
+
+func (e *RateLimitError) Error() string {
+	return fmt.Sprintf("request is rejected by rate limiter, endpoint:%s", e.Endpoint)
+}
+
+func IsRateLimitError(err error) bool {
+	_, ok := err.(*RateLimitError)
+	return ok
+}
+
+// NewRateLimiter create a token bucket rate limiter, `config` is used to
+// adjust the rate when receiving 429, the default value is used if it's nil
+func NewRateLimiter(endpoint string, limit *RateLimit, config *RateLimitConfig) *RateLimiter {
+	if config == nil {
+		config = &RateLimitConfig{}
+	}
+	config = fillDefaultRateLimitConfig(config)
+	burst := float64(limit.Burst)
+	if burst <= 0 {
+		burst = limit.QPS
+	}
+	if burst < 1 {
+		burst = 1
+	}
+	now := time.Now()
+	return &RateLimiter{
+		endpoint:    endpoint,
+		limit:       limit,
+		config:      config,
+		burst:       burst,
+		rate:        limit.QPS,
+		tokens:      burst,
+		lastRefill:  now,
+		lastAdjust:  now,
+		lastDecline: now.Add(-rateDecreaseCoolDown),
+	}
+}
+
+type RateLimiter struct {
+	endpoint string
+	limit    *RateLimit
+	config   *RateLimitConfig
+	burst    float64
+	lock     sync.Mutex
+	// current rate, it's lower than QPS after receiving 429
+	rate        float64
+	tokens      float64
+	lastRefill  time.Time
+	lastAdjust  time.Time
+	lastDecline time.Time
+}
+
+// TryAcquire takes a token if there is one available, it never blocks
+func (l *RateLimiter) TryAcquire() bool {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	l.refill(time.Now())
+	if l.tokens < 1 {
+		return false
+	}
+	l.tokens--
+	return true
+}
+
+// Wait blocks until a token is available. If the token can't be available
+// in `timeout`, it returns RateLimitError immediately without blocking,
+// `timeout` <= 0 means waiting without limit
+func (l *RateLimiter) Wait(timeout time.Duration) error {
+	l.lock.Lock()
+	l.refill(time.Now())
+	l.tokens--
+	if l.tokens >= 0 {
+		l.lock.Unlock()
+		return nil
+	}
+	waitTime := time.Duration(-l.tokens / l.rate * float64(time.Second))
+	if timeout > 0 && waitTime > timeout {
+		l.tokens++
+		l.lock.Unlock()
+		return &RateLimitError{Endpoint: l.endpoint}
+	}
+	l.lock.Unlock()
+	time.Sleep(waitTime)
+	return nil
+}
+
+// Rate returns the current permitted requests per second
+func (l *RateLimiter) Rate() float64 {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	l.refill(time.Now())
+	return l.rate
+}
+
+// OnThrottled cut the rate, it should be called when receiving 429 from server
+func (l *RateLimiter) OnThrottled() {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	now := time.Now()
+	l.refill(now)
+	if now.Sub(l.lastDecline) < rateDecreaseCoolDown {
+		return
+	}
+	minRate := l.limit.QPS * l.config.MinRateRatio
+	l.rate *= l.config.DecreaseFactor
+	if l.rate < minRate {
+		l.rate = minRate
+	}
+	if l.tokens > 0 {
+		l.tokens = 0
+	}
+	l.lastDecline = now
+	l.lastAdjust = now
+	logs.Warn("receive too many request, decrease rate to %.2f, endpoint:%s", l.rate, l.endpoint)
+}
+
+func (l *RateLimiter) refill(now time.Time) {
+	if l.rate < l.limit.QPS && now.Sub(l.lastAdjust) >= l.config.RecoverInterval {
+		l.rate += l.limit.QPS * l.config.RecoverRatio
+		if l.rate > l.limit.QPS {
+			l.rate = l.limit.QPS
+		}
+		l.lastAdjust = now
+		logs.Info("increase rate to %.2f, endpoint:%s", l.rate, l.endpoint)
+	}
+	elapsed := now.Sub(l.lastRefill).Seconds()
+	if elapsed <= 0 {
+		return
+	}
+	l.tokens += elapsed * l.rate
+	if l.tokens > l.burst {
+		l.tokens = l.burst
+	}
+	l.lastRefill = now
+}
+
+func newRateLimiters(config *RateLimitConfig) map[string]*RateLimiter {
+	limiters := make(map[string]*RateLimiter, len(config.Limits))
+	for endpoint, limit := range config.Limits {
+		if limit == nil || limit.QPS <= 0 {
+			continue
+		}
+		limiters[endpoint] = NewRateLimiter(endpoint, limit, config)
+	}
+	return limiters
+}
+
+// RateLimiter returns the rate limiter of the endpoint class,
+// nil if requests of the endpoint are not limited
+func (c *HTTPCaller) RateLimiter(endpoint string) *RateLimiter {
+	return c.rateLimiters[endpoint]
+}
+
+func (c *HTTPCaller) acquireRateLimit(url string, timeout time.Duration) error {
+	limiter := c.rateLimiters[endpointOf(url)]
+	if limiter == nil {
+		return nil
+	}
+	var err error
+	if limiter.limit.FailFast {
+		if !limiter.TryAcquire() {
+			err = &RateLimitError{Endpoint: limiter.endpoint}
+		}
+	} else {
+		err = limiter.Wait(timeout)
+	}
+	if err != nil {
+		metricsTags := []string{
+			"type:rate_limited",
+			"tenant:" + c.context.Tenant(),
+			"url:" + escapeMetricsTagValue(url),
+		}
+		metrics.Counter(metricsKeyCommonError, 1, metricsTags...)
+		logs.Warn("request is rejected by rate limiter, url:%s", url)
+	}
+	return err
+}
+
+// checkThrottled cut the rate if server returns 429 as http status or `Status.Code`
+func (c *HTTPCaller) checkThrottled(url string, response proto.Message, err error) {
+	limiter := c.rateLimiters[endpointOf(url)]
+	if limiter == nil {
+		return
+	}
+	if statusErr, ok := err.(*httpStatusError); ok {
+		if statusErr.code == StatusCodeTooManyRequest {
+			limiter.OnThrottled()
+		}
+		return
+	}
+	if err != nil {
+		return
+	}
+	if code, _, ok := responseStatus(response); ok && code == StatusCodeTooManyRequest {
+		limiter.OnThrottled()
+	}
+}
@@ -0,0 +1,39 @@
+package core
+
+import (
+	"testing"
+	"time"
+)
+
+func TestRateLimiter(t *testing.T) {
+	limiter := NewRateLimiter(EndpointWrite, &RateLimit{QPS: 10, Burst: 2}, &RateLimitConfig{
+		RecoverInterval: 50 * time.Millisecond,
+		RecoverRatio:    0.5,
+	})
+	if !limiter.TryAcquire() || !limiter.TryAcquire() {
+		t.Fatalf("TryAcquire() should succeed within burst")
+	}
+	if limiter.TryAcquire() {
+		t.Fatalf("TryAcquire() should fail after burst is exhausted")
+	}
+	if err := limiter.Wait(time.Millisecond); !IsRateLimitError(err) {
+		t.Fatalf("Wait() with short timeout = %v, want RateLimitError", err)
+	}
+	start := time.Now()
+	if err := limiter.Wait(time.Second); err != nil {
+		t.Fatalf("Wait() err:%v", err)
+	}
+	if cost := time.Now().Sub(start); cost < 50*time.Millisecond {
+		t.Errorf("Wait() returns after %v, want about 100ms", cost)
+	}
+
+	limiter.OnThrottled()
+	limiter.OnThrottled()
+	if rate := limiter.Rate(); rate != 5 {
+		t.Fatalf("Rate() after throttled = %v, want 5", rate)
+	}
+	time.Sleep(60 * time.Millisecond)
+	if rate := limiter.Rate(); rate != 10 {
+		t.Errorf("Rate() after recover interval = %v, want 10", rate)
+	}
+}
@@ -0,0 +1,298 @@
+package core
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// FieldMapping maps the field of Record to the field of the target, the key is
+// the field name of Record, and the value is the path of the target field, in
+// which the fields of nested message are separated by ".", e.g. "location.city".
+// The fields of Record not in FieldMapping are mapped to the field with the same name
+type FieldMapping map[string]string
+
+func (receiver FieldMapping) targetPath(field string) string {
+	if path, exist := receiver[field]; exist {
+		return path
+	}
+	return field
+}
+
+// RecordToMessage sets the fields of message by record, the values are converted
+// to the type of proto fields, e.g. "12" to int64, the field of proto can be
+// referred by its proto name or json name. Repeated fields accept json arrays,
+// and strings in format of json array or separated by ",". Message and map fields
+// accept json objects, and strings in format of json object. Fields of record
+// not found in message and null values, including the null elements of arrays,
+// are ignored
+func RecordToMessage(record Record, mapping FieldMapping, message proto.Message) error {
+	msg := message.ProtoReflect()
+	for field, value := range record {
+		path := mapping.targetPath(field)
+		if path == "" || value == nil {
+			continue
+		}
+		if err := setMessagePath(msg, strings.Split(path, "."), value); err != nil {
+			return fmt.Errorf("field %s: %w", field, err)
+		}
+	}
+	return nil
+}
+
+// MapRecord renames the fields of record by mapping, nested paths are converted
+// to nested maps, e.g. "location.city" sets record["location"]["city"]. The
+// fields with null value are skipped
+func MapRecord(record Record, mapping FieldMapping) map[string]interface{} {
+	result := make(map[string]interface{}, len(record))
+	for field, value := range record {
+		path := mapping.targetPath(field)
+		if path == "" || value == nil {
+			continue
+		}
+		names := strings.Split(path, ".")
+		parent := result
+		for _, name := range names[:len(names)-1] {
+			child, ok := parent[name].(map[string]interface{})
+			if !ok {
+				child = make(map[string]interface{})
+				parent[name] = child
+			}
+			parent = child
+		}
+		parent[names[len(names)-1]] = value
+	}
+	return result
+}
+
+func findField(msg protoreflect.Message, name string) protoreflect.FieldDescriptor {
+	fields := msg.Descriptor().Fields()
+	if fd := fields.ByName(protoreflect.Name(name)); fd != nil {
+		return fd
+	}
+	return fields.ByJSONName(name)
+}
+
+func setMessagePath(msg protoreflect.Message, names []string, value interface{}) error {
+	fd := findField(msg, names[0])
+	if fd == nil {
+		return nil
+	}
+	if len(names) > 1 {
+		if fd.Kind() != protoreflect.MessageKind || fd.IsList() || fd.IsMap() {
+			return fmt.Errorf("%s is not a message", fd.Name())
+		}
+		return setMessagePath(msg.Mutable(fd).Message(), names[1:], value)
+	}
+	return setField(msg, fd, value)
+}
+
+func setField(msg protoreflect.Message, fd protoreflect.FieldDescriptor, value interface{}) error {
+	switch {
+	case fd.IsList():
+		values, err := toList(value)
+		if err != nil {
+			return err
+		}
+		list := msg.Mutable(fd).List()
+		for _, element := range values {
+			if element == nil {
+				continue
+			}
+			if fd.Kind() == protoreflect.MessageKind {
+				elementMsg := list.NewElement()
+				if err = setMessageFields(elementMsg.Message(), element); err != nil {
+					return err
+				}
+				list.Append(elementMsg)
+				continue
+			}
+			protoValue, err := toProtoValue(fd, element)
+			if err != nil {
+				return err
+			}
+			list.Append(protoValue)
+		}
+		return nil
+	case fd.IsMap():
+		values, err := toObject(value)
+		if err != nil {
+			return err
+		}
+		protoMap := msg.Mutable(fd).Map()
+		for key, element := range values {
+			if element == nil {
+				continue
+			}
+			mapKey, err := toProtoValue(fd.MapKey(), key)
+			if err != nil {
+				return err
+			}
+			if fd.MapValue().Kind() == protoreflect.MessageKind {
+				elementMsg := protoMap.NewValue()
+				if err = setMessageFields(elementMsg.Message(), element); err != nil {
+					return err
+				}
+				protoMap.Set(mapKey.MapKey(), elementMsg)
+				continue
+			}
+			protoValue, err := toProtoValue(fd.MapValue(), element)
+			if err != nil {
+				return err
+			}
+			protoMap.Set(mapKey.MapKey(), protoValue)
+		}
+		return nil
+	case fd.Kind() == protoreflect.MessageKind:
+		return setMessageFields(msg.Mutable(fd).Message(), value)
+	default:
+		protoValue, err := toProtoValue(fd, value)
+		if err != nil {
+			return err
+		}
+		msg.Set(fd, protoValue)
+		return nil
+	}
+}
+
+func setMessageFields(msg protoreflect.Message, value interface{}) error {
+	fields, err := toObject(value)
+	if err != nil {
+		return err
+	}
+	for name, fieldValue := range fields {
+		if fieldValue == nil {
+			continue
+		}
+		if err = setMessagePath(msg, []string{name}, fieldValue); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func toList(value interface{}) ([]interface{}, error) {
+	switch v := value.(type) {
+	case []interface{}:
+		return v, nil
+	case string:
+		v = strings.TrimSpace(v)
+		if strings.HasPrefix(v, "[") {
+			var values []interface{}
+			if err := unmarshalUseNumber(v, &values); err != nil {
+				return nil, err
+			}
+			return values, nil
+		}
+		var values []interface{}
+		for _, element := range strings.Split(v, ",") {
+			values = append(values, strings.TrimSpace(element))
+		}
+		return values, nil
+	default:
+		return []interface{}{value}, nil
+	}
+}
+
+func toObject(value interface{}) (map[string]interface{}, error) {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		return v, nil
+	case Record:
+		return v, nil
+	case string:
+		values := make(map[string]interface{})
+		if err := unmarshalUseNumber(v, &values); err != nil {
+			return nil, err
+		}
+		return values, nil
+	default:
+		return nil, fmt.Errorf("can't convert %T to object", value)
+	}
+}
+
+func unmarshalUseNumber(data string, v interface{}) error {
+	decoder := json.NewDecoder(strings.NewReader(data))
+	decoder.UseNumber()
+	return decoder.Decode(v)
+}
+
+func toProtoValue(fd protoreflect.FieldDescriptor, value interface{}) (protoreflect.Value, error) {
+	text := toText(value)
+	switch fd.Kind() {
+	case protoreflect.StringKind:
+		return protoreflect.ValueOfString(text), nil
+	case protoreflect.BytesKind:
+		return protoreflect.ValueOfBytes([]byte(text)), nil
+	case protoreflect.BoolKind:
+		if b, ok := value.(bool); ok {
+			return protoreflect.ValueOfBool(b), nil
+		}
+		b, err := strconv.ParseBool(text)
+		if err != nil {
+			return protoreflect.Value{}, err
+		}
+		return protoreflect.ValueOfBool(b), nil
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		i, err := parseInt(text, 32)
+		return protoreflect.ValueOfInt32(int32(i)), err
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		i, err := parseInt(text, 64)
+		return protoreflect.ValueOfInt64(i), err
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		i, err := strconv.ParseUint(text, 10, 32)
+		return protoreflect.ValueOfUint32(uint32(i)), err
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		i, err := strconv.ParseUint(text, 10, 64)
+		return protoreflect.ValueOfUint64(i), err
+	case protoreflect.FloatKind:
+		f, err := strconv.ParseFloat(text, 32)
+		return protoreflect.ValueOfFloat32(float32(f)), err
+	case protoreflect.DoubleKind:
+		f, err := strconv.ParseFloat(text, 64)
+		return protoreflect.ValueOfFloat64(f), err
+	case protoreflect.EnumKind:
+		if enumValue := fd.Enum().Values().ByName(protoreflect.Name(text)); enumValue != nil {
+			return protoreflect.ValueOfEnum(enumValue.Number()), nil
+		}
+		i, err := parseInt(text, 32)
+		return protoreflect.ValueOfEnum(protoreflect.EnumNumber(i)), err
+	default:
+		return protoreflect.Value{}, fmt.Errorf("unsupported field kind %v", fd.Kind())
+	}
+}
+
+// parseInt parses integers, and also floats without fraction, e.g. "1.0" and "1e3"
+func parseInt(text string, bitSize int) (int64, error) {
+	i, err := strconv.ParseInt(text, 10, bitSize)
+	if err == nil {
+		return i, nil
+	}
+	f, floatErr := strconv.ParseFloat(text, 64)
+	if floatErr != nil || f != math.Trunc(f) {
+		return 0, err
+	}
+	limit := math.Ldexp(1, bitSize-1)
+	if f < -limit || f >= limit {
+		return 0, err
+	}
+	return int64(f), nil
+}
+
+func toText(value interface{}) string {
+	switch v := value.(type) {
+	case string:
+		return strings.TrimSpace(v)
+	case json.Number:
+		return v.String()
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	default:
+		return fmt.Sprint(value)
+	}
+}
@@ -0,0 +1,113 @@
+package core
+
+import (
+	"encoding/json"
+	"io"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/byteplus-sdk/sdk-go/common/protocol"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestRecordToMessage(t *testing.T) {
+	tests := []struct {
+		name    string
+		record  Record
+		mapping FieldMapping
+		want    proto.Message
+		wantErr bool
+	}{
+		{
+			name:    "csv_strings",
+			record:  Record{"op": "op-1", "finished": "true", "total": "1e3", "date": "2021-06-10"},
+			mapping: FieldMapping{"op": "name", "finished": "done", "total": "metadata.totalCount", "date": "metadata.date"},
+			want: &protocol.Operation{
+				Name: "op-1", Done: true, Metadata: &protocol.Metadata{TotalCount: 1000, Date: "2021-06-10"},
+			},
+		},
+		{
+			name:   "json_values",
+			record: Record{"name": "op-2", "metadata": map[string]interface{}{"success_count": json.Number("12")}},
+			want:   &protocol.Operation{Name: "op-2", Metadata: &protocol.Metadata{SuccessCount: 12}},
+		},
+		{
+			name: "null_values",
+			record: Record{"name": nil, "done": nil,
+				"metadata": map[string]interface{}{"success_count": nil, "total_count": json.Number("3")}},
+			want: &protocol.Operation{Metadata: &protocol.Metadata{TotalCount: 3}},
+		},
+		{
+			name:    "invalid_int",
+			record:  Record{"total": "12.5"},
+			mapping: FieldMapping{"total": "metadata.total_count"},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := &protocol.Operation{}
+			err := RecordToMessage(tt.record, tt.mapping, got)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("RecordToMessage() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && !proto.Equal(got, tt.want) {
+				t.Errorf("RecordToMessage() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	list := &protocol.ListOperationsResponse{}
+	record := Record{"operations": `[{"name": "a"}, null, {"name": "b", "done": true}]`}
+	if err := RecordToMessage(record, nil, list); err != nil || len(list.Operations) != 2 || !list.Operations[1].Done {
+		t.Errorf("RecordToMessage() of repeated message = %v, %v", list, err)
+	}
+}
+
+func TestMapRecord(t *testing.T) {
+	got := MapRecord(Record{"uid": "1", "city": "sg", "drop": "x", "gender": nil},
+		FieldMapping{"uid": "user_id", "city": "location.city", "drop": ""})
+	want := map[string]interface{}{"user_id": "1", "location": map[string]interface{}{"city": "sg"}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("MapRecord() = %v, want %v", got, want)
+	}
+}
+
+func TestOpenRecordReader(t *testing.T) {
+	dir := t.TempDir()
+	files := map[string]string{
+		"users.csv":   "user_id,gender\n1,male\n2,\n",
+		"users.jsonl": "{\"user_id\": \"1\", \"gender\": \"male\"}\n\n{\"user_id\": \"2\"}\n",
+	}
+	for name, content := range files {
+		path := filepath.Join(dir, name)
+		if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+		reader, err := OpenRecordReader(path)
+		if err != nil {
+			t.Fatalf("OpenRecordReader(%s) error = %v", name, err)
+		}
+		var records []Record
+		for {
+			record, err := reader.Read()
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				t.Fatalf("Read() of %s error = %v", name, err)
+			}
+			records = append(records, record)
+		}
+		_ = reader.Close()
+		want := []Record{{"user_id": "1", "gender": "male"}, {"user_id": "2"}}
+		if !reflect.DeepEqual(records, want) {
+			t.Errorf("records of %s = %v, want %v", name, records, want)
+		}
+	}
+	if _, err := OpenRecordReader(filepath.Join(dir, "users.orc")); err == nil {
+		t.Errorf("OpenRecordReader() of orc should fail")
+	}
+}
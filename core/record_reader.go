@@ -0,0 +1,147 @@
+package core
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+const maxJSONLRecordSize = 64 << 20
+
+// Record is a row read from file, the key is the column name of csv,
+// or the field name of json object
+type Record map[string]interface{}
+
+// RecordReader reads the records of a file one by one, io.EOF is returned
+// after the last record. Formats not supported by the sdk, e.g. parquet,
+// can be imported by implementing RecordReader with a third-party library
+type RecordReader interface {
+	Read() (Record, error)
+	// Line returns the position of the last record, which is the line number of
+	// jsonl file, or the row number of csv file with header as row 1
+	Line() int
+	Close() error
+}
+
+// OpenRecordReader opens the file with the reader chosen by file extension,
+// ".csv" for csv file, ".jsonl", ".ndjson" and ".json" for json lines file
+func OpenRecordReader(path string) (RecordReader, error) {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".csv":
+		return NewCSVRecordReader(path, ',')
+	case ".jsonl", ".ndjson", ".json":
+		return NewJSONLRecordReader(path)
+	case ".parquet":
+		return nil, errors.New("parquet is not supported by sdk, please implement RecordReader with a parquet library")
+	default:
+		return nil, fmt.Errorf("unsupported file format: %s", path)
+	}
+}
+
+type csvRecordReader struct {
+	file   *os.File
+	reader *csv.Reader
+	header []string
+	line   int
+}
+
+// NewCSVRecordReader reads the csv file whose first line is the header,
+// all values of record are string, empty values are skipped
+func NewCSVRecordReader(path string, comma rune) (RecordReader, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	reader := csv.NewReader(bufio.NewReader(file))
+	reader.Comma = comma
+	reader.ReuseRecord = true
+	header, err := reader.Read()
+	if err != nil {
+		_ = file.Close()
+		return nil, fmt.Errorf("read csv header fail, %w", err)
+	}
+	return &csvRecordReader{
+		file:   file,
+		reader: reader,
+		header: append([]string(nil), header...),
+		line:   1,
+	}, nil
+}
+
+func (receiver *csvRecordReader) Read() (Record, error) {
+	values, err := receiver.reader.Read()
+	if err != nil {
+		return nil, err
+	}
+	receiver.line++
+	record := make(Record, len(values))
+	for i, value := range values {
+		if i >= len(receiver.header) || value == "" {
+			continue
+		}
+		record[receiver.header[i]] = value
+	}
+	return record, nil
+}
+
+func (receiver *csvRecordReader) Line() int {
+	return receiver.line
+}
+
+func (receiver *csvRecordReader) Close() error {
+	return receiver.file.Close()
+}
+
+type jsonlRecordReader struct {
+	file    *os.File
+	scanner *bufio.Scanner
+	line    int
+}
+
+// NewJSONLRecordReader reads the file with a json object in each line,
+// numbers are kept as json.Number, and empty lines are skipped
+func NewJSONLRecordReader(path string) (RecordReader, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, 64*1024), maxJSONLRecordSize)
+	return &jsonlRecordReader{file: file, scanner: scanner}, nil
+}
+
+func (receiver *jsonlRecordReader) Read() (Record, error) {
+	for receiver.scanner.Scan() {
+		receiver.line++
+		line := bytes.TrimSpace(receiver.scanner.Bytes())
+		if len(line) == 0 {
+			continue
+		}
+		decoder := json.NewDecoder(bytes.NewReader(line))
+		decoder.UseNumber()
+		record := make(Record)
+		if err := decoder.Decode(&record); err != nil {
+			return nil, fmt.Errorf("line %d is not a json object, %w", receiver.line, err)
+		}
+		return record, nil
+	}
+	if err := receiver.scanner.Err(); err != nil {
+		return nil, err
+	}
+	return nil, io.EOF
+}
+
+func (receiver *jsonlRecordReader) Line() int {
+	return receiver.line
+}
+
+func (receiver *jsonlRecordReader) Close() error {
+	return receiver.file.Close()
+}
@@ -0,0 +1,241 @@
+package core
+
+import (
+	"errors"
+	"math/rand"
+	"strconv"
+	"sync/atomic"
+
+	"github.com/byteplus-sdk/sdk-go/core/logs"
+	"github.com/byteplus-sdk/sdk-go/core/metrics"
+	"github.com/byteplus-sdk/sdk-go/core/option"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	defaultShadowPredictSampleRate = 0.01
+	defaultShadowPredictTopK       = 10
+	defaultShadowPredictMaxPending = 100
+)
+
+// ShadowPredictConfig is the config of shadow predict, by which a sampled part of
+// Predict requests are also sent to a secondary target asynchronously, e.g. a
+// candidate stage, another tenant or host, and the results are compared with
+// the primary ones. The comparisons are reported by metrics, the overlap and
+// rank correlation are emitted as timers in per mille to get their distributions
+type ShadowPredictConfig struct {
+	// The ratio of the successful predict requests sent to the secondary target,
+	// in (0, 1], default is 0.01
+	SampleRate float64
+	// The top k items of results are compared, default is 10
+	TopK int
+	// Max count of the secondary requests in flight, the sampled requests are
+	// skipped when exceeding, so a slow target doesn't pile up goroutines,
+	// default is 100
+	MaxPending int
+	// Optional, the name of the secondary target in metrics tags
+	Target string
+	// Optional, the options of the secondary requests, appended to the options
+	// of the primary request, e.g. option.WithStage("candidate")
+	Options []option.Option
+	// Optional, called with each comparison in the goroutine of the secondary request
+	OnCompare func(comparison *PredictComparison)
+}
+
+func fillDefaultShadowPredictConfig(config *ShadowPredictConfig) *ShadowPredictConfig {
+	if config == nil {
+		return nil
+	}
+	result := *config
+	if result.SampleRate <= 0 || result.SampleRate > 1 {
+		result.SampleRate = defaultShadowPredictSampleRate
+	}
+	if result.TopK <= 0 {
+		result.TopK = defaultShadowPredictTopK
+	}
+	if result.MaxPending <= 0 {
+		result.MaxPending = defaultShadowPredictMaxPending
+	}
+	return &result
+}
+
+// PredictComparison is the comparison of the top k items predicted by the
+// primary target and the secondary target for the same request
+type PredictComparison struct {
+	Scene  string
+	Target string
+	TopK   int
+	// The count of items in both top k lists
+	CommonCount int
+	// |intersection| / |union| of the top k lists, 1 if both are empty
+	Jaccard float64
+	// The Spearman's rank correlation of the common items in both lists, in
+	// [-1, 1], it's 0 if there are less than 2 common items
+	RankCorrelation float64
+	// The error of the secondary request, the other fields are empty if it's set
+	Err error
+}
+
+// ComparePredictResults compares the top k of the item ids predicted by the
+// primary target and the secondary target, the ids are in the order of rank
+func ComparePredictResults(primaryIds []string, secondaryIds []string, k int) *PredictComparison {
+	primaryRanks := topRanks(primaryIds, k)
+	secondaryRanks := topRanks(secondaryIds, k)
+	comparison := &PredictComparison{TopK: k, Jaccard: 1}
+	var primaryCommon, secondaryCommon []int
+	for _, id := range topIds(primaryIds, k) {
+		if rank, exist := secondaryRanks[id]; exist {
+			primaryCommon = append(primaryCommon, primaryRanks[id])
+			secondaryCommon = append(secondaryCommon, rank)
+		}
+	}
+	comparison.CommonCount = len(primaryCommon)
+	union := len(primaryRanks) + len(secondaryRanks) - comparison.CommonCount
+	if union > 0 {
+		comparison.Jaccard = float64(comparison.CommonCount) / float64(union)
+	}
+	comparison.RankCorrelation = spearman(primaryCommon, secondaryCommon)
+	return comparison
+}
+
+// topRanks returns the 0-based rank of the first k distinct ids
+func topRanks(ids []string, k int) map[string]int {
+	ranks := make(map[string]int, k)
+	for _, id := range topIds(ids, k) {
+		ranks[id] = len(ranks)
+	}
+	return ranks
+}
+
+// topIds returns the first k distinct ids
+func topIds(ids []string, k int) []string {
+	seen := make(map[string]bool, k)
+	result := make([]string, 0, k)
+	for _, id := range ids {
+		if len(result) >= k {
+			break
+		}
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+		result = append(result, id)
+	}
+	return result
+}
+
+// spearman returns the rank correlation of the paired ranks, which are ranked
+// again among themselves. xs is in ascending order as it's ranked by the primary
+func spearman(xs []int, ys []int) float64 {
+	n := len(xs)
+	if n < 2 {
+		return 0
+	}
+	// the rank of ys[i] among ys
+	yRanks := make([]int, n)
+	for i := range ys {
+		for j := range ys {
+			if ys[j] < ys[i] {
+				yRanks[i]++
+			}
+		}
+	}
+	var sum float64
+	for i := range xs {
+		d := float64(i - yRanks[i])
+		sum += d * d
+	}
+	return 1 - 6*sum/float64(n*(n*n-1))
+}
+
+// ShadowPredictFunc sends the predict request, which is a clone of the primary
+// one, to the secondary target with the options of ShadowPredictConfig
+type ShadowPredictFunc func(request proto.Message, opts []option.Option) (proto.Message, error)
+
+// PredictIdsFunc returns the item ids of predict response in order
+type PredictIdsFunc func(response proto.Message) []string
+
+// ShadowPredictor samples the predict requests and compares the results of the
+// secondary target with the primary ones, it's nil if ShadowPredictConfig is not set
+type ShadowPredictor struct {
+	config  *ShadowPredictConfig
+	ids     PredictIdsFunc
+	pending int64
+}
+
+func NewShadowPredictor(config *ShadowPredictConfig, ids PredictIdsFunc) *ShadowPredictor {
+	if config == nil {
+		return nil
+	}
+	config = fillDefaultShadowPredictConfig(config)
+	return &ShadowPredictor{config: config, ids: ids}
+}
+
+// Shadow calls predict asynchronously with a clone of request if the request is
+// sampled, and compares its response with the primary response of the scene. The
+// primary responses which are not success or are fallbacks are not sampled, and
+// such secondary responses are regarded as errors
+func (receiver *ShadowPredictor) Shadow(scene string, request proto.Message,
+	primary proto.Message, predict ShadowPredictFunc) {
+	if receiver == nil || predictResponseError(primary) != nil ||
+		rand.Float64() >= receiver.config.SampleRate {
+		return
+	}
+	if atomic.AddInt64(&receiver.pending, 1) > int64(receiver.config.MaxPending) {
+		atomic.AddInt64(&receiver.pending, -1)
+		logs.Debug("[ShadowPredict] too many pending requests, skip scene:%s", scene)
+		return
+	}
+	// the primary request and response are owned by caller after return
+	request = proto.Clone(request)
+	primaryIds := receiver.ids(primary)
+	AsyncExecute(func() {
+		defer atomic.AddInt64(&receiver.pending, -1)
+		response, err := predict(request, receiver.config.Options)
+		if err == nil {
+			err = predictResponseError(response)
+		}
+		var comparison *PredictComparison
+		if err != nil {
+			comparison = &PredictComparison{TopK: receiver.config.TopK, Err: err}
+		} else {
+			comparison = ComparePredictResults(primaryIds, receiver.ids(response), receiver.config.TopK)
+		}
+		comparison.Scene = scene
+		comparison.Target = receiver.config.Target
+		receiver.emitMetrics(comparison)
+		if receiver.config.OnCompare != nil {
+			receiver.config.OnCompare(comparison)
+		}
+	})
+}
+
+// predictResponseError returns StatusError if the status of response is not
+// success, or an error if it's a fallback response
+func predictResponseError(response proto.Message) error {
+	code, message, ok := responseStatus(response)
+	if ok && code != StatusCodeSuccess {
+		return &StatusError{Code: code, Message: message, Response: response}
+	}
+	if isPredictFallback(response) {
+		return errors.New("predict response is a fallback")
+	}
+	return nil
+}
+func (receiver *ShadowPredictor) emitMetrics(comparison *PredictComparison) {
+	metricsTags := []string{
+		"scene:" + escapeMetricsTagValue(comparison.Scene),
+		"target:" + escapeMetricsTagValue(comparison.Target),
+		"top_k:" + strconv.Itoa(comparison.TopK),
+	}
+	if comparison.Err != nil {
+		logs.Warn("[ShadowPredict] secondary request fail, scene:%s err:%s", comparison.Scene, comparison.Err)
+		metrics.Counter(metricsKeyShadowPredictCount, 1, append(metricsTags, "type:fail")...)
+		return
+	}
+	metrics.Counter(metricsKeyShadowPredictCount, 1, append(metricsTags, "type:success")...)
+	metrics.Timer(metricsKeyShadowPredictJaccard, int64(comparison.Jaccard*1000), metricsTags...)
+	if comparison.CommonCount >= 2 {
+		metrics.Timer(metricsKeyShadowPredictRankCorrelation, int64(comparison.RankCorrelation*1000), metricsTags...)
+	}
+}
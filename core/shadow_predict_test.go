@@ -0,0 +1,193 @@
+package core
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/byteplus-sdk/sdk-go/core/option"
+	"github.com/byteplus-sdk/sdk-go/general/protocol"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestComparePredictResults(t *testing.T) {
+	tests := []struct {
+		name            string
+		primary         []string
+		secondary       []string
+		k               int
+		wantCommon      int
+		wantJaccard     float64
+		wantCorrelation float64
+	}{
+		{
+			name:            "same",
+			primary:         []string{"1", "2", "3"},
+			secondary:       []string{"1", "2", "3"},
+			k:               10,
+			wantCommon:      3,
+			wantJaccard:     1,
+			wantCorrelation: 1,
+		},
+		{
+			name:            "reversed",
+			primary:         []string{"1", "2", "3"},
+			secondary:       []string{"3", "2", "1"},
+			k:               10,
+			wantCommon:      3,
+			wantJaccard:     1,
+			wantCorrelation: -1,
+		},
+		{
+			name:            "partial_overlap_in_top_k",
+			primary:         []string{"1", "2", "3", "4"},
+			secondary:       []string{"2", "1", "5", "3"},
+			k:               3,
+			wantCommon:      2,
+			wantJaccard:     0.5,
+			wantCorrelation: -1,
+		},
+		{
+			name:        "disjoint",
+			primary:     []string{"1"},
+			secondary:   []string{"2"},
+			k:           10,
+			wantJaccard: 0,
+		},
+		{
+			name:        "both_empty",
+			k:           10,
+			wantJaccard: 1,
+		},
+		{
+			name:            "duplicates",
+			primary:         []string{"1", "1", "2"},
+			secondary:       []string{"1", "2"},
+			k:               2,
+			wantCommon:      2,
+			wantJaccard:     1,
+			wantCorrelation: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ComparePredictResults(tt.primary, tt.secondary, tt.k)
+			if got.CommonCount != tt.wantCommon || math.Abs(got.Jaccard-tt.wantJaccard) > 1e-9 ||
+				math.Abs(got.RankCorrelation-tt.wantCorrelation) > 1e-9 {
+				t.Errorf("ComparePredictResults() = %+v, want common %d jaccard %v correlation %v",
+					got, tt.wantCommon, tt.wantJaccard, tt.wantCorrelation)
+			}
+		})
+	}
+}
+
+func TestShadowPredictor_Shadow(t *testing.T) {
+	response := func(ids ...string) *protocol.PredictResponse {
+		result := &protocol.PredictResponse{Value: &protocol.PredictResult{}}
+		for _, id := range ids {
+			result.Value.Items = append(result.Value.Items, &protocol.PredictItem{Id: id})
+		}
+		return result
+	}
+	ids := func(response proto.Message) []string {
+		var result []string
+		for _, item := range response.(*protocol.PredictResponse).GetValue().GetItems() {
+			result = append(result, item.GetId())
+		}
+		return result
+	}
+	fallback := response("popular")
+	markPredictFallback(fallback, PredictFallbackSourceStatic)
+	tests := []struct {
+		name        string
+		primary     *protocol.PredictResponse
+		secondary   *protocol.PredictResponse
+		err         error
+		wantSampled bool
+		wantErr     bool
+		wantJaccard float64
+	}{
+		{
+			name:        "compared",
+			primary:     response("1", "2"),
+			secondary:   response("2", "3"),
+			wantSampled: true,
+			wantJaccard: 1.0 / 3,
+		},
+		{
+			name:        "secondary_error",
+			primary:     response("1"),
+			err:         errors.New("timeout"),
+			wantSampled: true,
+			wantErr:     true,
+		},
+		{
+			name:        "secondary_status_error",
+			primary:     response("1"),
+			secondary:   &protocol.PredictResponse{Code: 500},
+			wantSampled: true,
+			wantErr:     true,
+		},
+		{
+			name:        "secondary_fallback",
+			primary:     response("1"),
+			secondary:   fallback,
+			wantSampled: true,
+			wantErr:     true,
+		},
+		{
+			name:    "primary_fallback_not_sampled",
+			primary: fallback,
+		},
+		{
+			name:    "primary_status_error_not_sampled",
+			primary: &protocol.PredictResponse{Code: 500},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			compared := make(chan *PredictComparison, 1)
+			shadow := NewShadowPredictor(&ShadowPredictConfig{
+				SampleRate: 1,
+				Target:     "candidate",
+				Options:    []option.Option{option.WithStage("candidate")},
+				OnCompare: func(comparison *PredictComparison) {
+					compared <- comparison
+				},
+			}, ids)
+			sampled := false
+			request := &protocol.PredictRequest{User: &protocol.PredictUser{Uid: "u1"}}
+			shadow.Shadow("home", request, tt.primary, func(shadowRequest proto.Message,
+				opts []option.Option) (proto.Message, error) {
+				sampled = true
+				if shadowRequest == request || !proto.Equal(shadowRequest, request) {
+					t.Errorf("secondary request is not a clone of primary request")
+				}
+				if options := option.Conv2Options(opts...); options.Stage != "candidate" {
+					t.Errorf("options of secondary request are not applied")
+				}
+				return tt.secondary, tt.err
+			})
+			if !tt.wantSampled {
+				if sampled {
+					t.Errorf("Shadow() should not sample")
+				}
+				return
+			}
+			got := <-compared
+			if got.Scene != "home" || got.Target != "candidate" || (got.Err != nil) != tt.wantErr ||
+				math.Abs(got.Jaccard-tt.wantJaccard) > 1e-9 {
+				t.Errorf("comparison = %+v", got)
+			}
+		})
+	}
+}
+
+func TestShadowPredictor_nil(t *testing.T) {
+	var shadow *ShadowPredictor
+	shadow.Shadow("home", &protocol.PredictRequest{}, &protocol.PredictResponse{}, func(request proto.Message,
+		opts []option.Option) (proto.Message, error) {
+		t.Errorf("nil ShadowPredictor should not sample")
+		return nil, nil
+	})
+}
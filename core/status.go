@@ -0,0 +1,143 @@
+package core
+
+import (
+	"fmt"
+
+	"github.com/byteplus-sdk/sdk-go/core/logs"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// StrictStatusConfig when it's set, responses whose `Status.Code` (or `code`
+// of some general responses) is not success are returned as StatusError
+type StrictStatusConfig struct {
+	// Regard StatusCodeIdempotent as success, which means the request with
+	// the same "Request-Id" has been received before
+	IdempotentAsSuccess bool
+	// Return StatusError when the response has per-item errors,
+	// even if the status code is success
+	PartialFailureAsError bool
+}
+
+// StatusError is returned in strict status mode when the status of response is not success
+type StatusError struct {
+	Code      int32
+	Message   string
+	RequestId string
+	// The per-item errors in response, the concrete type depends on the
+	// request, e.g. *retail/protocol.UserError for WriteUsers
+	ItemErrors []proto.Message
+	// The whole response
+	Response proto.Message
+}
+
+func (e *StatusError) Error() string {
+	if len(e.ItemErrors) > 0 {
+		return fmt.Sprintf("response status not success, code:%d msg:%s requestId:%s itemErrors:%d",
+			e.Code, e.Message, e.RequestId, len(e.ItemErrors))
+	}
+	return fmt.Sprintf("response status not success, code:%d msg:%s requestId:%s",
+		e.Code, e.Message, e.RequestId)
+}
+
+// IsIdempotent a request with the same "Request-Id" was already received
+func (e *StatusError) IsIdempotent() bool {
+	return e.Code == StatusCodeIdempotent
+}
+
+// IsOperationLoss operation information is missing due to an unknown exception
+func (e *StatusError) IsOperationLoss() bool {
+	return e.Code == StatusCodeOperationLoss
+}
+
+// IsTooManyRequest the server hope slow down request frequency
+func (e *StatusError) IsTooManyRequest() bool {
+	return e.Code == StatusCodeTooManyRequest
+}
+
+// AsStatusError returns the StatusError if err is one
+func AsStatusError(err error) (*StatusError, bool) {
+	statusErr, ok := err.(*StatusError)
+	return statusErr, ok
+}
+
+func (c *HTTPCaller) checkStatus(reqID string, response proto.Message) error {
+	config := c.context.strictStatusConfig
+	if config == nil {
+		return nil
+	}
+	code, message, ok := responseStatus(response)
+	if !ok {
+		return nil
+	}
+	itemErrors := responseItemErrors(response)
+	if code == StatusCodeSuccess || (code == StatusCodeIdempotent && config.IdempotentAsSuccess) {
+		if len(itemErrors) == 0 || !config.PartialFailureAsError {
+			return nil
+		}
+	}
+	logs.Warn("response status not success, code:%d msg:%s requestId:%s", code, message, reqID)
+	return &StatusError{
+		Code:       code,
+		Message:    message,
+		RequestId:  reqID,
+		ItemErrors: itemErrors,
+		Response:   response,
+	}
+}
+
+// responseItemErrors returns the per-item errors of write or import responses,
+// which are in the `errors` field or the `error_samples` field
+func responseItemErrors(response proto.Message) []proto.Message {
+	msg := response.ProtoReflect()
+	fields := msg.Descriptor().Fields()
+	for _, name := range []protoreflect.Name{"errors", "error_samples"} {
+		field := fields.ByName(name)
+		if field == nil || !field.IsList() || field.Kind() != protoreflect.MessageKind {
+			continue
+		}
+		list := msg.Get(field).List()
+		if list.Len() == 0 {
+			return nil
+		}
+		result := make([]proto.Message, 0, list.Len())
+		for i := 0; i < list.Len(); i++ {
+			result = append(result, list.Get(i).Message().Interface())
+		}
+		return result
+	}
+	return nil
+}
+
+// responseStatus returns the business status of response. Most responses carry
+// a `status` field of common.Status, while some general responses put `code`
+// and `message` at the top level. The last return value is false if the
+// response has neither of them
+func responseStatus(response proto.Message) (int32, string, bool) {
+	if response == nil {
+		return 0, "", false
+	}
+	msg := response.ProtoReflect()
+	statusField := msg.Descriptor().Fields().ByName("status")
+	if statusField != nil && statusField.Kind() == protoreflect.MessageKind {
+		if !msg.Has(statusField) {
+			return 0, "", false
+		}
+		return codeAndMessage(msg.Get(statusField).Message())
+	}
+	return codeAndMessage(msg)
+}
+
+func codeAndMessage(msg protoreflect.Message) (int32, string, bool) {
+	fields := msg.Descriptor().Fields()
+	codeField := fields.ByName("code")
+	if codeField == nil || codeField.Kind() != protoreflect.Int32Kind {
+		return 0, "", false
+	}
+	var message string
+	if messageField := fields.ByName("message"); messageField != nil &&
+		messageField.Kind() == protoreflect.StringKind {
+		message = msg.Get(messageField).String()
+	}
+	return int32(msg.Get(codeField).Int()), message, true
+}
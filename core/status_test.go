@@ -0,0 +1,71 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/byteplus-sdk/sdk-go/common/protocol"
+)
+
+func Test_responseStatus(t *testing.T) {
+	code, message, ok := responseStatus(&protocol.DoneResponse{
+		Status: &protocol.Status{Code: StatusCodeTooManyRequest, Message: "slow down"},
+	})
+	if !ok || code != StatusCodeTooManyRequest || message != "slow down" {
+		t.Errorf("responseStatus() = %v, %v, %v", code, message, ok)
+	}
+	if _, _, ok := responseStatus(&protocol.DoneResponse{}); ok {
+		t.Errorf("responseStatus() without status should not be ok")
+	}
+	code, _, ok = responseStatus(&protocol.Status{Code: StatusCodeIdempotent})
+	if !ok || code != StatusCodeIdempotent {
+		t.Errorf("responseStatus() of top level code = %v, %v", code, ok)
+	}
+}
+
+func TestHTTPCaller_checkStatus(t *testing.T) {
+	tests := []struct {
+		name     string
+		config   *StrictStatusConfig
+		response *protocol.ListOperationsResponse
+		wantErr  bool
+	}{
+		{
+			name:     "not_strict",
+			response: &protocol.ListOperationsResponse{Status: &protocol.Status{Code: 500}},
+		},
+		{
+			name:     "success",
+			config:   &StrictStatusConfig{},
+			response: &protocol.ListOperationsResponse{Status: &protocol.Status{Code: StatusCodeSuccess}},
+		},
+		{
+			name:     "idempotent",
+			config:   &StrictStatusConfig{},
+			response: &protocol.ListOperationsResponse{Status: &protocol.Status{Code: StatusCodeIdempotent}},
+			wantErr:  true,
+		},
+		{
+			name:     "idempotent_as_success",
+			config:   &StrictStatusConfig{IdempotentAsSuccess: true},
+			response: &protocol.ListOperationsResponse{Status: &protocol.Status{Code: StatusCodeIdempotent}},
+		},
+		{
+			name:     "operation_loss",
+			config:   &StrictStatusConfig{IdempotentAsSuccess: true},
+			response: &protocol.ListOperationsResponse{Status: &protocol.Status{Code: StatusCodeOperationLoss}},
+			wantErr:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &HTTPCaller{context: &Context{strictStatusConfig: tt.config}}
+			err := c.checkStatus("req_id", tt.response)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("checkStatus() err = %v, wantErr %v", err, tt.wantErr)
+			}
+			if statusErr, ok := AsStatusError(err); ok && statusErr.Code != tt.response.Status.Code {
+				t.Errorf("StatusError.Code = %v, want %v", statusErr.Code, tt.response.Status.Code)
+			}
+		})
+	}
+}
@@ -4,6 +4,9 @@ import (
 	"log"
 	"runtime/debug"
 	"strings"
+	"time"
+
+	"github.com/byteplus-sdk/sdk-go/core/option"
 )
 
 func AsyncExecute(runnable func()) {
@@ -29,6 +32,21 @@ func IsTimeoutError(err error) bool {
 	return strings.Contains(strings.ToLower(err.Error()), "timeout")
 }
 
+// FillDateOptions sends the `DateConfig` of import request as the Content-Date
+// and Content-End headers, unless they are set by option.WithDataDate and
+// option.WithDateEnd. An unparsable date is left to be rejected by server
+func FillDateOptions(options *option.Options, date string, isEnd bool) {
+	if options.DataDate.IsZero() && date != "" {
+		dataDate, err := time.ParseInLocation("2006-01-02", date, time.Local)
+		if err == nil {
+			options.DataDate = dataDate
+		}
+	}
+	if isEnd {
+		options.DataIsEnd = true
+	}
+}
+
 func escapeMetricsTagValue(value string) string {
 	value = strings.ReplaceAll(value, "?", "-qu-")
 	value = strings.ReplaceAll(value, "&", "-and-")
@@ -0,0 +1,27 @@
+package core
+
+import (
+	"testing"
+	"time"
+
+	"github.com/byteplus-sdk/sdk-go/core/option"
+)
+
+func TestFillDateOptions(t *testing.T) {
+	options := &option.Options{}
+	FillDateOptions(options, "2021-06-10", true)
+	if want := time.Date(2021, 6, 10, 0, 0, 0, 0, time.Local); !options.DataDate.Equal(want) || !options.DataIsEnd {
+		t.Errorf("FillDateOptions() date = %v, end = %v", options.DataDate, options.DataIsEnd)
+	}
+	date := time.Date(2021, 6, 11, 0, 0, 0, 0, time.UTC)
+	options = &option.Options{DataDate: date}
+	FillDateOptions(options, "2021-06-10", false)
+	if !options.DataDate.Equal(date) || options.DataIsEnd {
+		t.Errorf("FillDateOptions() overrides the options, date = %v, end = %v", options.DataDate, options.DataIsEnd)
+	}
+	options = &option.Options{}
+	FillDateOptions(options, "20210610", false)
+	if !options.DataDate.IsZero() {
+		t.Errorf("FillDateOptions() of unparsable date = %v", options.DataDate)
+	}
+}
@@ -0,0 +1,77 @@
+package core
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/byteplus-sdk/sdk-go/core/logs"
+)
+
+const (
+	// MaxSecondTimestamp timestamps larger than it are regarded as milliseconds,
+	// which is about year 5138 in seconds
+	MaxSecondTimestamp = 1e11
+	// tolerate the clock skew between the client and the event source
+	futureTimestampTolerance = 10 * time.Minute
+)
+
+// ValidationIssue is a problem of an item found by client-side validation
+type ValidationIssue struct {
+	// The index of the item in the request
+	Index   int
+	Field   string
+	Message string
+	// Warnings are logged, and don't fail the validation of Write methods
+	Warning bool
+}
+
+func (receiver *ValidationIssue) String() string {
+	level := "error"
+	if receiver.Warning {
+		level = "warning"
+	}
+	return fmt.Sprintf("%s of item %d, %s: %s", level, receiver.Index, receiver.Field, receiver.Message)
+}
+
+// ValidationError is returned by Write methods with option.WithValidation()
+// when some items are invalid, the request is not sent
+type ValidationError struct {
+	// The issues which are not warnings
+	Issues []*ValidationIssue
+}
+
+func (e *ValidationError) Error() string {
+	return fmt.Sprintf("validation fail, %d issues, the first is %s", len(e.Issues), e.Issues[0])
+}
+
+// CheckValidationIssues logs the warnings of issues, and returns ValidationError
+// if there is any issue not a warning
+func CheckValidationIssues(issues []*ValidationIssue) error {
+	var errorIssues []*ValidationIssue
+	for _, issue := range issues {
+		if issue.Warning {
+			logs.Warn("[Validate] %s", issue)
+			continue
+		}
+		errorIssues = append(errorIssues, issue)
+	}
+	if len(errorIssues) == 0 {
+		return nil
+	}
+	return &ValidationError{Issues: errorIssues}
+}
+
+// ValidateTimestamp returns the problem of an event timestamp in seconds, which
+// is empty if the timestamp is valid
+func ValidateTimestamp(timestamp int64, now time.Time) string {
+	if timestamp <= 0 {
+		return "is empty"
+	}
+	if timestamp > MaxSecondTimestamp {
+		return fmt.Sprintf("%d should be in seconds rather than milliseconds", timestamp)
+	}
+	if timestamp > now.Add(futureTimestampTolerance).Unix() {
+		return fmt.Sprintf("%d is in the future", timestamp)
+	}
+	return ""
+}
@@ -0,0 +1,44 @@
+package core
+
+import (
+	"testing"
+	"time"
+)
+
+func TestValidateTimestamp(t *testing.T) {
+	now := time.Unix(1640000000, 0)
+	tests := []struct {
+		name      string
+		timestamp int64
+		wantValid bool
+	}{
+		{name: "seconds", timestamp: now.Unix() - 3600, wantValid: true},
+		{name: "clock_skew", timestamp: now.Unix() + 60, wantValid: true},
+		{name: "empty", timestamp: 0},
+		{name: "milliseconds", timestamp: now.UnixNano() / int64(time.Millisecond)},
+		{name: "future", timestamp: now.Unix() + 86400},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if problem := ValidateTimestamp(tt.timestamp, now); (problem == "") != tt.wantValid {
+				t.Errorf("ValidateTimestamp() = %q, wantValid %v", problem, tt.wantValid)
+			}
+		})
+	}
+}
+
+func TestCheckValidationIssues(t *testing.T) {
+	warning := &ValidationIssue{Index: 1, Field: "price.current_price", Message: "is high", Warning: true}
+	if err := CheckValidationIssues([]*ValidationIssue{warning}); err != nil {
+		t.Errorf("CheckValidationIssues() of warnings error = %v", err)
+	}
+	issue := &ValidationIssue{Index: 2, Field: "user_id", Message: "is empty"}
+	err := CheckValidationIssues([]*ValidationIssue{warning, issue})
+	validationErr, ok := err.(*ValidationError)
+	if !ok || len(validationErr.Issues) != 1 || validationErr.Issues[0] != issue {
+		t.Fatalf("CheckValidationIssues() error = %v", err)
+	}
+	if want := "validation fail, 1 issues, the first is error of item 2, user_id: is empty"; err.Error() != want {
+		t.Errorf("Error() = %q, want %q", err.Error(), want)
+	}
+}
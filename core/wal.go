@@ -0,0 +1,547 @@
+package core
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/byteplus-sdk/sdk-go/core/logs"
+)
+
+const (
+	defaultWALSegmentSize  = 64 << 20
+	defaultWALMaxDiskSize  = 1 << 30
+	defaultWALSyncInterval = time.Second
+
+	walSegmentSuffix  = ".wal"
+	walCheckpointFile = "checkpoint"
+	// length and crc32 of the payload
+	walRecordHeaderSize = 8
+	walMaxRecordSize    = 64 << 20
+)
+
+var (
+	// WALFullErr is returned by Append when the disk size of WAL exceeds MaxDiskSize
+	WALFullErr = errors.New("wal is full")
+
+	walClosedErr  = errors.New("wal is closed")
+	walCrc32Table = crc32.MakeTable(crc32.Castagnoli)
+)
+
+type WALSyncPolicy int
+
+const (
+	// WALSyncEveryAppend fsync the segment before Append returns,
+	// no appended record is lost even if the machine crashes
+	WALSyncEveryAppend WALSyncPolicy = iota
+	// WALSyncInterval fsync the segment every SyncInterval, the records appended
+	// in the last interval may be lost if the machine crashes, but not if the
+	// process restarts
+	WALSyncInterval
+	// WALSyncNever leaves the flushing to the operating system
+	WALSyncNever
+)
+
+type WALConfig struct {
+	// Required, the local directory of segment files and checkpoint
+	Dir string
+	// A new segment file is created when the current one exceeds SegmentSize,
+	// default is 64MB
+	SegmentSize int64
+	// Append fails with WALFullErr if the total size of segment files exceeds
+	// MaxDiskSize, default is 1GB
+	MaxDiskSize  int64
+	SyncPolicy   WALSyncPolicy
+	SyncInterval time.Duration
+}
+
+// WAL is a write-ahead log made up of segment files in a local directory.
+// Records are identified by continuous offsets, the records before
+// the committed offset are regarded as consumed, and the segment files
+// only containing consumed records are deleted
+type WAL struct {
+	config     *WALConfig
+	lock       sync.Mutex
+	segments   []*walSegment
+	active     *os.File
+	activeSize int64
+	diskSize   int64
+	nextOffset uint64
+	committed  uint64
+	dirty      bool
+	closed     bool
+	stopSync   chan struct{}
+
+	// the reader of the records not read yet
+	readFile   *os.File
+	reader     *bufio.Reader
+	readFirst  uint64
+	readOffset uint64
+}
+
+type walSegment struct {
+	firstOffset uint64
+	path        string
+	size        int64
+}
+
+func OpenWAL(config *WALConfig) (*WAL, error) {
+	config, err := fillDefaultWALConfig(config)
+	if err != nil {
+		return nil, err
+	}
+	if err = os.MkdirAll(config.Dir, 0755); err != nil {
+		return nil, err
+	}
+	wal := &WAL{config: config, stopSync: make(chan struct{})}
+	if err = wal.recover(); err != nil {
+		wal.closeFiles()
+		return nil, err
+	}
+	if config.SyncPolicy == WALSyncInterval {
+		AsyncExecute(wal.syncLoop)
+	}
+	return wal, nil
+}
+
+func fillDefaultWALConfig(config *WALConfig) (*WALConfig, error) {
+	if config == nil || config.Dir == "" {
+		return nil, errors.New("wal dir is empty")
+	}
+	result := *config
+	if result.SegmentSize <= 0 {
+		result.SegmentSize = defaultWALSegmentSize
+	}
+	if result.MaxDiskSize <= 0 {
+		result.MaxDiskSize = defaultWALMaxDiskSize
+	}
+	if result.SyncInterval <= 0 {
+		result.SyncInterval = defaultWALSyncInterval
+	}
+	return &result, nil
+}
+
+func (receiver *WAL) recover() error {
+	committed, err := receiver.readCheckpoint()
+	if err != nil {
+		return err
+	}
+	if err = receiver.loadSegments(); err != nil {
+		return err
+	}
+	if len(receiver.segments) == 0 {
+		receiver.nextOffset = committed
+		if err = receiver.createSegment(); err != nil {
+			return err
+		}
+	} else if err = receiver.openLastSegment(); err != nil {
+		return err
+	}
+	if first := receiver.segments[0].firstOffset; committed < first {
+		committed = first
+	}
+	if committed > receiver.nextOffset {
+		logs.Warn("[WAL] checkpoint %d exceeds the last record %d", committed, receiver.nextOffset)
+		committed = receiver.nextOffset
+	}
+	receiver.committed = committed
+	receiver.readOffset = committed
+	return receiver.removeConsumedSegments()
+}
+
+func (receiver *WAL) readCheckpoint() (uint64, error) {
+	content, err := ioutil.ReadFile(filepath.Join(receiver.config.Dir, walCheckpointFile))
+	if os.IsNotExist(err) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	return strconv.ParseUint(strings.TrimSpace(string(content)), 10, 64)
+}
+
+func (receiver *WAL) loadSegments() error {
+	files, err := ioutil.ReadDir(receiver.config.Dir)
+	if err != nil {
+		return err
+	}
+	for _, file := range files {
+		name := file.Name()
+		if file.IsDir() || !strings.HasSuffix(name, walSegmentSuffix) {
+			continue
+		}
+		firstOffset, err := strconv.ParseUint(strings.TrimSuffix(name, walSegmentSuffix), 10, 64)
+		if err != nil {
+			continue
+		}
+		receiver.segments = append(receiver.segments, &walSegment{
+			firstOffset: firstOffset,
+			path:        filepath.Join(receiver.config.Dir, name),
+			size:        file.Size(),
+		})
+		receiver.diskSize += file.Size()
+	}
+	sort.Slice(receiver.segments, func(i, j int) bool {
+		return receiver.segments[i].firstOffset < receiver.segments[j].firstOffset
+	})
+	return nil
+}
+
+// openLastSegment counts the records of the last segment to find the next
+// offset, the incomplete record written before crash is truncated
+func (receiver *WAL) openLastSegment() error {
+	last := receiver.segments[len(receiver.segments)-1]
+	file, err := os.OpenFile(last.path, os.O_RDWR, 0644)
+	if err != nil {
+		return err
+	}
+	reader := bufio.NewReader(file)
+	var count uint64
+	var validSize int64
+	for {
+		record, err := readWALRecord(reader)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			logs.Warn("[WAL] truncate broken record of %s at %d, err:%s", last.path, validSize, err.Error())
+			break
+		}
+		count++
+		validSize += int64(walRecordHeaderSize + len(record))
+	}
+	if validSize < last.size {
+		if err = file.Truncate(validSize); err != nil {
+			_ = file.Close()
+			return err
+		}
+		receiver.diskSize -= last.size - validSize
+		last.size = validSize
+	}
+	if _, err = file.Seek(validSize, io.SeekStart); err != nil {
+		_ = file.Close()
+		return err
+	}
+	receiver.active = file
+	receiver.activeSize = validSize
+	receiver.nextOffset = last.firstOffset + count
+	return nil
+}
+
+func (receiver *WAL) createSegment() error {
+	path := filepath.Join(receiver.config.Dir, fmt.Sprintf("%020d%s", receiver.nextOffset, walSegmentSuffix))
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0644)
+	if err != nil {
+		return err
+	}
+	receiver.segments = append(receiver.segments, &walSegment{firstOffset: receiver.nextOffset, path: path})
+	receiver.active = file
+	receiver.activeSize = 0
+	return nil
+}
+
+func (receiver *WAL) rotate() error {
+	if err := receiver.active.Sync(); err != nil {
+		return err
+	}
+	if err := receiver.active.Close(); err != nil {
+		return err
+	}
+	receiver.dirty = false
+	return receiver.createSegment()
+}
+
+// Append writes records to the end of WAL, the records are persisted
+// according to SyncPolicy when it returns without error
+func (receiver *WAL) Append(records [][]byte) error {
+	var buf []byte
+	for _, record := range records {
+		if len(record) > walMaxRecordSize {
+			return fmt.Errorf("wal record size %d exceeds %d", len(record), walMaxRecordSize)
+		}
+		buf = appendWALRecord(buf, record)
+	}
+	receiver.lock.Lock()
+	defer receiver.lock.Unlock()
+	if receiver.closed {
+		return walClosedErr
+	}
+	if receiver.diskSize+int64(len(buf)) > receiver.config.MaxDiskSize {
+		if err := receiver.releaseActiveSegment(); err != nil {
+			return err
+		}
+		if receiver.diskSize+int64(len(buf)) > receiver.config.MaxDiskSize {
+			return WALFullErr
+		}
+	}
+	if receiver.activeSize >= receiver.config.SegmentSize {
+		if err := receiver.rotate(); err != nil {
+			return err
+		}
+	}
+	if _, err := receiver.active.Write(buf); err != nil {
+		// drop the partially written records, so that the following records are readable
+		_ = receiver.active.Truncate(receiver.activeSize)
+		_, _ = receiver.active.Seek(receiver.activeSize, io.SeekStart)
+		return err
+	}
+	if receiver.config.SyncPolicy == WALSyncEveryAppend {
+		if err := receiver.active.Sync(); err != nil {
+			return err
+		}
+	} else {
+		receiver.dirty = true
+	}
+	receiver.activeSize += int64(len(buf))
+	receiver.segments[len(receiver.segments)-1].size = receiver.activeSize
+	receiver.diskSize += int64(len(buf))
+	receiver.nextOffset += uint64(len(records))
+	return nil
+}
+
+// Read returns at most `max` records following the records returned by
+// the last Read, starting from the committed offset after opening. The
+// second return value is the offset after the returned records, which
+// should be committed after the records are consumed. The records returned
+// along with an error are valid, and the next Read resumes after them
+func (receiver *WAL) Read(max int) ([][]byte, uint64, error) {
+	receiver.lock.Lock()
+	defer receiver.lock.Unlock()
+	if receiver.closed {
+		return nil, receiver.readOffset, walClosedErr
+	}
+	var records [][]byte
+	for len(records) < max && receiver.readOffset < receiver.nextOffset {
+		if receiver.reader == nil || receiver.readOffset >= receiver.segmentEnd(receiver.readFirst) {
+			if err := receiver.openReader(); err != nil {
+				return records, receiver.readOffset, err
+			}
+		}
+		record, err := readWALRecord(receiver.reader)
+		if err != nil {
+			// the reader may stop in the middle of the record, it's reopened at
+			// readOffset by the next Read
+			receiver.closeReader()
+			return records, receiver.readOffset, err
+		}
+		records = append(records, record)
+		receiver.readOffset++
+	}
+	return records, receiver.readOffset, nil
+}
+
+// Pending returns the count of records not committed
+func (receiver *WAL) Pending() uint64 {
+	receiver.lock.Lock()
+	defer receiver.lock.Unlock()
+	return receiver.nextOffset - receiver.committed
+}
+
+// Unread returns the count of records not returned by Read
+func (receiver *WAL) Unread() uint64 {
+	receiver.lock.Lock()
+	defer receiver.lock.Unlock()
+	return receiver.nextOffset - receiver.readOffset
+}
+
+// Commit marks the records before offset as consumed, they won't be
+// read again after reopening
+func (receiver *WAL) Commit(offset uint64) error {
+	receiver.lock.Lock()
+	defer receiver.lock.Unlock()
+	if receiver.closed {
+		return walClosedErr
+	}
+	if offset <= receiver.committed {
+		return nil
+	}
+	if offset > receiver.nextOffset {
+		offset = receiver.nextOffset
+	}
+	if err := receiver.writeCheckpoint(offset); err != nil {
+		return err
+	}
+	receiver.committed = offset
+	return receiver.removeConsumedSegments()
+}
+
+func (receiver *WAL) writeCheckpoint(offset uint64) error {
+	path := filepath.Join(receiver.config.Dir, walCheckpointFile)
+	tmpPath := path + ".tmp"
+	file, err := os.OpenFile(tmpPath, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0644)
+	if err != nil {
+		return err
+	}
+	_, err = file.WriteString(strconv.FormatUint(offset, 10))
+	if err == nil && receiver.config.SyncPolicy != WALSyncNever {
+		err = file.Sync()
+	}
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return err
+	}
+	return os.Rename(tmpPath, path)
+}
+
+// removeConsumedSegments deletes the segment files whose records are all committed,
+// the active segment is never deleted
+func (receiver *WAL) removeConsumedSegments() error {
+	for len(receiver.segments) > 1 && receiver.segments[1].firstOffset <= receiver.committed {
+		segment := receiver.segments[0]
+		if segment.firstOffset == receiver.readFirst && receiver.readFile != nil {
+			receiver.closeReader()
+		}
+		if err := os.Remove(segment.path); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+		receiver.diskSize -= segment.size
+		receiver.segments = receiver.segments[1:]
+	}
+	return nil
+}
+
+// releaseActiveSegment starts a new segment if all records of the active
+// segment are committed, so that the active segment can be removed
+func (receiver *WAL) releaseActiveSegment() error {
+	if receiver.activeSize == 0 || receiver.committed < receiver.nextOffset {
+		return nil
+	}
+	if err := receiver.rotate(); err != nil {
+		return err
+	}
+	return receiver.removeConsumedSegments()
+}
+
+// segmentEnd returns the offset after the last record of the segment
+func (receiver *WAL) segmentEnd(firstOffset uint64) uint64 {
+	for i, segment := range receiver.segments[:len(receiver.segments)-1] {
+		if segment.firstOffset == firstOffset {
+			return receiver.segments[i+1].firstOffset
+		}
+	}
+	return receiver.nextOffset
+}
+
+// openReader opens the segment containing readOffset, and skips the records before it
+func (receiver *WAL) openReader() error {
+	receiver.closeReader()
+	var segment *walSegment
+	for _, s := range receiver.segments {
+		if s.firstOffset > receiver.readOffset {
+			break
+		}
+		segment = s
+	}
+	if segment == nil {
+		return fmt.Errorf("wal segment of offset %d not found", receiver.readOffset)
+	}
+	file, err := os.Open(segment.path)
+	if err != nil {
+		return err
+	}
+	receiver.readFile = file
+	receiver.reader = bufio.NewReader(file)
+	receiver.readFirst = segment.firstOffset
+	for offset := segment.firstOffset; offset < receiver.readOffset; offset++ {
+		if _, err = readWALRecord(receiver.reader); err != nil {
+			receiver.closeReader()
+			return err
+		}
+	}
+	return nil
+}
+
+func (receiver *WAL) closeReader() {
+	if receiver.readFile != nil {
+		_ = receiver.readFile.Close()
+	}
+	receiver.readFile = nil
+	receiver.reader = nil
+}
+
+func (receiver *WAL) syncLoop() {
+	ticker := time.NewTicker(receiver.config.SyncInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-receiver.stopSync:
+			return
+		case <-ticker.C:
+		}
+		receiver.lock.Lock()
+		if receiver.dirty && !receiver.closed {
+			if err := receiver.active.Sync(); err != nil {
+				logs.Error("[WAL] sync segment fail, err:%s", err.Error())
+			} else {
+				receiver.dirty = false
+			}
+		}
+		receiver.lock.Unlock()
+	}
+}
+
+func (receiver *WAL) Close() error {
+	receiver.lock.Lock()
+	defer receiver.lock.Unlock()
+	if receiver.closed {
+		return nil
+	}
+	receiver.closed = true
+	close(receiver.stopSync)
+	var err error
+	if receiver.config.SyncPolicy != WALSyncNever {
+		err = receiver.active.Sync()
+	}
+	receiver.closeFiles()
+	return err
+}
+
+func (receiver *WAL) closeFiles() {
+	receiver.closeReader()
+	if receiver.active != nil {
+		_ = receiver.active.Close()
+	}
+}
+
+func appendWALRecord(buf []byte, record []byte) []byte {
+	var header [walRecordHeaderSize]byte
+	binary.LittleEndian.PutUint32(header[:4], uint32(len(record)))
+	binary.LittleEndian.PutUint32(header[4:], crc32.Checksum(record, walCrc32Table))
+	buf = append(buf, header[:]...)
+	return append(buf, record...)
+}
+
+func readWALRecord(reader io.Reader) ([]byte, error) {
+	var header [walRecordHeaderSize]byte
+	if _, err := io.ReadFull(reader, header[:]); err != nil {
+		return nil, err
+	}
+	size := binary.LittleEndian.Uint32(header[:4])
+	if size > walMaxRecordSize {
+		return nil, fmt.Errorf("wal record size %d exceeds %d", size, walMaxRecordSize)
+	}
+	record := make([]byte, size)
+	if _, err := io.ReadFull(reader, record); err != nil {
+		if err == io.EOF {
+			err = io.ErrUnexpectedEOF
+		}
+		return nil, err
+	}
+	if crc32.Checksum(record, walCrc32Table) != binary.LittleEndian.Uint32(header[4:]) {
+		return nil, errors.New("wal record checksum mismatch")
+	}
+	return record, nil
+}
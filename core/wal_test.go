@@ -0,0 +1,285 @@
+package core
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/byteplus-sdk/sdk-go/common/protocol"
+	"google.golang.org/protobuf/proto"
+)
+
+func appendTestRecords(t *testing.T, wal *WAL, from int, to int) {
+	var records [][]byte
+	for i := from; i < to; i++ {
+		records = append(records, []byte(fmt.Sprintf("record-%d", i)))
+	}
+	if err := wal.Append(records); err != nil {
+		t.Fatalf("Append() error = %v", err)
+	}
+}
+
+func TestWAL(t *testing.T) {
+	dir := t.TempDir()
+	config := &WALConfig{Dir: dir, SegmentSize: 64, SyncPolicy: WALSyncNever}
+	wal, err := OpenWAL(config)
+	if err != nil {
+		t.Fatalf("OpenWAL() error = %v", err)
+	}
+	for i := 0; i < 10; i++ {
+		appendTestRecords(t, wal, i*2, i*2+2)
+	}
+	records, next, err := wal.Read(15)
+	if err != nil || len(records) != 15 || next != 15 || string(records[14]) != "record-14" {
+		t.Fatalf("Read() = %d records, next %d, err %v", len(records), next, err)
+	}
+	if err = wal.Commit(5); err != nil {
+		t.Fatalf("Commit() error = %v", err)
+	}
+	_ = wal.Close()
+
+	// append a broken record, which should be truncated after reopen
+	segments, _ := filepath.Glob(filepath.Join(dir, "*"+walSegmentSuffix))
+	if first := filepath.Base(segments[0]); first == fmt.Sprintf("%020d%s", 0, walSegmentSuffix) {
+		t.Errorf("segment %s should be removed after commit", first)
+	}
+	file, _ := os.OpenFile(segments[len(segments)-1], os.O_APPEND|os.O_WRONLY, 0644)
+	_, _ = file.Write([]byte{100, 0, 0, 0, 1})
+	_ = file.Close()
+
+	wal, err = OpenWAL(config)
+	if err != nil {
+		t.Fatalf("reopen error = %v", err)
+	}
+	defer wal.Close()
+	if pending := wal.Pending(); pending != 15 {
+		t.Errorf("Pending() = %d, want 15", pending)
+	}
+	appendTestRecords(t, wal, 20, 21)
+	records, next, err = wal.Read(100)
+	if err != nil || len(records) != 16 || next != 21 {
+		t.Fatalf("Read() after reopen = %d records, next %d, err %v", len(records), next, err)
+	}
+	if string(records[0]) != "record-5" || string(records[15]) != "record-20" {
+		t.Errorf("Read() after reopen = %s ... %s", records[0], records[15])
+	}
+}
+
+func TestWAL_maxDiskSize(t *testing.T) {
+	wal, err := OpenWAL(&WALConfig{Dir: t.TempDir(), MaxDiskSize: 40})
+	if err != nil {
+		t.Fatalf("OpenWAL() error = %v", err)
+	}
+	defer wal.Close()
+	appendTestRecords(t, wal, 0, 2)
+	if err = wal.Append([][]byte{[]byte("record-2")}); err != WALFullErr {
+		t.Errorf("Append() error = %v, want WALFullErr", err)
+	}
+	_, next, _ := wal.Read(10)
+	if err = wal.Commit(next); err != nil {
+		t.Fatalf("Commit() error = %v", err)
+	}
+	// the segment of committed records is removed to release the disk
+	if err = wal.Append([][]byte{[]byte("record-2")}); err != nil {
+		t.Errorf("Append() after commit error = %v", err)
+	}
+}
+
+// failingReader returns err after reading all of reader
+type failingReader struct {
+	reader io.Reader
+	err    error
+}
+
+func (f *failingReader) Read(p []byte) (int, error) {
+	n, err := f.reader.Read(p)
+	if err == io.EOF {
+		return n, f.err
+	}
+	return n, err
+}
+
+func TestWAL_readFailure(t *testing.T) {
+	wal, err := OpenWAL(&WALConfig{Dir: t.TempDir(), SyncPolicy: WALSyncNever})
+	if err != nil {
+		t.Fatalf("OpenWAL() error = %v", err)
+	}
+	defer wal.Close()
+	appendTestRecords(t, wal, 0, 3)
+	if records, _, err := wal.Read(1); err != nil || len(records) != 1 {
+		t.Fatalf("Read() = %d records, err %v", len(records), err)
+	}
+	// the reader fails in the middle of record-2
+	buf := appendWALRecord(nil, []byte("record-1"))
+	buf = appendWALRecord(buf, []byte("record-2"))[:len(buf)+walRecordHeaderSize+2]
+	readErr := errors.New("read failure")
+	wal.reader = bufio.NewReader(&failingReader{reader: bytes.NewReader(buf), err: readErr})
+	records, next, err := wal.Read(10)
+	if err != readErr || len(records) != 1 || string(records[0]) != "record-1" || next != 2 {
+		t.Fatalf("Read() with failure = %q, next %d, err %v", records, next, err)
+	}
+	records, next, err = wal.Read(10)
+	if err != nil || len(records) != 1 || string(records[0]) != "record-2" || next != 3 {
+		t.Errorf("Read() after failure = %q, next %d, err %v", records, next, err)
+	}
+}
+
+func TestEventQueue(t *testing.T) {
+	var lock sync.Mutex
+	var sent []string
+	failTimes := 1
+	write := func(items []interface{}, requestId string) (proto.Message, error) {
+		lock.Lock()
+		defer lock.Unlock()
+		if failTimes > 0 {
+			failTimes--
+			return nil, errors.New(netErrMark + " connection refused")
+		}
+		for _, item := range items {
+			sent = append(sent, string(item.([]byte)))
+		}
+		return &protocol.Status{Code: StatusCodeSuccess}, nil
+	}
+	decode := func(record []byte) (interface{}, error) {
+		return record, nil
+	}
+	noFailedItems := func(items []interface{}, response proto.Message) []*FailedItem {
+		return nil
+	}
+	config := &EventQueueConfig{
+		WAL:           &WALConfig{Dir: t.TempDir()},
+		BatchSize:     2,
+		FlushInterval: 10 * time.Millisecond,
+		RetryConfig:   &PartialRetryConfig{MaxRetries: -1},
+		RetryBackoff:  time.Millisecond,
+	}
+	queue, err := NewEventQueue(config, decode, write, noFailedItems)
+	if err != nil {
+		t.Fatalf("NewEventQueue() error = %v", err)
+	}
+	defer queue.Close()
+	if err = queue.Append([][]byte{[]byte("a"), []byte("b"), []byte("c")}); err != nil {
+		t.Fatalf("Append() error = %v", err)
+	}
+	deadline := time.Now().Add(5 * time.Second)
+	for queue.Pending() > 0 && time.Now().Before(deadline) {
+		time.Sleep(5 * time.Millisecond)
+	}
+	lock.Lock()
+	defer lock.Unlock()
+	if fmt.Sprint(sent) != "[a b c]" {
+		t.Errorf("sent events = %v, want [a b c]", sent)
+	}
+}
+
+func TestEventQueue_resendFailedOnly(t *testing.T) {
+	var lock sync.Mutex
+	var sent []string
+	var requests int
+	write := func(items []interface{}, requestId string) (proto.Message, error) {
+		lock.Lock()
+		defer lock.Unlock()
+		requests++
+		switch requests {
+		case 1:
+			// "b" failed with retryable error, "a" is accepted
+			sent = append(sent, string(items[0].([]byte)))
+			return &protocol.Status{Code: StatusCodeSuccess, Message: "b"}, nil
+		case 2:
+			// the retry of "b" failed entirely
+			return nil, errors.New(netErrMark + " connection refused")
+		}
+		for _, item := range items {
+			sent = append(sent, string(item.([]byte)))
+		}
+		return &protocol.Status{Code: StatusCodeSuccess}, nil
+	}
+	decode := func(record []byte) (interface{}, error) {
+		return record, nil
+	}
+	failedItems := func(items []interface{}, response proto.Message) []*FailedItem {
+		if response.(*protocol.Status).Message == "b" {
+			return []*FailedItem{{Item: []byte("b"), Message: "write timeout"}}
+		}
+		return nil
+	}
+	config := &EventQueueConfig{
+		WAL:           &WALConfig{Dir: t.TempDir()},
+		BatchSize:     2,
+		FlushInterval: 10 * time.Millisecond,
+		RetryConfig:   &PartialRetryConfig{MaxRetries: 1, InitialBackoff: time.Millisecond},
+		RetryBackoff:  time.Millisecond,
+	}
+	queue, err := NewEventQueue(config, decode, write, failedItems)
+	if err != nil {
+		t.Fatalf("NewEventQueue() error = %v", err)
+	}
+	defer queue.Close()
+	if err = queue.Append([][]byte{[]byte("a"), []byte("b"), []byte("c")}); err != nil {
+		t.Fatalf("Append() error = %v", err)
+	}
+	deadline := time.Now().Add(5 * time.Second)
+	for queue.Pending() > 0 && time.Now().Before(deadline) {
+		time.Sleep(5 * time.Millisecond)
+	}
+	lock.Lock()
+	defer lock.Unlock()
+	if fmt.Sprint(sent) != "[a b c]" {
+		t.Errorf("sent events = %v, want [a b c] without duplicates", sent)
+	}
+}
+
+func TestEventQueue_closeDuringBackoff(t *testing.T) {
+	write := func(items []interface{}, requestId string) (proto.Message, error) {
+		return nil, errors.New(netErrMark + " connection refused")
+	}
+	decode := func(record []byte) (interface{}, error) {
+		return record, nil
+	}
+	noFailedItems := func(items []interface{}, response proto.Message) []*FailedItem {
+		return nil
+	}
+	tests := []struct {
+		name   string
+		config *EventQueueConfig
+	}{
+		{name: "queue_backoff", config: &EventQueueConfig{
+			RetryConfig:  &PartialRetryConfig{MaxRetries: -1},
+			RetryBackoff: time.Hour,
+		}},
+		{name: "partial_retry_backoff", config: &EventQueueConfig{
+			RetryConfig: &PartialRetryConfig{MaxRetries: 1, InitialBackoff: time.Hour},
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.config.WAL = &WALConfig{Dir: t.TempDir()}
+			tt.config.BatchSize = 1
+			queue, err := NewEventQueue(tt.config, decode, write, noFailedItems)
+			if err != nil {
+				t.Fatalf("NewEventQueue() error = %v", err)
+			}
+			if err = queue.Append([][]byte{[]byte("a")}); err != nil {
+				t.Fatalf("Append() error = %v", err)
+			}
+			time.Sleep(20 * time.Millisecond)
+			start := time.Now()
+			if err = queue.Close(); err != nil {
+				t.Errorf("Close() error = %v", err)
+			}
+			if cost := time.Since(start); cost > time.Second {
+				t.Errorf("Close() cost %v, want interrupting the backoff", cost)
+			}
+			if queue.Pending() != 1 {
+				t.Errorf("Pending() = %d, want the event kept in wal", queue.Pending())
+			}
+		})
+	}
+}
@@ -0,0 +1,383 @@
+package general
+
+import (
+	"encoding/json"
+	"errors"
+	"strconv"
+	"sync"
+	"time"
+
+	. "github.com/byteplus-sdk/sdk-go/core"
+	"github.com/byteplus-sdk/sdk-go/core/logs"
+	"github.com/byteplus-sdk/sdk-go/core/option"
+	. "github.com/byteplus-sdk/sdk-go/general/protocol"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	defaultCallbackWindow   = time.Second
+	defaultCallbackMaxItems = 500
+)
+
+// BuildCallbackRequest builds the CallbackRequest of a byte scene from the predict
+// request, its response and the item ids finally displayed in order. The `pos` of
+// item is its 1-based position displayed, or "0" if it's not displayed, and the
+// `extra` is the reason in json, e.g. {"reason":"kept"}. The items in response but
+// not displayed are "filtered", or the reason in filteredReasons
+func BuildCallbackRequest(request *PredictRequest, response *PredictResponse, scene string,
+	displayedIds []string, filteredReasons map[string]string) (*CallbackRequest, error) {
+	if err := checkCallbackResponse(response); err != nil {
+		return nil, err
+	}
+	items, err := diffCallbackItems(response, displayedIds, filteredReasons)
+	if err != nil {
+		return nil, err
+	}
+	callback := newCallbackRequest(request, response, scene)
+	callback.Items = items
+	return callback, nil
+}
+
+// checkCallbackResponse checks the predict response can be called back
+func checkCallbackResponse(response *PredictResponse) error {
+	if response.GetRequestId() == "" {
+		return errors.New("predict request id is empty")
+	}
+	if _, fallback := response.GetValue().GetExtra()[PredictFallbackExtraKey]; fallback {
+		return errors.New("predict response is a fallback, which is not recommended by byteplus")
+	}
+	return nil
+}
+
+// newCallbackRequest returns the CallbackRequest of a byte scene without items
+func newCallbackRequest(request *PredictRequest, response *PredictResponse, scene string) *CallbackRequest {
+	return &CallbackRequest{
+		Uid:              request.GetUser().GetUid(),
+		Scene:            scene,
+		PredictRequestId: response.GetRequestId(),
+		Context: &CallbackContext{
+			Spm:     request.GetContext().GetSpm(),
+			ReqType: CallbackReqType_byte_scene,
+		},
+	}
+}
+
+func diffCallbackItems(response *PredictResponse, displayedIds []string,
+	filteredReasons map[string]string) ([]*CallbackItem, error) {
+	items := response.GetValue().GetItems()
+	predictedIds := make([]string, 0, len(items))
+	for _, item := range items {
+		predictedIds = append(predictedIds, item.GetId())
+	}
+	alteredItems := DiffImpressions(predictedIds, displayedIds, filteredReasons)
+	callbackItems := make([]*CallbackItem, 0, len(alteredItems))
+	for _, item := range alteredItems {
+		extra, err := json.Marshal(map[string]string{"reason": item.Reason})
+		if err != nil {
+			return nil, err
+		}
+		callbackItems = append(callbackItems, &CallbackItem{
+			Id:    item.Id,
+			Pos:   strconv.Itoa(int(item.Rank)),
+			Extra: string(extra),
+		})
+	}
+	return callbackItems, nil
+}
+
+type CallbackAggregatorConfig struct {
+	// The callbacks of the same predict request and scene are gathered within
+	// Window since the first one, then sent in one request, default is 1s. The
+	// predicted items not displayed by then are reported as filtered with pos 0,
+	// and the items displayed by AddDisplayed after it are sent in another request
+	// ranked from 1 again, along with the other items reported as filtered again.
+	// So Window should cover the time a user usually browses a predict result
+	Window time.Duration
+	// The gathered items are sent immediately when reaching MaxItems, default is 500.
+	// The items displayed by AddDisplayed after it are ranked from 1 again, so it
+	// should be larger than the items displayed for a predict response
+	MaxItems int
+	// Optional, whether the scene is served by BytePlus, the `req_type` of the
+	// callbacks of the scenes not served is `not_byte_scene`. By default all
+	// scenes are served by BytePlus
+	IsByteScene func(scene string) bool
+	// Optional, the retry of the failed callback requests
+	RetryConfig *PartialRetryConfig
+}
+
+func fillDefaultCallbackAggregatorConfig(config *CallbackAggregatorConfig) *CallbackAggregatorConfig {
+	result := &CallbackAggregatorConfig{}
+	if config != nil {
+		*result = *config
+	}
+	if result.Window <= 0 {
+		result.Window = defaultCallbackWindow
+	}
+	if result.MaxItems <= 0 {
+		result.MaxItems = defaultCallbackMaxItems
+	}
+	if result.IsByteScene == nil {
+		result.IsByteScene = func(scene string) bool {
+			return true
+		}
+	}
+	return result
+}
+
+type pendingCallback struct {
+	request *CallbackRequest
+	// item id -> index in request.Items
+	itemIds map[string]int
+	// the predict result and the items displayed added by AddDisplayed, which
+	// are diffed once when it's sent, so that the positions continue as the
+	// user scrolls
+	predictResponse *PredictResponse
+	displayedIds    []string
+	displayed       map[string]bool
+	filteredReasons map[string]string
+	timer           *time.Timer
+}
+
+func (receiver *pendingCallback) addItems(items []*CallbackItem) {
+	for _, item := range items {
+		if index, exist := receiver.itemIds[item.GetId()]; exist {
+			receiver.request.Items[index] = item
+			continue
+		}
+		receiver.itemIds[item.GetId()] = len(receiver.request.Items)
+		receiver.request.Items = append(receiver.request.Items, item)
+	}
+}
+
+func (receiver *pendingCallback) itemCount() int {
+	return len(receiver.request.Items) + len(receiver.displayedIds)
+}
+
+// build adds the items diffed from the displayed ones to the request
+func (receiver *pendingCallback) build() *CallbackRequest {
+	if receiver.predictResponse == nil {
+		return receiver.request
+	}
+	items, err := diffCallbackItems(receiver.predictResponse, receiver.displayedIds, receiver.filteredReasons)
+	if err != nil {
+		logs.Error("[CallbackAggregator] diff displayed items of scene:%s fail, err:%s",
+			receiver.request.GetScene(), err.Error())
+		return receiver.request
+	}
+	receiver.addItems(items)
+	return receiver.request
+}
+
+// CallbackAggregator gathers the callbacks of the same `predict_request_id` and
+// scene within a short window, e.g. the impressions reported one by one as the
+// user scrolls, and sends them by Client.Callback asynchronously with retries
+type CallbackAggregator struct {
+	client  Client
+	config  *CallbackAggregatorConfig
+	opts    []option.Option
+	lock    sync.Mutex
+	pending map[string]*pendingCallback
+	sending sync.WaitGroup
+	closed  bool
+}
+
+func NewCallbackAggregator(client Client, config *CallbackAggregatorConfig,
+	opts ...option.Option) *CallbackAggregator {
+	return &CallbackAggregator{
+		client:  client,
+		config:  fillDefaultCallbackAggregatorConfig(config),
+		opts:    opts,
+		pending: make(map[string]*pendingCallback),
+	}
+}
+
+// AddDisplayed gathers the item ids displayed with the ones added before for
+// the same predict response and scene, e.g. the items displayed one by one as the
+// user scrolls, which are regarded as displayed in the order added. The gathered
+// ids are built into the callback by BuildCallbackRequest once when it's sent, so
+// the items displayed later are not reported as filtered by the former callbacks.
+// The filteredReasons are merged. The response shouldn't be modified after added
+func (receiver *CallbackAggregator) AddDisplayed(request *PredictRequest, response *PredictResponse,
+	scene string, displayedIds []string, filteredReasons map[string]string) error {
+	if scene == "" {
+		return errors.New("callback scene is empty")
+	}
+	if err := checkCallbackResponse(response); err != nil {
+		return err
+	}
+	receiver.lock.Lock()
+	defer receiver.lock.Unlock()
+	if receiver.closed {
+		return errors.New("callback aggregator is closed")
+	}
+	key, pending := receiver.pendingOf(newCallbackRequest(request, response, scene))
+	if pending.predictResponse == nil {
+		pending.predictResponse = response
+		pending.displayed = make(map[string]bool)
+		pending.filteredReasons = make(map[string]string)
+	}
+	for _, id := range displayedIds {
+		if !pending.displayed[id] {
+			pending.displayed[id] = true
+			pending.displayedIds = append(pending.displayedIds, id)
+		}
+	}
+	for id, reason := range filteredReasons {
+		pending.filteredReasons[id] = reason
+	}
+	receiver.sendIfFull(key, pending)
+	return nil
+}
+
+// Add gathers the items of request with the pending ones of the same
+// `predict_request_id` and scene, an item replaces the pending one with the same
+// id, e.g. the item filtered before is displayed as the user scrolls. The
+// `req_type` is set by CallbackAggregatorConfig.IsByteScene unless it's
+// `behavior`. The request shouldn't be modified after added
+func (receiver *CallbackAggregator) Add(request *CallbackRequest) error {
+	if request.GetScene() == "" {
+		return errors.New("callback scene is empty")
+	}
+	receiver.lock.Lock()
+	defer receiver.lock.Unlock()
+	if receiver.closed {
+		return errors.New("callback aggregator is closed")
+	}
+	key, pending := receiver.pendingOf(request)
+	pending.addItems(request.GetItems())
+	receiver.sendIfFull(key, pending)
+	return nil
+}
+
+// pendingOf returns the pending callback of the `predict_request_id` and scene of
+// request, which is created from request if not exist. It's called with lock held
+func (receiver *CallbackAggregator) pendingOf(request *CallbackRequest) (string, *pendingCallback) {
+	key := request.GetPredictRequestId() + "\x00" + request.GetScene()
+	if pending, exist := receiver.pending[key]; exist {
+		return key, pending
+	}
+	pending := &pendingCallback{
+		request: &CallbackRequest{
+			Uid:              request.GetUid(),
+			Scene:            request.GetScene(),
+			PredictRequestId: request.GetPredictRequestId(),
+			Context:          &CallbackContext{},
+			Extra:            request.GetExtra(),
+		},
+		itemIds: make(map[string]int),
+	}
+	if request.GetContext() != nil {
+		pending.request.Context = proto.Clone(request.GetContext()).(*CallbackContext)
+	}
+	receiver.fillReqType(pending.request)
+	pending.timer = time.AfterFunc(receiver.config.Window, func() {
+		receiver.flushKey(key, pending)
+	})
+	receiver.pending[key] = pending
+	return key, pending
+}
+
+// sendIfFull sends the pending callback immediately when reaching MaxItems,
+// it's called with lock held
+func (receiver *CallbackAggregator) sendIfFull(key string, pending *pendingCallback) {
+	if pending.itemCount() < receiver.config.MaxItems {
+		return
+	}
+	pending.timer.Stop()
+	delete(receiver.pending, key)
+	receiver.send(pending.build())
+}
+
+// fillReqType sets `req_type` of the request by IsByteScene unless it's `behavior`
+func (receiver *CallbackAggregator) fillReqType(request *CallbackRequest) {
+	if request.GetContext().GetReqType() == CallbackReqType_behavior {
+		return
+	}
+	request.Context.ReqType = CallbackReqType_byte_scene
+	if !receiver.config.IsByteScene(request.GetScene()) {
+		request.Context.ReqType = CallbackReqType_not_byte_scene
+	}
+}
+
+// flushKey sends the pending callback of key when its window ends, unless it's
+// already sent for reaching MaxItems
+func (receiver *CallbackAggregator) flushKey(key string, pending *pendingCallback) {
+	receiver.lock.Lock()
+	defer receiver.lock.Unlock()
+	if receiver.pending[key] != pending {
+		return
+	}
+	delete(receiver.pending, key)
+	receiver.send(pending.build())
+}
+
+// send sends the request asynchronously, it's called with lock held
+func (receiver *CallbackAggregator) send(request *CallbackRequest) {
+	receiver.sending.Add(1)
+	AsyncExecute(func() {
+		defer receiver.sending.Done()
+		items := make([]interface{}, 0, len(request.Items))
+		for _, item := range request.Items {
+			items = append(items, item)
+		}
+		report, err := RetryPartialFailures(receiver.config.RetryConfig, items,
+			receiver.callbackWriter(request), callbackFailedItems)
+		if err != nil {
+			logs.Error("[CallbackAggregator] callback %d items of scene:%s fail, err:%s",
+				len(items), request.GetScene(), err.Error())
+			return
+		}
+		if len(report.Failed) > 0 {
+			logs.Error("[CallbackAggregator] callback %d items of scene:%s fail",
+				len(report.Failed), request.GetScene())
+		}
+	})
+}
+
+func (receiver *CallbackAggregator) callbackWriter(request *CallbackRequest) PartialWriteFunc {
+	return func(items []interface{}, requestId string) (proto.Message, error) {
+		callbackItems := make([]*CallbackItem, 0, len(items))
+		for _, item := range items {
+			callbackItems = append(callbackItems, item.(*CallbackItem))
+		}
+		batch := &CallbackRequest{
+			Uid:              request.GetUid(),
+			Scene:            request.GetScene(),
+			Items:            callbackItems,
+			PredictRequestId: request.GetPredictRequestId(),
+			Context:          request.GetContext(),
+			Extra:            request.GetExtra(),
+		}
+		response, err := receiver.client.Callback(batch, RetryOptions(receiver.opts, requestId)...)
+		if err != nil {
+			return nil, err
+		}
+		return response, nil
+	}
+}
+
+// callbackFailedItems returns nil as CallbackResponse has no per-item errors,
+// the failure of whole request is judged by its code
+func callbackFailedItems(items []interface{}, response proto.Message) []*FailedItem {
+	return nil
+}
+
+// Flush sends all the pending callbacks, and waits until they are sent
+func (receiver *CallbackAggregator) Flush() {
+	receiver.lock.Lock()
+	for key, pending := range receiver.pending {
+		pending.timer.Stop()
+		delete(receiver.pending, key)
+		receiver.send(pending.build())
+	}
+	receiver.lock.Unlock()
+	receiver.sending.Wait()
+}
+
+// Close flushes the pending callbacks, the callbacks added after it are rejected
+func (receiver *CallbackAggregator) Close() {
+	receiver.lock.Lock()
+	receiver.closed = true
+	receiver.lock.Unlock()
+	receiver.Flush()
+}
@@ -0,0 +1,218 @@
+package general
+
+import (
+	"reflect"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/byteplus-sdk/sdk-go/core/option"
+	. "github.com/byteplus-sdk/sdk-go/general/protocol"
+)
+
+type mockCallbackClient struct {
+	Client
+	lock     sync.Mutex
+	requests []*CallbackRequest
+	sent     chan struct{}
+}
+
+func newMockCallbackClient() *mockCallbackClient {
+	return &mockCallbackClient{sent: make(chan struct{}, 10)}
+}
+
+func (m *mockCallbackClient) Callback(request *CallbackRequest,
+	opts ...option.Option) (*CallbackResponse, error) {
+	m.lock.Lock()
+	m.requests = append(m.requests, request)
+	m.lock.Unlock()
+	m.sent <- struct{}{}
+	return &CallbackResponse{}, nil
+}
+
+func (m *mockCallbackClient) sentRequests() []*CallbackRequest {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	return append([]*CallbackRequest(nil), m.requests...)
+}
+
+// callbackPositions returns the `pos` of the items by id
+func callbackPositions(request *CallbackRequest) map[string]string {
+	positions := make(map[string]string, len(request.GetItems()))
+	for _, item := range request.GetItems() {
+		positions[item.GetId()] = item.GetPos()
+	}
+	return positions
+}
+
+func predictedResponse(requestId string, ids ...string) *PredictResponse {
+	response := &PredictResponse{RequestId: requestId, Value: &PredictResult{}}
+	for _, id := range ids {
+		response.Value.Items = append(response.Value.Items, &PredictItem{Id: id})
+	}
+	return response
+}
+
+func TestCallbackAggregator_AddDisplayed(t *testing.T) {
+	client := newMockCallbackClient()
+	aggregator := NewCallbackAggregator(client, &CallbackAggregatorConfig{Window: time.Hour})
+	request := &PredictRequest{User: &PredictUser{Uid: "u1"}}
+	response := predictedResponse("r1", "1", "2", "3", "4")
+	// the items are displayed as the user scrolls
+	for _, displayedIds := range [][]string{{"1", "2"}, {"10", "3"}, {"2"}} {
+		if err := aggregator.AddDisplayed(request, response, "home", displayedIds, nil); err != nil {
+			t.Fatalf("AddDisplayed() error = %v", err)
+		}
+	}
+	aggregator.Flush()
+	requests := client.sentRequests()
+	if len(requests) != 1 {
+		t.Fatalf("sent %d requests, want 1", len(requests))
+	}
+	want := map[string]string{"1": "1", "2": "2", "10": "3", "3": "4", "4": "0"}
+	if got := callbackPositions(requests[0]); !reflect.DeepEqual(got, want) {
+		t.Errorf("positions = %v, want %v", got, want)
+	}
+	if requests[0].GetUid() != "u1" || requests[0].GetPredictRequestId() != "r1" ||
+		requests[0].GetContext().GetReqType() != CallbackReqType_byte_scene {
+		t.Errorf("request = %v", requests[0])
+	}
+	fallback := predictedResponse("", "1")
+	if err := aggregator.AddDisplayed(request, fallback, "home", []string{"1"}, nil); err == nil {
+		t.Errorf("AddDisplayed() of response without request id should fail")
+	}
+}
+
+func TestCallbackAggregator_Add(t *testing.T) {
+	client := newMockCallbackClient()
+	aggregator := NewCallbackAggregator(client, &CallbackAggregatorConfig{
+		Window:      time.Hour,
+		IsByteScene: func(scene string) bool { return scene != "other" },
+	})
+	first := &CallbackRequest{PredictRequestId: "r1", Scene: "other", Items: []*CallbackItem{
+		{Id: "1", Pos: "1"}, {Id: "2", Pos: "0"},
+	}}
+	second := &CallbackRequest{PredictRequestId: "r1", Scene: "other", Items: []*CallbackItem{
+		{Id: "2", Pos: "2"}, {Id: "3", Pos: "3"},
+	}}
+	for _, request := range []*CallbackRequest{first, second} {
+		if err := aggregator.Add(request); err != nil {
+			t.Fatalf("Add() error = %v", err)
+		}
+	}
+	aggregator.Flush()
+	requests := client.sentRequests()
+	if len(requests) != 1 {
+		t.Fatalf("sent %d requests, want 1", len(requests))
+	}
+	want := map[string]string{"1": "1", "2": "2", "3": "3"}
+	if got := callbackPositions(requests[0]); !reflect.DeepEqual(got, want) {
+		t.Errorf("positions = %v, want %v", got, want)
+	}
+	if requests[0].GetContext().GetReqType() != CallbackReqType_not_byte_scene {
+		t.Errorf("req_type = %v, want not_byte_scene", requests[0].GetContext().GetReqType())
+	}
+}
+
+func TestCallbackAggregator_window(t *testing.T) {
+	client := newMockCallbackClient()
+	aggregator := NewCallbackAggregator(client, &CallbackAggregatorConfig{Window: 10 * time.Millisecond})
+	request := &PredictRequest{User: &PredictUser{Uid: "u1"}}
+	if err := aggregator.AddDisplayed(request, predictedResponse("r1", "1"), "home", []string{"1"}, nil); err != nil {
+		t.Fatalf("AddDisplayed() error = %v", err)
+	}
+	if err := aggregator.AddDisplayed(request, predictedResponse("r2", "2"), "home", []string{"2"}, nil); err != nil {
+		t.Fatalf("AddDisplayed() error = %v", err)
+	}
+	for i := 0; i < 2; i++ {
+		select {
+		case <-client.sent:
+		case <-time.After(time.Second):
+			t.Fatalf("callbacks are not sent after window")
+		}
+	}
+	var requestIds []string
+	for _, request := range client.sentRequests() {
+		requestIds = append(requestIds, request.GetPredictRequestId())
+	}
+	sort.Strings(requestIds)
+	if !reflect.DeepEqual(requestIds, []string{"r1", "r2"}) {
+		t.Errorf("sent requests of %v, want r1 and r2", requestIds)
+	}
+}
+
+func TestCallbackAggregator_windowRestartsPositions(t *testing.T) {
+	client := newMockCallbackClient()
+	aggregator := NewCallbackAggregator(client, &CallbackAggregatorConfig{Window: 10 * time.Millisecond})
+	request := &PredictRequest{User: &PredictUser{Uid: "u1"}}
+	response := predictedResponse("r1", "1", "2", "3")
+	if err := aggregator.AddDisplayed(request, response, "home", []string{"1"}, nil); err != nil {
+		t.Fatalf("AddDisplayed() error = %v", err)
+	}
+	select {
+	case <-client.sent:
+	case <-time.After(time.Second):
+		t.Fatalf("callback is not sent after window")
+	}
+	// the item displayed after the window is ranked from 1 in another request
+	if err := aggregator.AddDisplayed(request, response, "home", []string{"2"}, nil); err != nil {
+		t.Fatalf("AddDisplayed() error = %v", err)
+	}
+	aggregator.Flush()
+	requests := client.sentRequests()
+	if len(requests) != 2 {
+		t.Fatalf("sent %d requests, want 2", len(requests))
+	}
+	wants := []map[string]string{{"1": "1", "2": "0", "3": "0"}, {"1": "0", "2": "1", "3": "0"}}
+	for i, want := range wants {
+		if got := callbackPositions(requests[i]); !reflect.DeepEqual(got, want) {
+			t.Errorf("positions of request %d = %v, want %v", i, got, want)
+		}
+	}
+}
+
+func TestCallbackAggregator_maxItems(t *testing.T) {
+	client := newMockCallbackClient()
+	aggregator := NewCallbackAggregator(client, &CallbackAggregatorConfig{Window: time.Hour, MaxItems: 3})
+	request := &PredictRequest{User: &PredictUser{Uid: "u1"}}
+	response := predictedResponse("r1", "1", "2", "3", "4")
+	if err := aggregator.AddDisplayed(request, response, "home", []string{"1", "2"}, nil); err != nil {
+		t.Fatalf("AddDisplayed() error = %v", err)
+	}
+	if len(client.sentRequests()) != 0 {
+		t.Fatalf("callback is sent before reaching MaxItems")
+	}
+	if err := aggregator.AddDisplayed(request, response, "home", []string{"3"}, nil); err != nil {
+		t.Fatalf("AddDisplayed() error = %v", err)
+	}
+	select {
+	case <-client.sent:
+	case <-time.After(time.Second):
+		t.Fatalf("callback is not sent when reaching MaxItems")
+	}
+	want := map[string]string{"1": "1", "2": "2", "3": "3", "4": "0"}
+	if got := callbackPositions(client.sentRequests()[0]); !reflect.DeepEqual(got, want) {
+		t.Errorf("positions = %v, want %v", got, want)
+	}
+}
+
+func TestCallbackAggregator_Close(t *testing.T) {
+	client := newMockCallbackClient()
+	aggregator := NewCallbackAggregator(client, &CallbackAggregatorConfig{Window: time.Hour})
+	request := &PredictRequest{User: &PredictUser{Uid: "u1"}}
+	response := predictedResponse("r1", "1")
+	if err := aggregator.AddDisplayed(request, response, "home", []string{"1"}, nil); err != nil {
+		t.Fatalf("AddDisplayed() error = %v", err)
+	}
+	aggregator.Close()
+	if len(client.sentRequests()) != 1 {
+		t.Errorf("Close() should send the pending callbacks")
+	}
+	if err := aggregator.AddDisplayed(request, response, "home", []string{"1"}, nil); err == nil {
+		t.Errorf("AddDisplayed() after Close() should fail")
+	}
+	if err := aggregator.Add(&CallbackRequest{PredictRequestId: "r1", Scene: "home"}); err == nil {
+		t.Errorf("Add() after Close() should fail")
+	}
+}
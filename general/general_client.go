@@ -3,6 +3,7 @@ package general
 import (
 	"github.com/byteplus-sdk/sdk-go/common"
 
+	"github.com/byteplus-sdk/sdk-go/core"
 	"github.com/byteplus-sdk/sdk-go/core/option"
 	. "github.com/byteplus-sdk/sdk-go/general/protocol"
 )
@@ -14,11 +15,22 @@ type Client interface {
 	// release resources
 	Release()
 
+	// HealthSnapshot
+	//
+	// Returns the health state of every host observed by the sdk, including
+	// the failure rate in window, recent ping latencies, the last ping error
+	// and the available hosts, which can be used to show the routing state.
+	HealthSnapshot() *core.HealthSnapshot
+
 	// WriteData
 	//
 	// Writes at most 100 data at a time. Exceeding 100 in a request results in
 	// a rejection. One can use this to upload new data, or update existing
 	// data (by providing all the fields, some data type not support update, e.g. user event).
+	// If the client is built with a schema registry, the data of the topic are
+	// coerced by its schema, and *core.ValidationError listing the invalid data
+	// and the reasons is returned without sending if any is invalid, i.e. the
+	// whole dataList is rejected rather than only the invalid data.
 	WriteData(dataList []map[string]interface{}, topic string,
 		opts ...option.Option) (*WriteResponse, error)
 
@@ -4,10 +4,12 @@ import (
 	"github.com/byteplus-sdk/sdk-go/common"
 	"github.com/byteplus-sdk/sdk-go/core"
 	"github.com/byteplus-sdk/sdk-go/core/metrics"
+	"github.com/byteplus-sdk/sdk-go/general/schema"
 )
 
 type ClientBuilder struct {
-	param core.ContextParam
+	param   core.ContextParam
+	schemas *schema.Registry
 }
 
 func (receiver *ClientBuilder) Tenant(tenant string) *ClientBuilder {
@@ -60,6 +62,50 @@ func (receiver *ClientBuilder) HostAvailablerConfig(hostAvailablerConfig *core.H
 	return receiver
 }
 
+func (receiver *ClientBuilder) HedgeConfig(hedgeConfig *core.HedgeConfig) *ClientBuilder {
+	receiver.param.HedgeConfig = hedgeConfig
+	return receiver
+}
+
+func (receiver *ClientBuilder) CircuitBreakerConfig(circuitBreakerConfig *core.CircuitBreakerConfig) *ClientBuilder {
+	receiver.param.CircuitBreakerConfig = circuitBreakerConfig
+	return receiver
+}
+
+func (receiver *ClientBuilder) RateLimitConfig(rateLimitConfig *core.RateLimitConfig) *ClientBuilder {
+	receiver.param.RateLimitConfig = rateLimitConfig
+	return receiver
+}
+
+func (receiver *ClientBuilder) StrictStatusConfig(strictStatusConfig *core.StrictStatusConfig) *ClientBuilder {
+	receiver.param.StrictStatusConfig = strictStatusConfig
+	return receiver
+}
+
+func (receiver *ClientBuilder) PredictCacheConfig(predictCacheConfig *core.PredictCacheConfig) *ClientBuilder {
+	receiver.param.PredictCacheConfig = predictCacheConfig
+	return receiver
+}
+
+func (receiver *ClientBuilder) PredictFallbackConfig(predictFallbackConfig *core.PredictFallbackConfig) *ClientBuilder {
+	receiver.param.PredictFallbackConfig = predictFallbackConfig
+	return receiver
+}
+
+// SchemaRegistry sets the schemas of topics, by which WriteData coerces the data
+// before sending, and rejects the whole request if any data is invalid
+func (receiver *ClientBuilder) SchemaRegistry(schemas *schema.Registry) *ClientBuilder {
+	receiver.schemas = schemas
+	return receiver
+}
+
+// DeadLetterSink sets the sink of the data failed permanently in WriteData or
+// WriteDataWithRetry, so that they can be replayed by ReplayDeadLetters
+func (receiver *ClientBuilder) DeadLetterSink(deadLetterSink core.DeadLetterSink) *ClientBuilder {
+	receiver.param.DeadLetterSink = deadLetterSink
+	return receiver
+}
+
 func (receiver *ClientBuilder) Build() (Client, error) {
 	receiver.param.UseAirAuth = true
 	context, err := core.NewContext(&receiver.param)
@@ -71,10 +117,14 @@ func (receiver *ClientBuilder) Build() (Client, error) {
 	hostAvailabler := core.NewHostAvailabler(gu, context)
 	metrics.Collector.Init(context.MetricsConfig(), hostAvailabler)
 	client := &clientImpl{
-		Client:  common.NewClient(httpCaller, gu.cu),
-		hCaller: httpCaller,
-		gu:      gu,
-		hostAva: hostAvailabler,
+		Client:    common.NewClient(httpCaller, gu.cu),
+		hCaller:   httpCaller,
+		gu:        gu,
+		hostAva:   hostAvailabler,
+		pCache:    core.NewPredictCache(context.PredictCacheConfig()),
+		pFallback: core.NewPredictFallback(context.PredictFallbackConfig()),
+		schemas:   receiver.schemas,
+		dlSink:    context.DeadLetterSink(),
 	}
 	return client, nil
 }
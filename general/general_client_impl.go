@@ -4,12 +4,15 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"sync"
 
 	"github.com/byteplus-sdk/sdk-go/common"
 	. "github.com/byteplus-sdk/sdk-go/core"
 	"github.com/byteplus-sdk/sdk-go/core/logs"
 	"github.com/byteplus-sdk/sdk-go/core/option"
 	. "github.com/byteplus-sdk/sdk-go/general/protocol"
+	"github.com/byteplus-sdk/sdk-go/general/schema"
+	"google.golang.org/protobuf/proto"
 )
 
 var (
@@ -19,24 +22,47 @@ var (
 
 type clientImpl struct {
 	common.Client
-	hCaller *HTTPCaller
-	gu      *generalURL
-	hostAva *HostAvailabler
+	hCaller   *HTTPCaller
+	gu        *generalURL
+	hostAva   *HostAvailabler
+	pCache    *PredictCache
+	pFallback *PredictFallback
+	schemas   *schema.Registry
+	dlSink    DeadLetterSink
+	// "topic|field" of the fields not in schema which have been warned
+	unknownFields sync.Map
 }
 
 func (c *clientImpl) Release() {
 	c.hostAva.Shutdown()
 }
 
+func (c *clientImpl) HealthSnapshot() *HealthSnapshot {
+	return c.hostAva.HealthSnapshot()
+}
+
 func (c *clientImpl) WriteData(dataList []map[string]interface{}, topic string,
 	opts ...option.Option) (*WriteResponse, error) {
 	if len(dataList) > MaxImportItemCount {
 		return nil, TooManyItemsErr
 	}
+	if c.schemas != nil {
+		coerced, issues := c.schemas.Coerce(topic, dataList)
+		if err := c.checkCoercionIssues(topic, issues); err != nil {
+			return nil, err
+		}
+		dataList = coerced
+	}
 	urlFormat := c.gu.writeDataURLFormat
 	url := strings.ReplaceAll(urlFormat, "{}", topic)
 	response := &WriteResponse{}
-	err := c.hCaller.DoJSONRequest(url, dataList, response, option.Conv2Options(opts...))
+	options := option.Conv2Options(opts...)
+	dlSink := withTopic(c.dlSink, topic)
+	PrepareDeadLetters(dlSink, options)
+	err := c.hCaller.DoJSONRequest(url, dataList, response, options)
+	WriteDeadLetters(dlSink, options, func() []interface{} {
+		return dataItems(dataList)
+	}, response, err, dataFailedItems)
 	if err != nil {
 		return nil, err
 	}
@@ -44,12 +70,41 @@ func (c *clientImpl) WriteData(dataList []map[string]interface{}, topic string,
 	return response, nil
 }
 
+// checkCoercionIssues returns ValidationError if any data can't be coerced, so the
+// whole dataList is rejected. The warnings of the fields not in schema are logged
+// once per topic and field rather than per data
+func (c *clientImpl) checkCoercionIssues(topic string, issues []*ValidationIssue) error {
+	var errorIssues []*ValidationIssue
+	var unknown []string
+	for _, issue := range issues {
+		if !issue.Warning {
+			errorIssues = append(errorIssues, issue)
+			continue
+		}
+		if _, warned := c.unknownFields.LoadOrStore(topic+"|"+issue.Field, true); !warned {
+			unknown = append(unknown, issue.Field)
+		}
+	}
+	if len(unknown) > 0 {
+		logs.Warn("[WriteData] fields %v are not in schema of topic %s, they are sent as is", unknown, topic)
+	}
+	if len(errorIssues) == 0 {
+		return nil
+	}
+	return &ValidationError{Issues: errorIssues}
+}
+
 func (c *clientImpl) Predict(request *PredictRequest,
 	scene string, opts ...option.Option) (*PredictResponse, error) {
 	urlFormat := c.gu.predictUrlFormat
 	url := strings.ReplaceAll(urlFormat, "{}", scene)
 	response := &PredictResponse{}
-	err := c.hCaller.DoPBRequest(url, request, response, option.Conv2Options(opts...))
+	options := option.Conv2Options(opts...)
+	err := c.pCache.Load(request, scene, options, response,
+		func(options *option.Options, response proto.Message) error {
+			return c.hCaller.DoHedgedPBRequest(url, request, response, options)
+		})
+	err = c.pFallback.Apply(request, scene, response, err)
 	if err != nil {
 		return nil, err
 	}
@@ -57,6 +112,20 @@ func (c *clientImpl) Predict(request *PredictRequest,
 	return response, nil
 }
 
+// predictShadow sends the secondary request of shadowPredictClient, which neither
+// reads nor changes the state of the primary requests, i.e. the predict cache
+// and fallback
+func (c *clientImpl) predictShadow(request *PredictRequest, scene string,
+	opts ...option.Option) (*PredictResponse, error) {
+	url := strings.ReplaceAll(c.gu.predictUrlFormat, "{}", scene)
+	response := &PredictResponse{}
+	err := c.hCaller.DoHedgedPBRequest(url, request, response, option.Conv2Options(opts...))
+	if err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
 func (c *clientImpl) Callback(request *CallbackRequest,
 	opts ...option.Option) (*CallbackResponse, error) {
 	url := c.gu.callbackURL
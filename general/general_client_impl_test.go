@@ -0,0 +1,59 @@
+package general
+
+import (
+	"testing"
+
+	. "github.com/byteplus-sdk/sdk-go/core"
+	"github.com/byteplus-sdk/sdk-go/general/schema"
+)
+
+func TestClientImpl_WriteData_coercion(t *testing.T) {
+	registry := schema.NewRegistry()
+	err := registry.Register(&schema.Schema{Topic: "item", Fields: []*schema.Field{
+		{Name: "id", Type: schema.TypeString, Required: true},
+		{Name: "price", Type: schema.TypeFloat},
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// the request is rejected before sending, so the client has no caller
+	client := &clientImpl{schemas: registry}
+	dataList := []map[string]interface{}{
+		{"id": "1", "price": "9.5", "color": "red"},
+		{"price": "cheap"},
+	}
+	_, err = client.WriteData(dataList, "item")
+	validationErr, ok := err.(*ValidationError)
+	if !ok {
+		t.Fatalf("WriteData() error = %v, want *ValidationError", err)
+	}
+	// the whole dataList is rejected by the issues of the invalid data only
+	for _, issue := range validationErr.Issues {
+		if issue.Index != 1 || issue.Warning {
+			t.Errorf("unexpected issue %v", issue)
+		}
+	}
+}
+
+func TestClientImpl_checkCoercionIssues(t *testing.T) {
+	client := &clientImpl{}
+	unknown := func(index int, field string) *ValidationIssue {
+		return &ValidationIssue{Index: index, Field: field, Message: "is not in schema", Warning: true}
+	}
+	issues := []*ValidationIssue{unknown(0, "color"), unknown(1, "color"), unknown(1, "size")}
+	if err := client.checkCoercionIssues("item", issues); err != nil {
+		t.Fatalf("checkCoercionIssues() of warnings error = %v", err)
+	}
+	warned := 0
+	client.unknownFields.Range(func(key, value interface{}) bool {
+		warned++
+		return true
+	})
+	if warned != 2 {
+		t.Errorf("warned %d unknown fields, want 2 as they're warned once per topic and field", warned)
+	}
+	invalid := &ValidationIssue{Index: 2, Field: "price", Message: "is not a float"}
+	if err := client.checkCoercionIssues("item", append(issues, invalid)); err == nil {
+		t.Errorf("checkCoercionIssues() should fail with invalid data")
+	}
+}
@@ -0,0 +1,65 @@
+package general
+
+import (
+	"bytes"
+	"encoding/json"
+
+	. "github.com/byteplus-sdk/sdk-go/core"
+	"github.com/byteplus-sdk/sdk-go/core/option"
+)
+
+// DataQueue persists the data of a topic in a local write-ahead log before
+// Append returns, and writes them asynchronously by WriteData
+type DataQueue struct {
+	queue *EventQueue
+}
+
+// NewDataQueue
+//
+// Creates the queue with the WAL of config.WAL.Dir, the data not accepted
+// by server before restart are resent. Each topic should use its own dir
+func NewDataQueue(client Client, topic string, config *EventQueueConfig,
+	opts ...option.Option) (*DataQueue, error) {
+	decode := func(record []byte) (interface{}, error) {
+		decoder := json.NewDecoder(bytes.NewReader(record))
+		// keep the precision of big integers, e.g. id
+		decoder.UseNumber()
+		data := make(map[string]interface{})
+		if err := decoder.Decode(&data); err != nil {
+			return nil, err
+		}
+		return data, nil
+	}
+	if config != nil && config.RetryConfig != nil {
+		topicConfig := *config
+		topicConfig.RetryConfig = withTopicDeadLetterSink(config.RetryConfig, topic)
+		config = &topicConfig
+	}
+	queue, err := NewEventQueue(config, decode, dataWriter(client, topic, opts), dataFailedItems)
+	if err != nil {
+		return nil, err
+	}
+	return &DataQueue{queue: queue}, nil
+}
+
+// Append persists the data, they are acknowledged when it returns without error
+func (receiver *DataQueue) Append(dataList []map[string]interface{}) error {
+	records := make([][]byte, 0, len(dataList))
+	for _, data := range dataList {
+		record, err := json.Marshal(data)
+		if err != nil {
+			return err
+		}
+		records = append(records, record)
+	}
+	return receiver.queue.Append(records)
+}
+
+// Pending returns the count of the data not accepted by server yet
+func (receiver *DataQueue) Pending() uint64 {
+	return receiver.queue.Pending()
+}
+
+func (receiver *DataQueue) Close() error {
+	return receiver.queue.Close()
+}
@@ -0,0 +1,46 @@
+package general
+
+import (
+	"github.com/byteplus-sdk/sdk-go/common"
+	. "github.com/byteplus-sdk/sdk-go/common/protocol"
+	. "github.com/byteplus-sdk/sdk-go/core"
+	"github.com/byteplus-sdk/sdk-go/core/option"
+)
+
+// WriteDataFromReader
+//
+// Reads data from reader, e.g. the RecordReader of csv or jsonl file opened by
+// OpenRecordReader, the fields of record are renamed by mapping, then writes
+// them by WriteData in batches with the date of config. As WriteData has no
+// operation, each request is regarded as an operation done immediately in summary
+func WriteDataFromReader(client Client, reader RecordReader, topic string, mapping FieldMapping,
+	config *common.ImportConfig, opts ...option.Option) (*common.ImportSummary, error) {
+	convert := func(record Record) (interface{}, error) {
+		return MapRecord(record, mapping), nil
+	}
+	importBatch := func(items []interface{}, dateEnd bool) (*OperationResponse, error) {
+		dataList := make([]map[string]interface{}, 0, len(items))
+		for _, item := range items {
+			dataList = append(dataList, item.(map[string]interface{}))
+		}
+		dateOpts := append(opts[:len(opts):len(opts)], option.WithDataDate(config.Date), option.WithDateEnd(dateEnd))
+		response, err := client.WriteData(dataList, topic, dateOpts...)
+		if err != nil {
+			return nil, err
+		}
+		failureCount := int64(len(response.GetErrors()))
+		return &OperationResponse{
+			Status: response.GetStatus(),
+			Operation: &Operation{
+				Done: true,
+				Metadata: &Metadata{
+					Date:         config.Date.Format("2006-01-02"),
+					TotalCount:   int64(len(dataList)),
+					SuccessCount: int64(len(dataList)) - failureCount,
+					FailureCount: failureCount,
+				},
+			},
+		}, nil
+	}
+	return common.ImportRecords(client, reader, convert, importBatch, config)
+}
@@ -0,0 +1,472 @@
+package general
+
+import (
+	"errors"
+	"fmt"
+	"math"
+
+	. "github.com/byteplus-sdk/sdk-go/general/protocol"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// PredictRequestBuilder builds PredictRequest fluently, e.g.
+//
+//	request, err := NewPredictRequest().
+//		User("uid").
+//		Device(&PredictDevice{Platform: "app"}).
+//		Feature("price", 9.9).
+//		Filter("tags", []string{"new"}).
+//		Candidates("item1", "item2").
+//		Build()
+//
+// The first misuse, e.g. a value of unsupported type, is kept and returned by
+// Build, the calls after it are ignored
+type PredictRequestBuilder struct {
+	request *PredictRequest
+	err     error
+}
+
+func NewPredictRequest() *PredictRequestBuilder {
+	return &PredictRequestBuilder{request: &PredictRequest{}}
+}
+
+func (receiver *PredictRequestBuilder) fail(format string, args ...interface{}) *PredictRequestBuilder {
+	if receiver.err == nil {
+		receiver.err = fmt.Errorf(format, args...)
+	}
+	return receiver
+}
+
+func (receiver *PredictRequestBuilder) user() *PredictUser {
+	if receiver.request.User == nil {
+		receiver.request.User = &PredictUser{}
+	}
+	return receiver.request.User
+}
+
+func (receiver *PredictRequestBuilder) context() *PredictContext {
+	if receiver.request.Context == nil {
+		receiver.request.Context = &PredictContext{}
+	}
+	return receiver.request.Context
+}
+
+func (receiver *PredictRequestBuilder) searchInfo() *SearchInfo {
+	if receiver.request.SearchInfo == nil {
+		receiver.request.SearchInfo = &SearchInfo{}
+	}
+	return receiver.request.SearchInfo
+}
+
+// User sets the uid of user, which is required
+func (receiver *PredictRequestBuilder) User(uid string) *PredictRequestBuilder {
+	if receiver.err != nil {
+		return receiver
+	}
+	if uid == "" {
+		return receiver.fail("User: uid is empty")
+	}
+	receiver.user().Uid = uid
+	return receiver
+}
+
+// UserProfile sets the other fields of user, the uid is kept if it's empty in user
+func (receiver *PredictRequestBuilder) UserProfile(user *PredictUser) *PredictRequestBuilder {
+	if receiver.err != nil {
+		return receiver
+	}
+	if user == nil {
+		return receiver.fail("UserProfile: user is nil")
+	}
+	uid := receiver.user().Uid
+	receiver.request.User = user
+	if user.Uid == "" {
+		user.Uid = uid
+	}
+	return receiver
+}
+
+func (receiver *PredictRequestBuilder) Device(device *PredictDevice) *PredictRequestBuilder {
+	if receiver.err != nil {
+		return receiver
+	}
+	if device == nil {
+		return receiver.fail("Device: device is nil")
+	}
+	receiver.user().Device = device
+	return receiver
+}
+
+// Size sets the count of items to predict
+func (receiver *PredictRequestBuilder) Size(size int) *PredictRequestBuilder {
+	if receiver.err != nil {
+		return receiver
+	}
+	if size <= 0 || size > math.MaxInt32 {
+		return receiver.fail("Size: %d is out of range", size)
+	}
+	receiver.request.Size = int32(size)
+	return receiver
+}
+
+func (receiver *PredictRequestBuilder) Spm(spm string) *PredictRequestBuilder {
+	if receiver.err != nil {
+		return receiver
+	}
+	receiver.context().Spm = spm
+	return receiver
+}
+
+func (receiver *PredictRequestBuilder) ClosePersonalizedRecommend(closed bool) *PredictRequestBuilder {
+	if receiver.err != nil {
+		return receiver
+	}
+	receiver.context().ClosePersonalizedRecommend = closed
+	return receiver
+}
+
+// Feature sets the context feature, the value is put into the typed map of
+// PredictFeature by its go type: string, integers, float32, float64, []string,
+// []int, []int32, []int64, []float32 and []float64. A name can't be set with
+// values of different types
+func (receiver *PredictRequestBuilder) Feature(name string, value interface{}) *PredictRequestBuilder {
+	if receiver.err != nil {
+		return receiver
+	}
+	context := receiver.context()
+	if context.Feature == nil {
+		context.Feature = &PredictFeature{}
+	}
+	if err := putTypedValue(context.Feature, name, value); err != nil {
+		return receiver.fail("Feature(%s): %s", name, err)
+	}
+	return receiver
+}
+
+// Filter sets the context filter, the value is put into the typed map of
+// PredictFilter by its go type, in the same way as Feature
+func (receiver *PredictRequestBuilder) Filter(name string, value interface{}) *PredictRequestBuilder {
+	if receiver.err != nil {
+		return receiver
+	}
+	context := receiver.context()
+	if context.Filter == nil {
+		context.Filter = &PredictFilter{}
+	}
+	if err := putTypedValue(context.Filter, name, value); err != nil {
+		return receiver.fail("Filter(%s): %s", name, err)
+	}
+	return receiver
+}
+
+// ContextExtra sets the extra of context
+func (receiver *PredictRequestBuilder) ContextExtra(key string, value string) *PredictRequestBuilder {
+	if receiver.err != nil {
+		return receiver
+	}
+	context := receiver.context()
+	if context.Extra == nil {
+		context.Extra = make(map[string]string)
+	}
+	context.Extra[key] = value
+	return receiver
+}
+
+// Candidates appends the candidate items by ids, the ids should be unique
+func (receiver *PredictRequestBuilder) Candidates(ids ...string) *PredictRequestBuilder {
+	items := make([]*PredictCandidateItem, 0, len(ids))
+	for _, id := range ids {
+		items = append(items, &PredictCandidateItem{Id: id})
+	}
+	return receiver.CandidateItems(items...)
+}
+
+// CandidateItems appends the candidate items, the ids should be unique
+func (receiver *PredictRequestBuilder) CandidateItems(items ...*PredictCandidateItem) *PredictRequestBuilder {
+	if receiver.err != nil {
+		return receiver
+	}
+	seen := make(map[string]bool, len(receiver.request.CandidateItems)+len(items))
+	for _, item := range receiver.request.CandidateItems {
+		seen[item.GetId()] = true
+	}
+	for _, item := range items {
+		if item.GetId() == "" {
+			return receiver.fail("Candidates: id is empty")
+		}
+		if seen[item.GetId()] {
+			return receiver.fail("Candidates: id %s is duplicated", item.GetId())
+		}
+		seen[item.GetId()] = true
+	}
+	receiver.request.CandidateItems = append(receiver.request.CandidateItems, items...)
+	return receiver
+}
+
+// FilterItems appends the items which shouldn't be predicted
+func (receiver *PredictRequestBuilder) FilterItems(ids ...string) *PredictRequestBuilder {
+	if receiver.err != nil {
+		return receiver
+	}
+	for _, id := range ids {
+		if id == "" {
+			return receiver.fail("FilterItems: id is empty")
+		}
+		receiver.request.FilterItems = append(receiver.request.FilterItems, &PredictFilterItem{Id: id})
+	}
+	return receiver
+}
+
+// RelatedItem sets the item which the predicted items are related to, e.g. the
+// item of the detail page, extra is optional
+func (receiver *PredictRequestBuilder) RelatedItem(id string, extra map[string]string) *PredictRequestBuilder {
+	if receiver.err != nil {
+		return receiver
+	}
+	if id == "" {
+		return receiver.fail("RelatedItem: id is empty")
+	}
+	receiver.request.RelatedItem = &PredictRelatedItem{Id: id, Extra: extra}
+	return receiver
+}
+
+// ParentItem sets the parent item of the predicted items, extra is optional
+func (receiver *PredictRequestBuilder) ParentItem(id string, extra map[string]string) *PredictRequestBuilder {
+	if receiver.err != nil {
+		return receiver
+	}
+	if id == "" {
+		return receiver.fail("ParentItem: id is empty")
+	}
+	receiver.request.ParentItem = &PredictParentItem{Id: id, Extra: extra}
+	return receiver
+}
+
+// Search sets the query of SearchInfo
+func (receiver *PredictRequestBuilder) Search(query string) *PredictRequestBuilder {
+	if receiver.err != nil {
+		return receiver
+	}
+	if query == "" {
+		return receiver.fail("Search: query is empty")
+	}
+	receiver.searchInfo().Query = query
+	return receiver
+}
+
+// SearchContent sets the content of SearchInfo
+func (receiver *PredictRequestBuilder) SearchContent(content string) *PredictRequestBuilder {
+	if receiver.err != nil {
+		return receiver
+	}
+	receiver.searchInfo().Content = content
+	return receiver
+}
+
+// SearchType sets the search type of SearchInfo
+func (receiver *PredictRequestBuilder) SearchType(searchType int32) *PredictRequestBuilder {
+	if receiver.err != nil {
+		return receiver
+	}
+	receiver.searchInfo().SearchType = searchType
+	return receiver
+}
+
+// SearchStart sets the start offset of the search results
+func (receiver *PredictRequestBuilder) SearchStart(start int32) *PredictRequestBuilder {
+	if receiver.err != nil {
+		return receiver
+	}
+	if start < 0 {
+		return receiver.fail("SearchStart: %d is negative", start)
+	}
+	receiver.searchInfo().Start = start
+	return receiver
+}
+
+// SearchSort sets the sort mode and sort type of SearchInfo
+func (receiver *PredictRequestBuilder) SearchSort(sortMode int32, sortType int32) *PredictRequestBuilder {
+	if receiver.err != nil {
+		return receiver
+	}
+	searchInfo := receiver.searchInfo()
+	searchInfo.SortMode = sortMode
+	searchInfo.SortType = sortType
+	return receiver
+}
+
+// SearchFilter sets the values of the search filter
+func (receiver *PredictRequestBuilder) SearchFilter(name string, values ...string) *PredictRequestBuilder {
+	if receiver.err != nil {
+		return receiver
+	}
+	if name == "" {
+		return receiver.fail("SearchFilter: name is empty")
+	}
+	searchInfo := receiver.searchInfo()
+	if searchInfo.Filters == nil {
+		searchInfo.Filters = make(map[string]*StringArray)
+	}
+	searchInfo.Filters[name] = &StringArray{Values: values}
+	return receiver
+}
+
+// SearchFilterOut sets the values to be filtered out from the search results
+func (receiver *PredictRequestBuilder) SearchFilterOut(name string, values ...string) *PredictRequestBuilder {
+	if receiver.err != nil {
+		return receiver
+	}
+	if name == "" {
+		return receiver.fail("SearchFilterOut: name is empty")
+	}
+	searchInfo := receiver.searchInfo()
+	if searchInfo.FilterOut == nil {
+		searchInfo.FilterOut = make(map[string]*StringArray)
+	}
+	searchInfo.FilterOut[name] = &StringArray{Values: values}
+	return receiver
+}
+
+// SearchGoods appends a goods of the search results
+func (receiver *PredictRequestBuilder) SearchGoods(id string, price float32, boostCoef float32) *PredictRequestBuilder {
+	if receiver.err != nil {
+		return receiver
+	}
+	if id == "" {
+		return receiver.fail("SearchGoods: id is empty")
+	}
+	searchInfo := receiver.searchInfo()
+	searchInfo.GoodsList = append(searchInfo.GoodsList, &SearchGoods{
+		GoodsIdStr: id,
+		Price:      price,
+		BoostCeof:  boostCoef,
+	})
+	return receiver
+}
+
+// Extra sets the extra of request
+func (receiver *PredictRequestBuilder) Extra(key string, value string) *PredictRequestBuilder {
+	if receiver.err != nil {
+		return receiver
+	}
+	if receiver.request.Extra == nil {
+		receiver.request.Extra = &PredictExtra{}
+	}
+	if receiver.request.Extra.Extra == nil {
+		receiver.request.Extra.Extra = make(map[string]string)
+	}
+	receiver.request.Extra.Extra[key] = value
+	return receiver
+}
+
+// Build returns the request, or the first misuse of the builder. The uid of
+// user is required
+func (receiver *PredictRequestBuilder) Build() (*PredictRequest, error) {
+	if receiver.err != nil {
+		return nil, receiver.err
+	}
+	if receiver.request.GetUser().GetUid() == "" {
+		return nil, errors.New("user uid is empty")
+	}
+	return receiver.request, nil
+}
+
+// typedFieldNumbers is the field number of the typed map in PredictFeature and
+// PredictFilter by the go type of value, both messages number them in the same order
+var typedFieldNumbers = map[string]protoreflect.FieldNumber{
+	"string":    1,
+	"int64":     2,
+	"float32":   3,
+	"float64":   4,
+	"[]string":  5,
+	"[]int64":   6,
+	"[]float32": 7,
+	"[]float64": 8,
+}
+
+// putTypedValue puts value into the typed map of PredictFeature or PredictFilter
+// by its go type, the integers are converted to int64
+func putTypedValue(message proto.Message, name string, value interface{}) error {
+	if name == "" {
+		return errors.New("name is empty")
+	}
+	value, err := normalizeTypedValue(value)
+	if err != nil {
+		return err
+	}
+	number, ok := typedFieldNumbers[fmt.Sprintf("%T", value)]
+	if !ok {
+		return fmt.Errorf("type %T is unsupported", value)
+	}
+	msg := message.ProtoReflect()
+	fields := msg.Descriptor().Fields()
+	key := protoreflect.ValueOfString(name).MapKey()
+	for i := 0; i < fields.Len(); i++ {
+		field := fields.Get(i)
+		if field.Number() != number && msg.Get(field).Map().Has(key) {
+			return fmt.Errorf("it's already set in %s", field.Name())
+		}
+	}
+	var mapValue protoreflect.Value
+	switch v := value.(type) {
+	case []string:
+		mapValue = protoreflect.ValueOfMessage((&StringArray{Values: v}).ProtoReflect())
+	case []int64:
+		mapValue = protoreflect.ValueOfMessage((&IntArray{Values: v}).ProtoReflect())
+	case []float32:
+		mapValue = protoreflect.ValueOfMessage((&FloatArray{Values: v}).ProtoReflect())
+	case []float64:
+		mapValue = protoreflect.ValueOfMessage((&DoubleArray{Values: v}).ProtoReflect())
+	default:
+		mapValue = protoreflect.ValueOf(v)
+	}
+	msg.Mutable(fields.ByNumber(number)).Map().Set(key, mapValue)
+	return nil
+}
+
+// normalizeTypedValue converts the integers and integer slices to int64 and []int64
+func normalizeTypedValue(value interface{}) (interface{}, error) {
+	switch v := value.(type) {
+	case nil:
+		return nil, errors.New("value is nil")
+	case int:
+		return int64(v), nil
+	case int8:
+		return int64(v), nil
+	case int16:
+		return int64(v), nil
+	case int32:
+		return int64(v), nil
+	case uint8:
+		return int64(v), nil
+	case uint16:
+		return int64(v), nil
+	case uint32:
+		return int64(v), nil
+	case uint:
+		return uint64ToInt64(uint64(v))
+	case uint64:
+		return uint64ToInt64(v)
+	case []int:
+		result := make([]int64, 0, len(v))
+		for _, item := range v {
+			result = append(result, int64(item))
+		}
+		return result, nil
+	case []int32:
+		result := make([]int64, 0, len(v))
+		for _, item := range v {
+			result = append(result, int64(item))
+		}
+		return result, nil
+	}
+	return value, nil
+}
+
+func uint64ToInt64(value uint64) (interface{}, error) {
+	if value > math.MaxInt64 {
+		return nil, fmt.Errorf("%d overflows int64", value)
+	}
+	return int64(value), nil
+}
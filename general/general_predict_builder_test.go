@@ -0,0 +1,204 @@
+package general
+
+import (
+	"math"
+	"strings"
+	"testing"
+
+	. "github.com/byteplus-sdk/sdk-go/general/protocol"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestPredictRequestBuilder_Feature(t *testing.T) {
+	tests := []struct {
+		name  string
+		value interface{}
+		want  *PredictFeature
+	}{
+		{name: "string", value: "red", want: &PredictFeature{StringFeature: map[string]string{"f": "red"}}},
+		{name: "int", value: 3, want: &PredictFeature{IntFeature: map[string]int64{"f": 3}}},
+		{name: "int8", value: int8(-3), want: &PredictFeature{IntFeature: map[string]int64{"f": -3}}},
+		{name: "int32", value: int32(3), want: &PredictFeature{IntFeature: map[string]int64{"f": 3}}},
+		{name: "int64", value: int64(math.MaxInt64),
+			want: &PredictFeature{IntFeature: map[string]int64{"f": math.MaxInt64}}},
+		{name: "uint32", value: uint32(math.MaxUint32),
+			want: &PredictFeature{IntFeature: map[string]int64{"f": math.MaxUint32}}},
+		{name: "uint64", value: uint64(math.MaxInt64),
+			want: &PredictFeature{IntFeature: map[string]int64{"f": math.MaxInt64}}},
+		{name: "float32", value: float32(1.5), want: &PredictFeature{FloatFeature: map[string]float32{"f": 1.5}}},
+		{name: "float64", value: 1.5, want: &PredictFeature{DoubleFeature: map[string]float64{"f": 1.5}}},
+		{name: "strings", value: []string{"a"},
+			want: &PredictFeature{StringArrayFeature: map[string]*StringArray{"f": {Values: []string{"a"}}}}},
+		{name: "ints", value: []int{1, 2},
+			want: &PredictFeature{IntArrayFeature: map[string]*IntArray{"f": {Values: []int64{1, 2}}}}},
+		{name: "int32s", value: []int32{1},
+			want: &PredictFeature{IntArrayFeature: map[string]*IntArray{"f": {Values: []int64{1}}}}},
+		{name: "int64s", value: []int64{1},
+			want: &PredictFeature{IntArrayFeature: map[string]*IntArray{"f": {Values: []int64{1}}}}},
+		{name: "float32s", value: []float32{1.5},
+			want: &PredictFeature{FloatArrayFeature: map[string]*FloatArray{"f": {Values: []float32{1.5}}}}},
+		{name: "float64s", value: []float64{1.5},
+			want: &PredictFeature{DoubleArrayFeature: map[string]*DoubleArray{"f": {Values: []float64{1.5}}}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			request, err := NewPredictRequest().User("u1").Feature("f", tt.value).Build()
+			if err != nil {
+				t.Fatalf("Build() error = %v", err)
+			}
+			if got := request.GetContext().GetFeature(); !proto.Equal(got, tt.want) {
+				t.Errorf("Feature() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPredictRequestBuilder_Filter(t *testing.T) {
+	request, err := NewPredictRequest().User("u1").
+		Filter("tags", []string{"new"}).
+		Filter("price", 10).
+		Filter("price", 20).
+		Build()
+	if err != nil {
+		t.Fatalf("Build() error = %v", err)
+	}
+	want := &PredictFilter{
+		StringArrayFilter: map[string]*StringArray{"tags": {Values: []string{"new"}}},
+		IntFilter:         map[string]int64{"price": 20},
+	}
+	if got := request.GetContext().GetFilter(); !proto.Equal(got, want) {
+		t.Errorf("Filter() = %v, want %v", got, want)
+	}
+}
+
+func TestPredictRequestBuilder_misuse(t *testing.T) {
+	tests := []struct {
+		name    string
+		build   func(builder *PredictRequestBuilder) *PredictRequestBuilder
+		wantErr string
+	}{
+		{
+			name: "cross_type_feature",
+			build: func(builder *PredictRequestBuilder) *PredictRequestBuilder {
+				return builder.Feature("price", 10).Feature("price", 9.9)
+			},
+			wantErr: "Feature(price): it's already set in intFeature",
+		},
+		{
+			name: "cross_type_filter",
+			build: func(builder *PredictRequestBuilder) *PredictRequestBuilder {
+				return builder.Filter("tags", "new").Filter("tags", []string{"new"})
+			},
+			wantErr: "Filter(tags): it's already set in stringFilter",
+		},
+		{
+			name: "uint_overflow",
+			build: func(builder *PredictRequestBuilder) *PredictRequestBuilder {
+				return builder.Feature("count", uint64(math.MaxUint64))
+			},
+			wantErr: "overflows int64",
+		},
+		{
+			name: "unsupported_type",
+			build: func(builder *PredictRequestBuilder) *PredictRequestBuilder {
+				return builder.Feature("tags", []interface{}{"new"})
+			},
+			wantErr: "type []interface {} is unsupported",
+		},
+		{
+			name: "nil_value",
+			build: func(builder *PredictRequestBuilder) *PredictRequestBuilder {
+				return builder.Filter("tags", nil)
+			},
+			wantErr: "value is nil",
+		},
+		{
+			name: "empty_name",
+			build: func(builder *PredictRequestBuilder) *PredictRequestBuilder {
+				return builder.Feature("", 1)
+			},
+			wantErr: "name is empty",
+		},
+		{
+			name: "size_out_of_range",
+			build: func(builder *PredictRequestBuilder) *PredictRequestBuilder {
+				return builder.Size(0)
+			},
+			wantErr: "Size: 0 is out of range",
+		},
+		{
+			name: "size_overflow",
+			build: func(builder *PredictRequestBuilder) *PredictRequestBuilder {
+				size := int64(math.MaxInt32) + 1
+				return builder.Size(int(size))
+			},
+			wantErr: "is out of range",
+		},
+		{
+			name: "nil_candidate",
+			build: func(builder *PredictRequestBuilder) *PredictRequestBuilder {
+				return builder.CandidateItems(nil)
+			},
+			wantErr: "Candidates: id is empty",
+		},
+		{
+			name: "duplicated_candidate",
+			build: func(builder *PredictRequestBuilder) *PredictRequestBuilder {
+				return builder.Candidates("i1").Candidates("i2", "i1")
+			},
+			wantErr: "Candidates: id i1 is duplicated",
+		},
+		{
+			name: "nil_user_profile",
+			build: func(builder *PredictRequestBuilder) *PredictRequestBuilder {
+				return builder.UserProfile(nil)
+			},
+			wantErr: "UserProfile: user is nil",
+		},
+		{
+			name: "nil_device",
+			build: func(builder *PredictRequestBuilder) *PredictRequestBuilder {
+				return builder.Device(nil)
+			},
+			wantErr: "Device: device is nil",
+		},
+		{
+			name: "first_misuse_kept",
+			build: func(builder *PredictRequestBuilder) *PredictRequestBuilder {
+				return builder.Search("").RelatedItem("", nil).Feature("f", struct{}{})
+			},
+			wantErr: "Search: query is empty",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			request, err := tt.build(NewPredictRequest().User("u1")).Build()
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) || request != nil {
+				t.Errorf("Build() = %v, %v, want error %q", request, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestPredictRequestBuilder_Build(t *testing.T) {
+	if _, err := NewPredictRequest().Size(10).Build(); err == nil {
+		t.Errorf("Build() without uid should fail")
+	}
+	request, err := NewPredictRequest().
+		User("u1").
+		UserProfile(&PredictUser{Gender: "female"}).
+		Size(10).
+		Candidates("i1", "i2").
+		FilterItems("i3").
+		Search("shoes").
+		Extra("k", "v").
+		Build()
+	if err != nil {
+		t.Fatalf("Build() error = %v", err)
+	}
+	if request.GetUser().GetUid() != "u1" || request.GetUser().GetGender() != "female" ||
+		request.GetSize() != 10 || len(request.GetCandidateItems()) != 2 || len(request.GetFilterItems()) != 1 ||
+		request.GetSearchInfo().GetQuery() != "shoes" || request.GetExtra().GetExtra()["k"] != "v" {
+		t.Errorf("Build() = %v", request)
+	}
+}
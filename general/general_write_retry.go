@@ -0,0 +1,127 @@
+package general
+
+import (
+	"encoding/json"
+
+	. "github.com/byteplus-sdk/sdk-go/core"
+	"github.com/byteplus-sdk/sdk-go/core/logs"
+	"github.com/byteplus-sdk/sdk-go/core/option"
+	. "github.com/byteplus-sdk/sdk-go/general/protocol"
+	"google.golang.org/protobuf/proto"
+)
+
+// WriteDataWithRetry
+//
+// Writes data by client.WriteData, and resubmits only the data failed with
+// retryable errors. The Item of FailedItem in report is map[string]interface{}
+// decoded from DataError.Data, or the raw string if it isn't a json object,
+// which is a permanent failure as it can't be resubmitted
+func WriteDataWithRetry(client Client, dataList []map[string]interface{}, topic string,
+	config *PartialRetryConfig, opts ...option.Option) (*PartialRetryReport, error) {
+	config = withTopicDeadLetterSink(RetryConfigWithRequestId(config, opts), topic)
+	return RetryPartialFailures(config, dataItems(dataList), dataWriter(client, topic, opts), dataFailedItems)
+}
+
+// ReplayDeadLetters
+//
+// Resubmits the data of dead letters by WriteData with the topic of each
+// dead letter, returns the dead letters still failed
+func ReplayDeadLetters(client Client, letters []*DeadLetter,
+	opts ...option.Option) ([]*DeadLetter, error) {
+	var topics []string
+	topicLetters := make(map[string][]*DeadLetter)
+	var stillFailed []*DeadLetter
+	for _, letter := range letters {
+		if _, ok := letter.Item.(map[string]interface{}); !ok || letter.Topic == "" {
+			logs.Warn("[ReplayDeadLetters] unsupported dead letter, type:%s topic:%s", letter.Type, letter.Topic)
+			stillFailed = append(stillFailed, letter)
+			continue
+		}
+		if _, exist := topicLetters[letter.Topic]; !exist {
+			topics = append(topics, letter.Topic)
+		}
+		topicLetters[letter.Topic] = append(topicLetters[letter.Topic], letter)
+	}
+	var lastErr error
+	for _, topic := range topics {
+		failed, err := ResubmitDeadLetters(topicLetters[topic], RequestIdPrefix(opts),
+			dataWriter(client, topic, opts), dataFailedItems)
+		if err != nil {
+			lastErr = err
+		}
+		stillFailed = append(stillFailed, failed...)
+	}
+	return stillFailed, lastErr
+}
+
+func dataItems(dataList []map[string]interface{}) []interface{} {
+	items := make([]interface{}, 0, len(dataList))
+	for _, data := range dataList {
+		items = append(items, data)
+	}
+	return items
+}
+
+func dataWriter(client Client, topic string, opts []option.Option) PartialWriteFunc {
+	return func(items []interface{}, requestId string) (proto.Message, error) {
+		dataList := make([]map[string]interface{}, 0, len(items))
+		for _, item := range items {
+			// the failed data which can't be decoded are permanent failures,
+			// so the items resubmitted are always decoded maps
+			dataList = append(dataList, item.(map[string]interface{}))
+		}
+		response, err := client.WriteData(dataList, topic, RetryOptions(opts, requestId)...)
+		if err != nil {
+			return nil, err
+		}
+		return response, nil
+	}
+}
+
+func dataFailedItems(items []interface{}, response proto.Message) []*FailedItem {
+	var result []*FailedItem
+	for _, dataErr := range response.(*WriteResponse).GetErrors() {
+		data := decodeErrorData(dataErr.Data)
+		_, decoded := data.(map[string]interface{})
+		result = append(result, &FailedItem{Item: data, Message: dataErr.Message, Permanent: !decoded})
+	}
+	return result
+}
+
+func decodeErrorData(rawData string) interface{} {
+	data := make(map[string]interface{})
+	if err := json.Unmarshal([]byte(rawData), &data); err != nil {
+		return rawData
+	}
+	return data
+}
+
+func withTopicDeadLetterSink(config *PartialRetryConfig, topic string) *PartialRetryConfig {
+	if config == nil || config.DeadLetterSink == nil {
+		return config
+	}
+	topicConfig := *config
+	topicConfig.DeadLetterSink = withTopic(config.DeadLetterSink, topic)
+	return &topicConfig
+}
+
+// withTopic returns the sink setting the topic of dead letters, or nil if sink is nil
+func withTopic(sink DeadLetterSink, topic string) DeadLetterSink {
+	if sink == nil {
+		return nil
+	}
+	return &topicDeadLetterSink{sink: sink, topic: topic}
+}
+
+// topicDeadLetterSink sets the topic of dead letters before writing them to sink
+type topicDeadLetterSink struct {
+	sink  DeadLetterSink
+	topic string
+}
+
+func (receiver *topicDeadLetterSink) Write(letters []*DeadLetter) error {
+	for _, letter := range letters {
+		letter.Topic = receiver.topic
+	}
+	return receiver.sink.Write(letters)
+}
@@ -0,0 +1,251 @@
+package schema
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/byteplus-sdk/sdk-go/core"
+)
+
+// Coerce returns the rows of dataList coerced by the schema of topic, and the
+// issues of rows, whose Index is the index in dataList. The rows with any issue
+// not a warning are excluded from the result, and the fields not in schema are
+// kept and reported as warnings. The dataList is returned as is if the topic has
+// no schema. The rows in dataList are not modified. Note that WriteData of the
+// general client built with the registry rejects the whole dataList if any row
+// is invalid, Coerce can be called before it to send only the valid rows
+func (receiver *Registry) Coerce(topic string,
+	dataList []map[string]interface{}) ([]map[string]interface{}, []*core.ValidationIssue) {
+	s := receiver.get(topic)
+	if s == nil {
+		return dataList, nil
+	}
+	result := make([]map[string]interface{}, 0, len(dataList))
+	var issues []*core.ValidationIssue
+	for i, row := range dataList {
+		coerced, rowIssues := s.coerceRow(row)
+		valid := true
+		for _, issue := range rowIssues {
+			issue.Index = i
+			valid = valid && issue.Warning
+		}
+		issues = append(issues, rowIssues...)
+		if valid {
+			result = append(result, coerced)
+		}
+	}
+	return result, issues
+}
+
+func (receiver *schema) coerceRow(row map[string]interface{}) (map[string]interface{}, []*core.ValidationIssue) {
+	result := make(map[string]interface{}, len(row))
+	var issues []*core.ValidationIssue
+	for _, f := range receiver.Fields {
+		value, exist := row[f.Name]
+		if value == nil {
+			if f.Required {
+				issues = append(issues, &core.ValidationIssue{Field: f.Name, Message: "is required"})
+			} else if exist {
+				result[f.Name] = nil
+			}
+			continue
+		}
+		coerced, path, problem := receiver.fields[f.Name].coerce(value)
+		if problem != "" {
+			issues = append(issues, &core.ValidationIssue{Field: path, Message: problem})
+			continue
+		}
+		result[f.Name] = coerced
+	}
+	var unknown []string
+	for name, value := range row {
+		if receiver.fields[name] == nil {
+			unknown = append(unknown, name)
+			result[name] = value
+		}
+	}
+	sort.Strings(unknown)
+	for _, name := range unknown {
+		issues = append(issues, &core.ValidationIssue{
+			Field:   name,
+			Message: fmt.Sprintf("is not in schema of topic %s", receiver.Topic),
+			Warning: true,
+		})
+	}
+	return result, issues
+}
+
+// coerce returns the value coerced, or the path of the field and the problem
+// if it can't be coerced or is not acceptable
+func (receiver *field) coerce(value interface{}) (interface{}, string, string) {
+	if !receiver.Type.isArray() {
+		coerced, problem := receiver.coerceElement(receiver.Type, value)
+		if problem == "" && receiver.Required && coerced == "" {
+			problem = "is empty"
+		}
+		return coerced, receiver.Name, problem
+	}
+	elements, ok := toSlice(value)
+	if !ok {
+		return nil, receiver.Name, fmt.Sprintf("%v (%T) is not an array", value, value)
+	}
+	if receiver.Required && len(elements) == 0 {
+		return nil, receiver.Name, "is empty"
+	}
+	result := make([]interface{}, 0, len(elements))
+	for i, element := range elements {
+		coerced, problem := receiver.coerceElement(elementTypes[receiver.Type], element)
+		if problem != "" {
+			return nil, fmt.Sprintf("%s[%d]", receiver.Name, i), problem
+		}
+		result = append(result, coerced)
+	}
+	return result, receiver.Name, ""
+}
+
+func (receiver *field) coerceElement(fieldType FieldType, value interface{}) (interface{}, string) {
+	var coerced interface{}
+	var ok bool
+	switch fieldType {
+	case TypeString:
+		coerced, ok = toString(value)
+	case TypeInt:
+		coerced, ok = toInt(value)
+	case TypeFloat:
+		coerced, ok = toFloat(value)
+	case TypeBool:
+		coerced, ok = toBool(value)
+	case TypeTimestamp:
+		coerced, ok = toTimestamp(value)
+	}
+	if !ok {
+		return nil, fmt.Sprintf("%v (%T) is not %s", value, value, fieldType)
+	}
+	if receiver.enum != nil && !receiver.enum[fmt.Sprint(coerced)] {
+		return nil, fmt.Sprintf("%v is not in enum %v", coerced, receiver.Enum)
+	}
+	return coerced, ""
+}
+
+func toString(value interface{}) (string, bool) {
+	v := reflect.ValueOf(value)
+	switch v.Kind() {
+	case reflect.String:
+		return v.String(), true
+	case reflect.Bool:
+		return strconv.FormatBool(v.Bool()), true
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(v.Int(), 10), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(v.Uint(), 10), true
+	case reflect.Float32, reflect.Float64:
+		return strconv.FormatFloat(v.Float(), 'f', -1, 64), true
+	}
+	return "", false
+}
+
+func toInt(value interface{}) (int64, bool) {
+	v := reflect.ValueOf(value)
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return v.Int(), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return int64(v.Uint()), v.Uint() <= math.MaxInt64
+	case reflect.String:
+		if result, err := strconv.ParseInt(strings.TrimSpace(v.String()), 10, 64); err == nil {
+			return result, true
+		}
+	}
+	// integral floats, e.g. the numbers decoded from json, or "1.0"
+	f, ok := toFloat(value)
+	if !ok || f != math.Trunc(f) || f < math.MinInt64 || f >= math.MaxInt64 {
+		return 0, false
+	}
+	return int64(f), true
+}
+
+func toFloat(value interface{}) (float64, bool) {
+	v := reflect.ValueOf(value)
+	var result float64
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		result = float64(v.Int())
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		result = float64(v.Uint())
+	case reflect.Float32, reflect.Float64:
+		result = v.Float()
+	case reflect.String:
+		parsed, err := strconv.ParseFloat(strings.TrimSpace(v.String()), 64)
+		if err != nil {
+			return 0, false
+		}
+		result = parsed
+	default:
+		return 0, false
+	}
+	// NaN and Inf can't be encoded to json
+	return result, !math.IsNaN(result) && !math.IsInf(result, 0)
+}
+
+func toBool(value interface{}) (bool, bool) {
+	v := reflect.ValueOf(value)
+	switch v.Kind() {
+	case reflect.Bool:
+		return v.Bool(), true
+	case reflect.String:
+		result, err := strconv.ParseBool(strings.TrimSpace(v.String()))
+		return result, err == nil
+	}
+	i, ok := toInt(value)
+	if !ok || (i != 0 && i != 1) {
+		return false, false
+	}
+	return i == 1, true
+}
+
+func toTimestamp(value interface{}) (int64, bool) {
+	switch v := value.(type) {
+	case time.Time:
+		return v.Unix(), true
+	case string:
+		if t, err := time.Parse(time.RFC3339, strings.TrimSpace(v)); err == nil {
+			return t.Unix(), true
+		}
+	}
+	result, ok := toInt(value)
+	if !ok {
+		return 0, false
+	}
+	if result > core.MaxSecondTimestamp {
+		result /= 1000
+	}
+	return result, true
+}
+
+// toSlice returns the elements of a slice, or of a json array in string
+func toSlice(value interface{}) ([]interface{}, bool) {
+	if s, ok := value.(string); ok {
+		decoder := json.NewDecoder(strings.NewReader(s))
+		decoder.UseNumber()
+		var result []interface{}
+		if err := decoder.Decode(&result); err != nil || decoder.More() {
+			return nil, false
+		}
+		return result, true
+	}
+	v := reflect.ValueOf(value)
+	if v.Kind() != reflect.Slice && v.Kind() != reflect.Array {
+		return nil, false
+	}
+	result := make([]interface{}, 0, v.Len())
+	for i := 0; i < v.Len(); i++ {
+		result = append(result, v.Index(i).Interface())
+	}
+	return result, true
+}
@@ -0,0 +1,117 @@
+package schema
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func newTestRegistry(t *testing.T) *Registry {
+	registry := NewRegistry()
+	err := registry.Register(&Schema{Topic: "item", Fields: []*Field{
+		{Name: "id", Type: TypeString, Required: true},
+		{Name: "price", Type: TypeFloat},
+		{Name: "stock", Type: TypeInt, Enum: []string{"0", "1", "2"}},
+		{Name: "on_sale", Type: TypeBool},
+		{Name: "publish_time", Type: TypeTimestamp},
+		{Name: "tags", Type: TypeStringArray},
+		{Name: "sizes", Type: TypeIntArray},
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return registry
+}
+
+func TestCoerce(t *testing.T) {
+	publishTime := time.Unix(1640000000, 0)
+	tests := []struct {
+		name  string
+		row   map[string]interface{}
+		field string
+		want  interface{}
+	}{
+		{name: "int_to_string", row: map[string]interface{}{"id": 123}, field: "id", want: "123"},
+		{name: "string_to_float", row: map[string]interface{}{"price": " 9.5"}, field: "price", want: 9.5},
+		{name: "json_number_to_int", row: map[string]interface{}{"stock": json.Number("2")}, field: "stock", want: int64(2)},
+		{name: "integral_float_to_int", row: map[string]interface{}{"stock": 1.0}, field: "stock", want: int64(1)},
+		{name: "string_to_bool", row: map[string]interface{}{"on_sale": "true"}, field: "on_sale", want: true},
+		{name: "int_to_bool", row: map[string]interface{}{"on_sale": 0}, field: "on_sale", want: false},
+		{name: "time_to_timestamp", row: map[string]interface{}{"publish_time": publishTime}, field: "publish_time", want: int64(1640000000)},
+		{name: "rfc3339_to_timestamp", row: map[string]interface{}{"publish_time": publishTime.UTC().Format(time.RFC3339)},
+			field: "publish_time", want: int64(1640000000)},
+		{name: "milliseconds_to_timestamp", row: map[string]interface{}{"publish_time": int64(1640000000123)},
+			field: "publish_time", want: int64(1640000000)},
+		{name: "stringified_array", row: map[string]interface{}{"tags": `["a", 1]`}, field: "tags", want: []interface{}{"a", "1"}},
+		{name: "typed_slice", row: map[string]interface{}{"sizes": []string{"1", "2"}}, field: "sizes", want: []interface{}{int64(1), int64(2)}},
+		{name: "null_kept", row: map[string]interface{}{"price": nil}, field: "price", want: nil},
+	}
+	registry := newTestRegistry(t)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, exist := tt.row["id"]; !exist {
+				tt.row["id"] = "1"
+			}
+			result, issues := registry.Coerce("item", []map[string]interface{}{tt.row})
+			if len(issues) != 0 || len(result) != 1 {
+				t.Fatalf("Coerce() = %v, issues %v", result, issues)
+			}
+			got, exist := result[0][tt.field]
+			if !exist || !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Coerce() %s = %#v, want %#v", tt.field, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCoerceInvalid(t *testing.T) {
+	tests := []struct {
+		name      string
+		row       map[string]interface{}
+		wantField string
+	}{
+		{name: "required_absent", row: map[string]interface{}{"price": 1}, wantField: "id"},
+		{name: "required_null", row: map[string]interface{}{"id": nil}, wantField: "id"},
+		{name: "required_empty", row: map[string]interface{}{"id": ""}, wantField: "id"},
+		{name: "not_numeric", row: map[string]interface{}{"id": "1", "price": "cheap"}, wantField: "price"},
+		{name: "fractional_int", row: map[string]interface{}{"id": "1", "stock": 1.5}, wantField: "stock"},
+		{name: "not_in_enum", row: map[string]interface{}{"id": "1", "stock": 3}, wantField: "stock"},
+		{name: "not_bool", row: map[string]interface{}{"id": "1", "on_sale": 2}, wantField: "on_sale"},
+		{name: "map_to_string", row: map[string]interface{}{"id": map[string]interface{}{}}, wantField: "id"},
+		{name: "not_array", row: map[string]interface{}{"id": "1", "tags": "a,b"}, wantField: "tags"},
+		{name: "invalid_element", row: map[string]interface{}{"id": "1", "sizes": []interface{}{1, "L"}}, wantField: "sizes[1]"},
+	}
+	registry := newTestRegistry(t)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			valid := map[string]interface{}{"id": "0"}
+			result, issues := registry.Coerce("item", []map[string]interface{}{valid, tt.row})
+			if len(result) != 1 || result[0]["id"] != "0" {
+				t.Errorf("Coerce() = %v, want only the valid row", result)
+			}
+			if len(issues) != 1 || issues[0].Index != 1 || issues[0].Field != tt.wantField || issues[0].Warning {
+				t.Fatalf("Coerce() issues = %v, want error of %s", issues, tt.wantField)
+			}
+		})
+	}
+}
+
+func TestCoerceUnknown(t *testing.T) {
+	registry := newTestRegistry(t)
+	row := map[string]interface{}{"id": 1, "color": "red"}
+	result, issues := registry.Coerce("item", []map[string]interface{}{row})
+	if len(result) != 1 || result[0]["color"] != "red" || result[0]["id"] != "1" {
+		t.Errorf("Coerce() = %v", result)
+	}
+	if len(issues) != 1 || issues[0].Field != "color" || !issues[0].Warning {
+		t.Errorf("Coerce() issues = %v, want warning of unknown field", issues)
+	}
+	if row["id"] != 1 {
+		t.Errorf("Coerce() should not modify the rows")
+	}
+	dataList := []map[string]interface{}{row}
+	if result, issues := registry.Coerce("user", dataList); len(issues) != 0 || !reflect.DeepEqual(result, dataList) {
+		t.Errorf("Coerce() of topic without schema = %v, %v", result, issues)
+	}
+}
@@ -0,0 +1,210 @@
+// Package schema keeps the schemas of general data topics locally, which are
+// used to coerce the types of data and reject the invalid ones before WriteData
+package schema
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"gopkg.in/yaml.v2"
+)
+
+// FieldType is the type of a field value after coercion
+type FieldType string
+
+const (
+	// string, numbers and bools are formatted as string
+	TypeString FieldType = "string"
+	// int64, integral floats and numeric strings are accepted
+	TypeInt FieldType = "int"
+	// float64, numbers and numeric strings are accepted
+	TypeFloat FieldType = "float"
+	// bool, 0/1 and strings like "true" are accepted
+	TypeBool FieldType = "bool"
+	// int64 in seconds, milliseconds, RFC3339 strings and time.Time are
+	// converted to seconds
+	TypeTimestamp FieldType = "timestamp"
+	// the arrays accept slices and json arrays in string, e.g. "[\"a\",\"b\"]",
+	// and their elements are coerced as the element type
+	TypeStringArray FieldType = "string_array"
+	TypeIntArray    FieldType = "int_array"
+	TypeFloatArray  FieldType = "float_array"
+)
+
+// the element type of array types, and the scalar types themselves
+var elementTypes = map[FieldType]FieldType{
+	TypeString:      TypeString,
+	TypeInt:         TypeInt,
+	TypeFloat:       TypeFloat,
+	TypeBool:        TypeBool,
+	TypeTimestamp:   TypeTimestamp,
+	TypeStringArray: TypeString,
+	TypeIntArray:    TypeInt,
+	TypeFloatArray:  TypeFloat,
+}
+
+func (receiver FieldType) isArray() bool {
+	return elementTypes[receiver] != receiver
+}
+
+// Field is a field of a topic
+type Field struct {
+	Name string    `json:"name" yaml:"name"`
+	Type FieldType `json:"type" yaml:"type"`
+	// Required fields can't be absent or null, and required strings can't be empty
+	Required bool `json:"required,omitempty" yaml:"required,omitempty"`
+	// Optional, the acceptable values of a string or int field, or of the
+	// elements of an array field
+	Enum []string `json:"enum,omitempty" yaml:"enum,omitempty"`
+}
+
+// Schema is the fields of the data of a topic, e.g. "user"
+type Schema struct {
+	Topic  string   `json:"topic" yaml:"topic"`
+	Fields []*Field `json:"fields" yaml:"fields"`
+}
+
+func (receiver *Schema) check() error {
+	if receiver.Topic == "" {
+		return errors.New("topic of schema is empty")
+	}
+	names := make(map[string]bool, len(receiver.Fields))
+	for i, field := range receiver.Fields {
+		if field.Name == "" {
+			return fmt.Errorf("name of field %d in topic %s is empty", i, receiver.Topic)
+		}
+		if names[field.Name] {
+			return fmt.Errorf("field %s in topic %s is duplicated", field.Name, receiver.Topic)
+		}
+		names[field.Name] = true
+		elementType, exist := elementTypes[field.Type]
+		if !exist {
+			return fmt.Errorf("type %q of field %s in topic %s is unknown", field.Type, field.Name, receiver.Topic)
+		}
+		if len(field.Enum) > 0 && elementType != TypeString && elementType != TypeInt {
+			return fmt.Errorf("enum of field %s in topic %s is only for string and int", field.Name, receiver.Topic)
+		}
+	}
+	return nil
+}
+
+// Registry is the schemas by topic, it's safe for concurrent use
+type Registry struct {
+	lock    sync.RWMutex
+	schemas map[string]*schema
+}
+
+// schema is the Schema indexed for coercion
+type schema struct {
+	*Schema
+	fields map[string]*field
+}
+
+type field struct {
+	*Field
+	enum map[string]bool
+}
+
+func NewRegistry() *Registry {
+	return &Registry{schemas: make(map[string]*schema)}
+}
+
+// Register adds the schemas, which replace the registered ones of the same topics.
+// None of them is added if any is invalid
+func (receiver *Registry) Register(schemas ...*Schema) error {
+	indexed := make([]*schema, 0, len(schemas))
+	for _, s := range schemas {
+		if err := s.check(); err != nil {
+			return err
+		}
+		indexed = append(indexed, newSchema(s))
+	}
+	receiver.lock.Lock()
+	defer receiver.lock.Unlock()
+	for _, s := range indexed {
+		receiver.schemas[s.Topic] = s
+	}
+	return nil
+}
+
+func newSchema(s *Schema) *schema {
+	result := &schema{Schema: s, fields: make(map[string]*field, len(s.Fields))}
+	for _, f := range s.Fields {
+		indexed := &field{Field: f}
+		if len(f.Enum) > 0 {
+			indexed.enum = make(map[string]bool, len(f.Enum))
+			for _, value := range f.Enum {
+				indexed.enum[value] = true
+			}
+		}
+		result.fields[f.Name] = indexed
+	}
+	return result
+}
+
+// Schema returns the schema of topic, or nil if it's not registered
+func (receiver *Registry) Schema(topic string) *Schema {
+	if s := receiver.get(topic); s != nil {
+		return s.Schema
+	}
+	return nil
+}
+
+func (receiver *Registry) get(topic string) *schema {
+	receiver.lock.RLock()
+	defer receiver.lock.RUnlock()
+	return receiver.schemas[topic]
+}
+
+// schemaFile is the content of a schema file, e.g. in yaml
+//
+//	schemas:
+//	  - topic: user
+//	    fields:
+//	      - name: user_id
+//	        type: string
+//	        required: true
+//	      - name: gender
+//	        type: string
+//	        enum: [male, female]
+type schemaFile struct {
+	Schemas []*Schema `json:"schemas" yaml:"schemas"`
+}
+
+// LoadJSON registers the schemas in json, in the format of {"schemas": [...]}
+func (receiver *Registry) LoadJSON(data []byte) error {
+	file := &schemaFile{}
+	if err := json.Unmarshal(data, file); err != nil {
+		return fmt.Errorf("parse schemas fail, %s", err.Error())
+	}
+	return receiver.Register(file.Schemas...)
+}
+
+// LoadYAML registers the schemas in yaml, in the same format as LoadJSON
+func (receiver *Registry) LoadYAML(data []byte) error {
+	file := &schemaFile{}
+	if err := yaml.UnmarshalStrict(data, file); err != nil {
+		return fmt.Errorf("parse schemas fail, %s", err.Error())
+	}
+	return receiver.Register(file.Schemas...)
+}
+
+// LoadFile registers the schemas in the file, which is parsed as yaml if its
+// extension is ".yaml" or ".yml", otherwise as json
+func (receiver *Registry) LoadFile(path string) error {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".yaml", ".yml":
+		return receiver.LoadYAML(data)
+	default:
+		return receiver.LoadJSON(data)
+	}
+}
@@ -0,0 +1,103 @@
+package schema
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+const testSchemasYAML = `
+schemas:
+  - topic: user
+    fields:
+      - name: user_id
+        type: string
+        required: true
+      - name: gender
+        type: string
+        enum: [male, female]
+      - name: register_time
+        type: timestamp
+      - name: tags
+        type: string_array
+`
+
+const testSchemasJSON = `{"schemas": [{"topic": "user", "fields": [
+	{"name": "user_id", "type": "string", "required": true},
+	{"name": "gender", "type": "string", "enum": ["male", "female"]},
+	{"name": "register_time", "type": "timestamp"},
+	{"name": "tags", "type": "string_array"}
+]}]}`
+
+func TestLoad(t *testing.T) {
+	dir, err := ioutil.TempDir("", "schema")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	tests := []struct {
+		name    string
+		file    string
+		content string
+	}{
+		{name: "yaml", file: "schemas.yaml", content: testSchemasYAML},
+		{name: "yml", file: "schemas.yml", content: testSchemasYAML},
+		{name: "json", file: "schemas.json", content: testSchemasJSON},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(dir, tt.file)
+			if err := ioutil.WriteFile(path, []byte(tt.content), 0644); err != nil {
+				t.Fatal(err)
+			}
+			registry := NewRegistry()
+			if err := registry.LoadFile(path); err != nil {
+				t.Fatalf("LoadFile() error = %v", err)
+			}
+			s := registry.Schema("user")
+			if s == nil || len(s.Fields) != 4 {
+				t.Fatalf("Schema() = %v, want 4 fields", s)
+			}
+			if gender := s.Fields[1]; gender.Type != TypeString || len(gender.Enum) != 2 || gender.Required {
+				t.Errorf("field = %+v", gender)
+			}
+			if !s.Fields[0].Required || s.Fields[3].Type != TypeStringArray {
+				t.Errorf("fields = %+v, %+v", s.Fields[0], s.Fields[3])
+			}
+			if registry.Schema("product") != nil {
+				t.Errorf("Schema() of unregistered topic should be nil")
+			}
+		})
+	}
+}
+
+func TestRegisterInvalid(t *testing.T) {
+	tests := []struct {
+		name   string
+		schema *Schema
+	}{
+		{name: "empty_topic", schema: &Schema{Fields: []*Field{{Name: "id", Type: TypeString}}}},
+		{name: "empty_name", schema: &Schema{Topic: "user", Fields: []*Field{{Type: TypeString}}}},
+		{name: "duplicated", schema: &Schema{Topic: "user", Fields: []*Field{
+			{Name: "id", Type: TypeString}, {Name: "id", Type: TypeInt}}}},
+		{name: "unknown_type", schema: &Schema{Topic: "user", Fields: []*Field{{Name: "id", Type: "long"}}}},
+		{name: "enum_of_float", schema: &Schema{Topic: "user", Fields: []*Field{
+			{Name: "score", Type: TypeFloat, Enum: []string{"1"}}}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			registry := NewRegistry()
+			valid := &Schema{Topic: "product", Fields: []*Field{{Name: "id", Type: TypeString}}}
+			if err := registry.Register(valid, tt.schema); err == nil {
+				t.Fatalf("Register() should fail")
+			}
+			if registry.Schema("product") != nil {
+				t.Errorf("Register() should add none of the schemas if any is invalid")
+			}
+		})
+	}
+	if err := NewRegistry().LoadYAML([]byte("schemas:\n  - topic: user\n    field: []\n")); err == nil {
+		t.Errorf("LoadYAML() should fail with unknown keys")
+	}
+}
@@ -7,4 +7,5 @@ require (
 	github.com/valyala/fasthttp v1.27.0
 	go.uber.org/atomic v1.9.0
 	google.golang.org/protobuf v1.26.0
+	gopkg.in/yaml.v2 v2.4.0
 )
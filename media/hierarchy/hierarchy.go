@@ -0,0 +1,224 @@
+// Package hierarchy builds and validates the entity -> series -> video hierarchy
+// of media contents, e.g. a TV show -> its seasons -> the episodes of each season
+package hierarchy
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/byteplus-sdk/sdk-go/core"
+	"github.com/byteplus-sdk/sdk-go/media/protocol"
+	"google.golang.org/protobuf/proto"
+)
+
+const contentTypeVideo = "video"
+
+// Entity is a TV show with multiple seasons
+type Entity struct {
+	Id   string
+	Name string
+	// Optional, the content record of the entity itself
+	Content *protocol.Content
+	// In order of series_index
+	Series []*Series
+}
+
+// Series is a season of the entity
+type Series struct {
+	Id   string
+	Name string
+	// Optional, the content record of the series itself
+	Content *protocol.Content
+	// In order of video_index
+	Videos []*Video
+}
+
+// Video is an episode of the series
+type Video struct {
+	Id   string
+	Name string
+	// Required, the content record of the video
+	Content *protocol.Content
+}
+
+// Build returns the content records of the entity, its series and their videos,
+// in which the hierarchy fields are filled by the tree: `entity_id`, `series_id`,
+// `video_id` and their names, the 1-based `series_index` and `video_index` by the
+// order in tree, and `series_count` and `video_count`. The `content_id` is the id
+// of the node if it's empty. The contents in tree are not modified
+func Build(entity *Entity) []*protocol.Content {
+	var contents []*protocol.Content
+	if entity.Content != nil {
+		content := newContent(entity.Content, entity.Id)
+		setEntity(content, entity)
+		contents = append(contents, content)
+	}
+	for i, series := range entity.Series {
+		if series.Content != nil {
+			content := newContent(series.Content, series.Id)
+			setEntity(content, entity)
+			setSeries(content, series, i, len(entity.Series))
+			contents = append(contents, content)
+		}
+		for j, video := range series.Videos {
+			if video.Content == nil {
+				continue
+			}
+			content := newContent(video.Content, video.Id)
+			setEntity(content, entity)
+			setSeries(content, series, i, len(entity.Series))
+			content.VideoId = video.Id
+			content.VideoName = video.Name
+			content.VideoIndex = int32(j + 1)
+			content.VideoCount = int32(len(series.Videos))
+			contents = append(contents, content)
+		}
+	}
+	return contents
+}
+
+func newContent(content *protocol.Content, id string) *protocol.Content {
+	result := proto.Clone(content).(*protocol.Content)
+	if result.ContentId == "" {
+		result.ContentId = id
+	}
+	return result
+}
+
+func setEntity(content *protocol.Content, entity *Entity) {
+	content.EntityId = entity.Id
+	content.EntityName = entity.Name
+}
+
+func setSeries(content *protocol.Content, series *Series, index int, count int) {
+	content.SeriesId = series.Id
+	content.SeriesName = series.Name
+	content.SeriesIndex = int32(index + 1)
+	content.SeriesCount = int32(count)
+}
+
+// Validate checks the hierarchy and copyright of contents. For each content, the
+// indexes should be within the counts, a series should belong to an entity, a video
+// should have its duration, and copyright should start before it ends. The contents
+// of the same series should agree on its entity, index and count, and no two series
+// of an entity or videos of a series have the same index. Contents still
+// recommendable after copyright ended are reported as warnings
+func Validate(contents []*protocol.Content, now time.Time) []*core.ValidationIssue {
+	checker := &checker{
+		series:       make(map[string]*protocol.Content),
+		seriesIndex:  make(map[string]string),
+		videoIndex:   make(map[string]string),
+		videoCounter: make(map[string]*protocol.Content),
+	}
+	for i, content := range contents {
+		checker.index = i
+		checker.checkContent(content, now)
+		checker.checkSeries(content)
+		checker.checkVideo(content)
+	}
+	return checker.issues
+}
+
+type checker struct {
+	index  int
+	issues []*core.ValidationIssue
+	// series id -> the first content of the series
+	series map[string]*protocol.Content
+	// entity id and series index -> series id
+	seriesIndex map[string]string
+	// series id and video index -> video id
+	videoIndex map[string]string
+	// series id -> the first video of the series
+	videoCounter map[string]*protocol.Content
+}
+
+func (receiver *checker) add(field string, warning bool, format string, args ...interface{}) {
+	receiver.issues = append(receiver.issues, &core.ValidationIssue{
+		Index:   receiver.index,
+		Field:   field,
+		Message: fmt.Sprintf(format, args...),
+		Warning: warning,
+	})
+}
+
+func (receiver *checker) checkContent(content *protocol.Content, now time.Time) {
+	if content.GetContentId() == "" {
+		receiver.add("content_id", false, "is empty")
+	}
+	if content.GetSeriesId() != "" && content.GetEntityId() == "" {
+		receiver.add("entity_id", false, "is empty for series %s", content.GetSeriesId())
+	}
+	if content.GetSeriesCount() > 0 && (content.GetSeriesIndex() < 1 || content.GetSeriesIndex() > content.GetSeriesCount()) {
+		receiver.add("series_index", false, "%d is out of series_count %d", content.GetSeriesIndex(), content.GetSeriesCount())
+	}
+	if content.GetVideoCount() > 0 && (content.GetVideoIndex() < 1 || content.GetVideoIndex() > content.GetVideoCount()) {
+		receiver.add("video_index", false, "%d is out of video_count %d", content.GetVideoIndex(), content.GetVideoCount())
+	}
+	if content.GetContentType() == contentTypeVideo && content.GetVideoDuration() <= 0 {
+		receiver.add("video_duration", false, "is empty for video")
+	}
+	start, end := content.GetCopyrightStartTimestamp(), content.GetCopyrightEndTimestamp()
+	if start > 0 && end > 0 && start > end {
+		receiver.add("copyright_start_timestamp", false, "%d is after copyright_end_timestamp %d", start, end)
+	}
+	if end > 0 && end < now.Unix() && content.GetIsRecommendable() == 1 {
+		receiver.add("is_recommendable", true, "is 1 but copyright ended at %d", end)
+	}
+}
+
+func (receiver *checker) checkSeries(content *protocol.Content) {
+	seriesId := content.GetSeriesId()
+	if seriesId == "" {
+		return
+	}
+	first, exist := receiver.series[seriesId]
+	if !exist {
+		receiver.series[seriesId] = content
+		if content.GetSeriesIndex() <= 0 {
+			return
+		}
+		key := fmt.Sprintf("%s:%d", content.GetEntityId(), content.GetSeriesIndex())
+		if other, exist := receiver.seriesIndex[key]; exist {
+			receiver.add("series_index", false, "%d of entity %s is used by both series %s and %s",
+				content.GetSeriesIndex(), content.GetEntityId(), other, seriesId)
+			return
+		}
+		receiver.seriesIndex[key] = seriesId
+		return
+	}
+	if first.GetEntityId() != content.GetEntityId() {
+		receiver.add("entity_id", false, "%s of series %s is different from %s of the other content",
+			content.GetEntityId(), seriesId, first.GetEntityId())
+	}
+	if first.GetSeriesIndex() != content.GetSeriesIndex() {
+		receiver.add("series_index", false, "%d of series %s is different from %d of the other content",
+			content.GetSeriesIndex(), seriesId, first.GetSeriesIndex())
+	}
+	if first.GetSeriesCount() != content.GetSeriesCount() {
+		receiver.add("series_count", false, "%d of series %s is different from %d of the other content",
+			content.GetSeriesCount(), seriesId, first.GetSeriesCount())
+	}
+}
+
+func (receiver *checker) checkVideo(content *protocol.Content) {
+	seriesId, videoId := content.GetSeriesId(), content.GetVideoId()
+	if seriesId == "" || videoId == "" {
+		return
+	}
+	if first, exist := receiver.videoCounter[seriesId]; !exist {
+		receiver.videoCounter[seriesId] = content
+	} else if first.GetVideoCount() != content.GetVideoCount() {
+		receiver.add("video_count", false, "%d of series %s is different from %d of video %s",
+			content.GetVideoCount(), seriesId, first.GetVideoCount(), first.GetVideoId())
+	}
+	if content.GetVideoIndex() <= 0 {
+		return
+	}
+	key := fmt.Sprintf("%s:%d", seriesId, content.GetVideoIndex())
+	if other, exist := receiver.videoIndex[key]; exist && other != videoId {
+		receiver.add("video_index", false, "%d of series %s is used by both video %s and %s",
+			content.GetVideoIndex(), seriesId, other, videoId)
+		return
+	}
+	receiver.videoIndex[key] = videoId
+}
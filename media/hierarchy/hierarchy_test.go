@@ -0,0 +1,99 @@
+package hierarchy
+
+import (
+	"testing"
+	"time"
+
+	"github.com/byteplus-sdk/sdk-go/media/protocol"
+)
+
+func TestBuild(t *testing.T) {
+	video := func() *protocol.Content {
+		return &protocol.Content{ContentType: contentTypeVideo, VideoDuration: 1000}
+	}
+	entity := &Entity{
+		Id:      "show",
+		Name:    "Show",
+		Content: &protocol.Content{},
+		Series: []*Series{
+			{Id: "s1", Content: &protocol.Content{}, Videos: []*Video{{Id: "v1", Content: video()}, {Id: "v2", Content: video()}}},
+			{Id: "s2", Videos: []*Video{{Id: "v3", Content: video()}}},
+		},
+	}
+	contents := Build(entity)
+	if len(contents) != 5 {
+		t.Fatalf("Build() returns %d contents, want 5", len(contents))
+	}
+	v2 := contents[3]
+	if v2.ContentId != "v2" || v2.EntityId != "show" || v2.SeriesId != "s1" || v2.SeriesIndex != 1 ||
+		v2.SeriesCount != 2 || v2.VideoIndex != 2 || v2.VideoCount != 2 {
+		t.Errorf("Build() video = %v", v2)
+	}
+	if v3 := contents[4]; v3.SeriesIndex != 2 || v3.VideoIndex != 1 || v3.VideoCount != 1 {
+		t.Errorf("Build() video = %v", v3)
+	}
+	if entity.Series[0].Videos[0].Content.ContentId != "" {
+		t.Errorf("Build() should not modify the contents in tree")
+	}
+	if issues := Validate(contents, time.Now()); len(issues) != 0 {
+		t.Errorf("Validate() of built contents = %v", issues)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	now := time.Unix(1640000000, 0)
+	tests := []struct {
+		name        string
+		contents    []*protocol.Content
+		wantField   string
+		wantWarning bool
+	}{
+		{
+			name:      "index_out_of_count",
+			contents:  []*protocol.Content{{ContentId: "v1", VideoId: "v1", VideoIndex: 3, VideoCount: 2}},
+			wantField: "video_index",
+		},
+		{
+			name:      "series_without_entity",
+			contents:  []*protocol.Content{{ContentId: "s1", SeriesId: "s1"}},
+			wantField: "entity_id",
+		},
+		{
+			name: "inconsistent_series_count",
+			contents: []*protocol.Content{
+				{ContentId: "s1", EntityId: "e", SeriesId: "s1", SeriesIndex: 1, SeriesCount: 2},
+				{ContentId: "v1", EntityId: "e", SeriesId: "s1", SeriesIndex: 1, SeriesCount: 3, VideoId: "v1"},
+			},
+			wantField: "series_count",
+		},
+		{
+			name: "duplicated_video_index",
+			contents: []*protocol.Content{
+				{ContentId: "v1", EntityId: "e", SeriesId: "s1", VideoId: "v1", VideoIndex: 1, VideoCount: 2},
+				{ContentId: "v2", EntityId: "e", SeriesId: "s1", VideoId: "v2", VideoIndex: 1, VideoCount: 2},
+			},
+			wantField: "video_index",
+		},
+		{
+			name:      "copyright_start_after_end",
+			contents:  []*protocol.Content{{ContentId: "c", CopyrightStartTimestamp: 200, CopyrightEndTimestamp: 100}},
+			wantField: "copyright_start_timestamp",
+		},
+		{
+			name: "recommendable_after_copyright",
+			contents: []*protocol.Content{
+				{ContentId: "c", IsRecommendable: 1, CopyrightEndTimestamp: now.Unix() - 1},
+			},
+			wantField:   "is_recommendable",
+			wantWarning: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			issues := Validate(tt.contents, now)
+			if len(issues) != 1 || issues[0].Field != tt.wantField || issues[0].Warning != tt.wantWarning {
+				t.Errorf("Validate() = %v, want an issue of %s", issues, tt.wantField)
+			}
+		})
+	}
+}
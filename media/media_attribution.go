@@ -0,0 +1,58 @@
+package media
+
+import (
+	"github.com/byteplus-sdk/sdk-go/core"
+	"github.com/byteplus-sdk/sdk-go/media/protocol"
+)
+
+// trackPredict remembers the contents of the successful predict response,
+// fallback responses are not tracked as they are not recommended by BytePlus
+func trackPredict(tracker *core.AttributionTracker, request *protocol.PredictRequest,
+	scene string, response *protocol.PredictResponse) {
+	if tracker == nil || response.GetStatus().GetCode() != core.StatusCodeSuccess {
+		return
+	}
+	if _, fallback := response.GetValue().GetExtra()[core.PredictFallbackExtraKey]; fallback {
+		return
+	}
+	if name := request.GetScene().GetSceneName(); name != "" {
+		scene = name
+	}
+	for _, content := range response.GetValue().GetResponseContents() {
+		tracker.Track(request.GetUserId(), content.GetContentId(), &core.Attribution{
+			RequestId:  response.GetRequestId(),
+			RecInfo:    content.GetRecInfo(),
+			SceneName:  scene,
+			PageNumber: request.GetScene().GetPageNumber(),
+			Offset:     content.GetRank(),
+		})
+	}
+}
+
+// attributeUserEvents fills the empty request_id, rec_info and scene
+// of the events whose content was shown to the user by Predict.
+// The events are modified in place, so the request of the caller carries the
+// filled fields after the call. The traffic_source is left to the caller, as
+// the event may not be led by the recommendation even if the item was shown
+func attributeUserEvents(tracker *core.AttributionTracker, userEvents []*protocol.UserEvent) {
+	if tracker == nil {
+		return
+	}
+	for _, userEvent := range userEvents {
+		attribution := tracker.Lookup(userEvent.GetUserId(), userEvent.GetContentId())
+		if attribution == nil {
+			continue
+		}
+		if userEvent.RequestId == "" {
+			userEvent.RequestId = attribution.RequestId
+		}
+		if userEvent.RecInfo == "" {
+			userEvent.RecInfo = attribution.RecInfo
+		}
+		if userEvent.SceneName == "" {
+			userEvent.SceneName = attribution.SceneName
+			userEvent.PageNumber = attribution.PageNumber
+			userEvent.Offset = attribution.Offset
+		}
+	}
+}
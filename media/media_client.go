@@ -2,6 +2,8 @@ package media
 
 import (
 	"github.com/byteplus-sdk/sdk-go/common"
+	commonprotocol "github.com/byteplus-sdk/sdk-go/common/protocol"
+	"github.com/byteplus-sdk/sdk-go/core"
 	"github.com/byteplus-sdk/sdk-go/core/option"
 	"github.com/byteplus-sdk/sdk-go/media/protocol"
 )
@@ -17,6 +19,22 @@ type Client interface {
 	WriteUsers(request *protocol.WriteUsersRequest,
 		opts ...option.Option) (*protocol.WriteUsersResponse, error)
 
+	// ImportUsers
+	//
+	// Bulk import of Users.
+	//
+	// `Operation.response` is of type ImportUsersResponse. Note that it is
+	// possible for a subset of the items to be successfully inserted.
+	// Operation.metadata is of type Metadata.
+	// This call returns immediately after the server finishes the
+	// preliminary validations and persists the request. The caller should
+	// keep polling `OperationResponse.operation.name` using `GetOperation`
+	// call below to check the status.
+	// Note: This can also be used to update the existing data by providing the
+	// existing ids. In this case, please make sure you provide all fields.
+	ImportUsers(request *protocol.ImportUsersRequest,
+		opts ...option.Option) (*commonprotocol.OperationResponse, error)
+
 	// WriteContents
 	//
 	// Writes at most 2000 contents at a time. Exceeding 2000 in a request protocol.protocol.results
@@ -28,6 +46,22 @@ type Client interface {
 	WriteContents(request *protocol.WriteContentsRequest,
 		opts ...option.Option) (*protocol.WriteContentsResponse, error)
 
+	// ImportContents
+	//
+	// Bulk import of Contents.
+	//
+	// `Operation.response` is of type ImportContentsResponse. Note that it is
+	// possible for a subset of the items to be successfully inserted.
+	// Operation.metadata is of type Metadata.
+	// This call returns immediately after the server finishes the preliminary
+	// validations and persists the request.  The caller should keep polling
+	// `OperationResponse.operation.name` using `GetOperation` call below to
+	// check the status.
+	// Note: This can also be used to update the existing data by providing the
+	// existing ids. In this case, please make sure you provide all fields.
+	ImportContents(request *protocol.ImportContentsRequest,
+		opts ...option.Option) (*commonprotocol.OperationResponse, error)
+
 	// WriteUserEvents
 	//
 	// Writes at most 2000 UserEvents at a time. Exceeding 2000 in a request
@@ -35,9 +69,26 @@ type Client interface {
 	// UserEvents.  Note: This is processing realtime data, so we won't dedupe
 	// the requests.
 	// Please make sure the requests are deduplicated before sending over.
+	// When AttributionConfig is set, the empty attribution fields of the events
+	// are filled from the recent Predict results, modifying the request in place.
 	WriteUserEvents(request *protocol.WriteUserEventsRequest,
 		opts ...option.Option) (*protocol.WriteUserEventsResponse, error)
 
+	// ImportUserEvents
+	//
+	// Bulk import of User events.
+	//
+	// `Operation.response` is of type ImportUserEventsResponse. Note that it is
+	// possible for a subset of the items to be successfully inserted.
+	// Operation.metadata is of type Metadata.
+	// This call returns immediately after the server finishes the preliminary
+	// validations and persists the request.  The caller should keep polling
+	// `OperationResponse.operation.name` using `GetOperation` call below to
+	// check the status.
+	// Please make sure the requests are deduplicated before sending over.
+	ImportUserEvents(request *protocol.ImportUserEventsRequest,
+		opts ...option.Option) (*commonprotocol.OperationResponse, error)
+
 	// Predict
 	//
 	// Gets the list of contents (ranked).
@@ -71,4 +122,11 @@ type Client interface {
 
 	// Release resources
 	Release()
+
+	// HealthSnapshot
+	//
+	// Returns the health state of every host observed by the sdk, including
+	// the failure rate in window, recent ping latencies, the last ping error
+	// and the available hosts, which can be used to show the routing state.
+	HealthSnapshot() *core.HealthSnapshot
 }
@@ -60,6 +60,49 @@ func (receiver *ClientBuilder) HostAvailablerConfig(hostAvailablerConfig *core.H
 	return receiver
 }
 
+func (receiver *ClientBuilder) HedgeConfig(hedgeConfig *core.HedgeConfig) *ClientBuilder {
+	receiver.param.HedgeConfig = hedgeConfig
+	return receiver
+}
+
+func (receiver *ClientBuilder) CircuitBreakerConfig(circuitBreakerConfig *core.CircuitBreakerConfig) *ClientBuilder {
+	receiver.param.CircuitBreakerConfig = circuitBreakerConfig
+	return receiver
+}
+
+func (receiver *ClientBuilder) RateLimitConfig(rateLimitConfig *core.RateLimitConfig) *ClientBuilder {
+	receiver.param.RateLimitConfig = rateLimitConfig
+	return receiver
+}
+
+func (receiver *ClientBuilder) StrictStatusConfig(strictStatusConfig *core.StrictStatusConfig) *ClientBuilder {
+	receiver.param.StrictStatusConfig = strictStatusConfig
+	return receiver
+}
+
+func (receiver *ClientBuilder) PredictCacheConfig(predictCacheConfig *core.PredictCacheConfig) *ClientBuilder {
+	receiver.param.PredictCacheConfig = predictCacheConfig
+	return receiver
+}
+
+func (receiver *ClientBuilder) PredictFallbackConfig(predictFallbackConfig *core.PredictFallbackConfig) *ClientBuilder {
+	receiver.param.PredictFallbackConfig = predictFallbackConfig
+	return receiver
+}
+
+func (receiver *ClientBuilder) AttributionConfig(attributionConfig *core.AttributionConfig) *ClientBuilder {
+	receiver.param.AttributionConfig = attributionConfig
+	return receiver
+}
+
+// DeadLetterSink sets the sink of the items failed permanently in WriteUsers, WriteContents
+// and WriteUserEvents or their WithRetry helpers, so that they can be replayed
+// by ReplayDeadLetters
+func (receiver *ClientBuilder) DeadLetterSink(deadLetterSink core.DeadLetterSink) *ClientBuilder {
+	receiver.param.DeadLetterSink = deadLetterSink
+	return receiver
+}
+
 func (receiver *ClientBuilder) Build() (Client, error) {
 	receiver.param.UseAirAuth = true
 	context, err := core.NewContext(&receiver.param)
@@ -71,10 +114,14 @@ func (receiver *ClientBuilder) Build() (Client, error) {
 	hostAvailabler := core.NewHostAvailabler(mu, context)
 	metrics.Collector.Init(context.MetricsConfig(), hostAvailabler)
 	client := &clientImpl{
-		Client:  common.NewClient(httpCaller, mu.cu),
-		hCaller: httpCaller,
-		mu:      mu,
-		hostAva: hostAvailabler,
+		Client:    common.NewClient(httpCaller, mu.cu),
+		hCaller:   httpCaller,
+		mu:        mu,
+		hostAva:   hostAvailabler,
+		pCache:    core.NewPredictCache(context.PredictCacheConfig()),
+		pFallback: core.NewPredictFallback(context.PredictFallbackConfig()),
+		tracker:   core.NewAttributionTracker(context.AttributionConfig()),
+		dlSink:    context.DeadLetterSink(),
 	}
 	return client, nil
 }
@@ -4,24 +4,35 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/byteplus-sdk/sdk-go/common"
+	commonprotocol "github.com/byteplus-sdk/sdk-go/common/protocol"
 	"github.com/byteplus-sdk/sdk-go/core"
 	"github.com/byteplus-sdk/sdk-go/core/logs"
 	"github.com/byteplus-sdk/sdk-go/core/option"
+	"github.com/byteplus-sdk/sdk-go/media/hierarchy"
 	"github.com/byteplus-sdk/sdk-go/media/protocol"
+	"google.golang.org/protobuf/proto"
 )
 
 var (
 	writeMsgFormat  = "Only can receive max to %d items in one write request"
 	writeTooManyErr = errors.New(fmt.Sprintf(writeMsgFormat, core.MaxWriteItemCount))
+
+	importMsgFormat  = "Only can receive max to %d items in one import request"
+	importTooManyErr = errors.New(fmt.Sprintf(importMsgFormat, core.MaxImportItemCount))
 )
 
 type clientImpl struct {
 	common.Client
-	hCaller *core.HTTPCaller
-	mu      *mediaURL
-	hostAva *core.HostAvailabler
+	hCaller   *core.HTTPCaller
+	mu        *mediaURL
+	hostAva   *core.HostAvailabler
+	pCache    *core.PredictCache
+	pFallback *core.PredictFallback
+	tracker   *core.AttributionTracker
+	dlSink    core.DeadLetterSink
 }
 
 func (c clientImpl) WriteUsers(request *protocol.WriteUsersRequest,
@@ -29,9 +40,14 @@ func (c clientImpl) WriteUsers(request *protocol.WriteUsersRequest,
 	if len(request.Users) > core.MaxWriteItemCount {
 		return nil, writeTooManyErr
 	}
+	options := option.Conv2Options(opts...)
 	url := c.mu.writeUsersURL
 	response := &protocol.WriteUsersResponse{}
-	err := c.hCaller.DoPBRequest(url, request, response, option.Conv2Options(opts...))
+	core.PrepareDeadLetters(c.dlSink, options)
+	err := c.hCaller.DoPBRequest(url, request, response, options)
+	core.WriteDeadLetters(c.dlSink, options, func() []interface{} {
+		return usersItems(request.Users)
+	}, response, err, usersFailedItems)
 	if err != nil {
 		return nil, err
 	}
@@ -39,14 +55,42 @@ func (c clientImpl) WriteUsers(request *protocol.WriteUsersRequest,
 	return response, nil
 }
 
+func (c clientImpl) ImportUsers(request *protocol.ImportUsersRequest,
+	opts ...option.Option) (*commonprotocol.OperationResponse, error) {
+	users := request.GetInputConfig().GetUsersInlineSource().GetUsers()
+	if len(users) > core.MaxImportItemCount {
+		return nil, importTooManyErr
+	}
+	options := option.Conv2Options(opts...)
+	core.FillDateOptions(options, request.GetDateConfig().GetDate(), request.GetDateConfig().GetIsEnd())
+	url := c.mu.importUsersURL
+	response := &commonprotocol.OperationResponse{}
+	err := c.hCaller.DoPBRequest(url, request, response, options)
+	if err != nil {
+		return nil, err
+	}
+	logs.Debug("[ImportUsers] rsp:\n%s\n", response)
+	return response, nil
+}
+
 func (c clientImpl) WriteContents(request *protocol.WriteContentsRequest,
 	opts ...option.Option) (*protocol.WriteContentsResponse, error) {
 	if len(request.Contents) > core.MaxWriteItemCount {
 		return nil, writeTooManyErr
 	}
+	options := option.Conv2Options(opts...)
+	if options.Validate {
+		if err := core.CheckValidationIssues(hierarchy.Validate(request.Contents, time.Now())); err != nil {
+			return nil, err
+		}
+	}
 	url := c.mu.writeContentsURL
 	response := &protocol.WriteContentsResponse{}
-	err := c.hCaller.DoPBRequest(url, request, response, option.Conv2Options(opts...))
+	core.PrepareDeadLetters(c.dlSink, options)
+	err := c.hCaller.DoPBRequest(url, request, response, options)
+	core.WriteDeadLetters(c.dlSink, options, func() []interface{} {
+		return contentsItems(request.Contents)
+	}, response, err, contentsFailedItems)
 	if err != nil {
 		return nil, err
 	}
@@ -54,14 +98,38 @@ func (c clientImpl) WriteContents(request *protocol.WriteContentsRequest,
 	return response, nil
 }
 
+func (c clientImpl) ImportContents(request *protocol.ImportContentsRequest,
+	opts ...option.Option) (*commonprotocol.OperationResponse, error) {
+	contents := request.GetInputConfig().GetContentsInlineSource().GetContents()
+	if len(contents) > core.MaxImportItemCount {
+		return nil, importTooManyErr
+	}
+	options := option.Conv2Options(opts...)
+	core.FillDateOptions(options, request.GetDateConfig().GetDate(), request.GetDateConfig().GetIsEnd())
+	url := c.mu.importContentsURL
+	response := &commonprotocol.OperationResponse{}
+	err := c.hCaller.DoPBRequest(url, request, response, options)
+	if err != nil {
+		return nil, err
+	}
+	logs.Debug("[ImportContents] rsp:\n%s\n", response)
+	return response, nil
+}
+
 func (c clientImpl) WriteUserEvents(request *protocol.WriteUserEventsRequest,
 	opts ...option.Option) (*protocol.WriteUserEventsResponse, error) {
 	if len(request.UserEvents) > core.MaxWriteItemCount {
 		return nil, writeTooManyErr
 	}
+	attributeUserEvents(c.tracker, request.UserEvents)
+	options := option.Conv2Options(opts...)
 	url := c.mu.writeUserEventsURL
 	response := &protocol.WriteUserEventsResponse{}
-	err := c.hCaller.DoPBRequest(url, request, response, option.Conv2Options(opts...))
+	core.PrepareDeadLetters(c.dlSink, options)
+	err := c.hCaller.DoPBRequest(url, request, response, options)
+	core.WriteDeadLetters(c.dlSink, options, func() []interface{} {
+		return userEventsItems(request.UserEvents)
+	}, response, err, userEventsFailedItems)
 	if err != nil {
 		return nil, err
 	}
@@ -69,18 +137,56 @@ func (c clientImpl) WriteUserEvents(request *protocol.WriteUserEventsRequest,
 	return response, nil
 }
 
+func (c clientImpl) ImportUserEvents(request *protocol.ImportUserEventsRequest,
+	opts ...option.Option) (*commonprotocol.OperationResponse, error) {
+	userEvents := request.GetInputConfig().GetUserEventsInlineSource().GetUserEvents()
+	if len(userEvents) > core.MaxImportItemCount {
+		return nil, importTooManyErr
+	}
+	options := option.Conv2Options(opts...)
+	core.FillDateOptions(options, request.GetDateConfig().GetDate(), request.GetDateConfig().GetIsEnd())
+	url := c.mu.importUserEventsURL
+	response := &commonprotocol.OperationResponse{}
+	err := c.hCaller.DoPBRequest(url, request, response, options)
+	if err != nil {
+		return nil, err
+	}
+	logs.Debug("[ImportUserEvents] rsp:\n%s\n", response)
+	return response, nil
+}
+
 func (c *clientImpl) Predict(request *protocol.PredictRequest, scene string,
 	opts ...option.Option) (*protocol.PredictResponse, error) {
 	url := strings.ReplaceAll(c.mu.predictURLFormat, "{}", scene)
 	response := &protocol.PredictResponse{}
-	err := c.hCaller.DoPBRequest(url, request, response, option.Conv2Options(opts...))
+	options := option.Conv2Options(opts...)
+	err := c.pCache.Load(request, scene, options, response,
+		func(options *option.Options, response proto.Message) error {
+			return c.hCaller.DoHedgedPBRequest(url, request, response, options)
+		})
+	err = c.pFallback.Apply(request, scene, response, err)
 	if err != nil {
 		return nil, err
 	}
+	trackPredict(c.tracker, request, scene, response)
 	logs.Debug("[Predict] rsp:\n%s\n", response)
 	return response, nil
 }
 
+// predictShadow sends the secondary request of shadowPredictClient, which neither
+// reads nor changes the state of the primary requests, i.e. the predict cache,
+// fallback and attribution tracking
+func (c *clientImpl) predictShadow(request *protocol.PredictRequest, scene string,
+	opts ...option.Option) (*protocol.PredictResponse, error) {
+	url := strings.ReplaceAll(c.mu.predictURLFormat, "{}", scene)
+	response := &protocol.PredictResponse{}
+	err := c.hCaller.DoHedgedPBRequest(url, request, response, option.Conv2Options(opts...))
+	if err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
 func (c *clientImpl) AckServerImpressions(request *protocol.AckServerImpressionsRequest,
 	opts ...option.Option) (*protocol.AckServerImpressionsResponse, error) {
 	url := c.mu.ackImpressionURL
@@ -96,3 +202,7 @@ func (c *clientImpl) AckServerImpressions(request *protocol.AckServerImpressions
 func (c clientImpl) Release() {
 	c.hostAva.Shutdown()
 }
+
+func (c clientImpl) HealthSnapshot() *core.HealthSnapshot {
+	return c.hostAva.HealthSnapshot()
+}
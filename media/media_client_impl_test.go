@@ -0,0 +1,192 @@
+package media
+
+import (
+	"compress/gzip"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	commonprotocol "github.com/byteplus-sdk/sdk-go/common/protocol"
+	"github.com/byteplus-sdk/sdk-go/core"
+	"github.com/byteplus-sdk/sdk-go/media/protocol"
+	"google.golang.org/protobuf/proto"
+)
+
+// stubRequest is a request received by stubServer, whose body is decompressed
+type stubRequest struct {
+	url    string
+	header http.Header
+	body   []byte
+}
+
+// stubServer responds the requests with the message returned by respond
+type stubServer struct {
+	*httptest.Server
+	lock     sync.Mutex
+	requests []*stubRequest
+}
+
+func newStubServer(t *testing.T, respond func(request *stubRequest) proto.Message) *stubServer {
+	server := &stubServer{}
+	server.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		reader, err := gzip.NewReader(r.Body)
+		if err != nil {
+			t.Errorf("request body is not gzipped, err:%v", err)
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		body, _ := ioutil.ReadAll(reader)
+		request := &stubRequest{url: r.URL.String(), header: r.Header, body: body}
+		server.lock.Lock()
+		server.requests = append(server.requests, request)
+		server.lock.Unlock()
+		rspBytes, _ := proto.Marshal(respond(request))
+		_, _ = w.Write(rspBytes)
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func (s *stubServer) received() []*stubRequest {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return append([]*stubRequest(nil), s.requests...)
+}
+
+func newStubClient(t *testing.T, server *stubServer) *clientImpl {
+	client, err := (&ClientBuilder{}).
+		Tenant("media_demo").
+		TenantId("tenant_id").
+		Token("token").
+		Region(core.RegionSg).
+		Schema("http").
+		Hosts([]string{strings.TrimPrefix(server.URL, "http://")}).
+		Build()
+	if err != nil {
+		t.Fatalf("Build() error = %v", err)
+	}
+	t.Cleanup(client.Release)
+	return client.(*clientImpl)
+}
+
+func TestClientImpl_Import(t *testing.T) {
+	server := newStubServer(t, func(request *stubRequest) proto.Message {
+		return &commonprotocol.OperationResponse{Status: &commonprotocol.Status{Code: 0},
+			Operation: &commonprotocol.Operation{Name: "op"}}
+	})
+	client := newStubClient(t, server)
+	dateConfig := &protocol.DateConfig{Date: "2021-06-10", IsEnd: true}
+	tests := []struct {
+		name     string
+		doImport func() (*commonprotocol.OperationResponse, error)
+		wantURL  string
+	}{
+		{
+			name: "users",
+			doImport: func() (*commonprotocol.OperationResponse, error) {
+				return client.ImportUsers(&protocol.ImportUsersRequest{
+					InputConfig: &protocol.UsersInputConfig{Source: &protocol.UsersInputConfig_UsersInlineSource{
+						UsersInlineSource: &protocol.UsersInlineSource{Users: []*protocol.User{{UserId: "u1"}}}}},
+					DateConfig: dateConfig,
+				})
+			},
+			wantURL: "/data/api/media/media_demo/user?method=import",
+		},
+		{
+			name: "contents",
+			doImport: func() (*commonprotocol.OperationResponse, error) {
+				return client.ImportContents(&protocol.ImportContentsRequest{
+					InputConfig: &protocol.ContentsInputConfig{Source: &protocol.ContentsInputConfig_ContentsInlineSource{
+						ContentsInlineSource: &protocol.ContentsInlineSource{
+							Contents: []*protocol.Content{{ContentId: "c1"}}}}},
+					DateConfig: dateConfig,
+				})
+			},
+			wantURL: "/data/api/media/media_demo/content?method=import",
+		},
+		{
+			name: "user_events",
+			doImport: func() (*commonprotocol.OperationResponse, error) {
+				return client.ImportUserEvents(&protocol.ImportUserEventsRequest{
+					InputConfig: &protocol.UserEventsInputConfig{Source: &protocol.UserEventsInputConfig_UserEventsInlineSource{
+						UserEventsInlineSource: &protocol.UserEventsInlineSource{
+							UserEvents: []*protocol.UserEvent{{UserId: "u1"}}}}},
+					DateConfig: dateConfig,
+				})
+			},
+			wantURL: "/data/api/media/media_demo/user_event?method=import",
+		},
+	}
+	date, _ := time.ParseInLocation("2006-01-02", "2021-06-10", time.Local)
+	for i, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			response, err := tt.doImport()
+			if err != nil || response.GetOperation().GetName() != "op" {
+				t.Fatalf("import = %v, %v", response, err)
+			}
+			request := server.received()[i]
+			if request.url != tt.wantURL {
+				t.Errorf("url = %s, want %s", request.url, tt.wantURL)
+			}
+			if got := request.header.Get("Content-Date"); got != date.Format(time.RFC3339) {
+				t.Errorf("Content-Date = %s, want %s", got, date.Format(time.RFC3339))
+			}
+			if got := request.header.Get("Content-End"); got != "true" {
+				t.Errorf("Content-End = %s, want true", got)
+			}
+		})
+	}
+}
+
+func TestClientImpl_Import_tooManyItems(t *testing.T) {
+	server := newStubServer(t, func(request *stubRequest) proto.Message {
+		return &commonprotocol.OperationResponse{Status: &commonprotocol.Status{Code: 0}}
+	})
+	client := newStubClient(t, server)
+	users := make([]*protocol.User, core.MaxImportItemCount+1)
+	contents := make([]*protocol.Content, core.MaxImportItemCount+1)
+	userEvents := make([]*protocol.UserEvent, core.MaxImportItemCount+1)
+	tests := []struct {
+		name     string
+		doImport func() (*commonprotocol.OperationResponse, error)
+	}{
+		{
+			name: "users",
+			doImport: func() (*commonprotocol.OperationResponse, error) {
+				return client.ImportUsers(&protocol.ImportUsersRequest{InputConfig: &protocol.UsersInputConfig{
+					Source: &protocol.UsersInputConfig_UsersInlineSource{
+						UsersInlineSource: &protocol.UsersInlineSource{Users: users}}}})
+			},
+		},
+		{
+			name: "contents",
+			doImport: func() (*commonprotocol.OperationResponse, error) {
+				return client.ImportContents(&protocol.ImportContentsRequest{InputConfig: &protocol.ContentsInputConfig{
+					Source: &protocol.ContentsInputConfig_ContentsInlineSource{
+						ContentsInlineSource: &protocol.ContentsInlineSource{Contents: contents}}}})
+			},
+		},
+		{
+			name: "user_events",
+			doImport: func() (*commonprotocol.OperationResponse, error) {
+				return client.ImportUserEvents(&protocol.ImportUserEventsRequest{InputConfig: &protocol.UserEventsInputConfig{
+					Source: &protocol.UserEventsInputConfig_UserEventsInlineSource{
+						UserEventsInlineSource: &protocol.UserEventsInlineSource{UserEvents: userEvents}}}})
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := tt.doImport(); err != importTooManyErr {
+				t.Errorf("import error = %v, want %v", err, importTooManyErr)
+			}
+		})
+	}
+	if len(server.received()) != 0 {
+		t.Errorf("server received %d requests, want none", len(server.received()))
+	}
+}
@@ -0,0 +1,92 @@
+package media
+
+import (
+	"github.com/byteplus-sdk/sdk-go/common"
+	commonprotocol "github.com/byteplus-sdk/sdk-go/common/protocol"
+	"github.com/byteplus-sdk/sdk-go/core"
+	"github.com/byteplus-sdk/sdk-go/core/option"
+	"github.com/byteplus-sdk/sdk-go/media/protocol"
+	"google.golang.org/protobuf/proto"
+)
+
+// ImportUsersFromReader
+//
+// Reads users from reader, e.g. the RecordReader of csv or jsonl file opened by
+// OpenRecordReader, the fields of record are mapped to User by mapping, then
+// imports them by ImportUsers in batches and waits for the operations
+func ImportUsersFromReader(client Client, reader core.RecordReader, mapping core.FieldMapping,
+	config *common.ImportConfig, opts ...option.Option) (*common.ImportSummary, error) {
+	newUser := func() proto.Message {
+		return &protocol.User{}
+	}
+	importUsers := func(messages []proto.Message, date string, dateEnd bool) (*commonprotocol.OperationResponse, error) {
+		users := make([]*protocol.User, 0, len(messages))
+		for _, message := range messages {
+			users = append(users, message.(*protocol.User))
+		}
+		request := &protocol.ImportUsersRequest{
+			InputConfig: &protocol.UsersInputConfig{
+				Source: &protocol.UsersInputConfig_UsersInlineSource{
+					UsersInlineSource: &protocol.UsersInlineSource{Users: users},
+				},
+			},
+			DateConfig: &protocol.DateConfig{Date: date, IsEnd: dateEnd},
+		}
+		return client.ImportUsers(request, opts...)
+	}
+	return common.ImportMessages(client, reader, mapping, newUser, importUsers, config)
+}
+
+// ImportContentsFromReader
+//
+// Reads contents from reader, the fields of record are mapped to Content by
+// mapping, then imports them by ImportContents in batches and waits for the operations
+func ImportContentsFromReader(client Client, reader core.RecordReader, mapping core.FieldMapping,
+	config *common.ImportConfig, opts ...option.Option) (*common.ImportSummary, error) {
+	newContent := func() proto.Message {
+		return &protocol.Content{}
+	}
+	importContents := func(messages []proto.Message, date string, dateEnd bool) (*commonprotocol.OperationResponse, error) {
+		contents := make([]*protocol.Content, 0, len(messages))
+		for _, message := range messages {
+			contents = append(contents, message.(*protocol.Content))
+		}
+		request := &protocol.ImportContentsRequest{
+			InputConfig: &protocol.ContentsInputConfig{
+				Source: &protocol.ContentsInputConfig_ContentsInlineSource{
+					ContentsInlineSource: &protocol.ContentsInlineSource{Contents: contents},
+				},
+			},
+			DateConfig: &protocol.DateConfig{Date: date, IsEnd: dateEnd},
+		}
+		return client.ImportContents(request, opts...)
+	}
+	return common.ImportMessages(client, reader, mapping, newContent, importContents, config)
+}
+
+// ImportUserEventsFromReader
+//
+// Reads user events from reader, the fields of record are mapped to UserEvent by
+// mapping, then imports them by ImportUserEvents in batches and waits for the operations
+func ImportUserEventsFromReader(client Client, reader core.RecordReader, mapping core.FieldMapping,
+	config *common.ImportConfig, opts ...option.Option) (*common.ImportSummary, error) {
+	newUserEvent := func() proto.Message {
+		return &protocol.UserEvent{}
+	}
+	importUserEvents := func(messages []proto.Message, date string, dateEnd bool) (*commonprotocol.OperationResponse, error) {
+		userEvents := make([]*protocol.UserEvent, 0, len(messages))
+		for _, message := range messages {
+			userEvents = append(userEvents, message.(*protocol.UserEvent))
+		}
+		request := &protocol.ImportUserEventsRequest{
+			InputConfig: &protocol.UserEventsInputConfig{
+				Source: &protocol.UserEventsInputConfig_UserEventsInlineSource{
+					UserEventsInlineSource: &protocol.UserEventsInlineSource{UserEvents: userEvents},
+				},
+			},
+			DateConfig: &protocol.DateConfig{Date: date, IsEnd: dateEnd},
+		}
+		return client.ImportUserEvents(request, opts...)
+	}
+	return common.ImportMessages(client, reader, mapping, newUserEvent, importUserEvents, config)
+}
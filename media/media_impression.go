@@ -0,0 +1,52 @@
+package media
+
+import (
+	"errors"
+
+	"github.com/byteplus-sdk/sdk-go/core"
+	"github.com/byteplus-sdk/sdk-go/media/protocol"
+	"google.golang.org/protobuf/proto"
+)
+
+// BuildAckServerImpressionsRequest builds the request of AckServerImpressions from
+// the predict request, its response and the content ids finally displayed in order.
+// The contents displayed after the last kept one are "filled", and the contents in
+// response but not displayed are "filtered", or the reason in filteredReasons.
+// TrafficSource default is "byteplus".
+// See Client.AckServerImpressions for the format of the request
+func BuildAckServerImpressionsRequest(request *protocol.PredictRequest, response *protocol.PredictResponse,
+	displayedIds []string, trafficSource string, filteredReasons map[string]string) (*protocol.AckServerImpressionsRequest, error) {
+	if response.GetRequestId() == "" {
+		return nil, errors.New("predict request id is empty")
+	}
+	if _, fallback := response.GetValue().GetExtra()[core.PredictFallbackExtraKey]; fallback {
+		return nil, errors.New("predict response is a fallback, which is not recommended by byteplus")
+	}
+	if trafficSource == "" {
+		trafficSource = "byteplus"
+	}
+	contents := response.GetValue().GetResponseContents()
+	predictedIds := make([]string, 0, len(contents))
+	for _, content := range contents {
+		predictedIds = append(predictedIds, content.GetContentId())
+	}
+	alteredItems := core.DiffFilledImpressions(predictedIds, displayedIds, filteredReasons)
+	alteredContents := make([]*protocol.AckServerImpressionsRequest_AlteredContent, 0, len(alteredItems))
+	for _, item := range alteredItems {
+		alteredContents = append(alteredContents, &protocol.AckServerImpressionsRequest_AlteredContent{
+			ContentId:     item.Id,
+			AlteredReason: item.Reason,
+			Rank:          item.Rank,
+		})
+	}
+	ackRequest := &protocol.AckServerImpressionsRequest{
+		PredictRequestId: response.GetRequestId(),
+		UserId:           request.GetUserId(),
+		TrafficSource:    trafficSource,
+		AlteredContents:  alteredContents,
+	}
+	if request.GetScene() != nil {
+		ackRequest.Scene = proto.Clone(request.GetScene()).(*protocol.PredictRequest_Scene)
+	}
+	return ackRequest, nil
+}
@@ -0,0 +1,72 @@
+package media
+
+import (
+	"github.com/byteplus-sdk/sdk-go/core"
+	"github.com/byteplus-sdk/sdk-go/core/option"
+	"github.com/byteplus-sdk/sdk-go/media/protocol"
+	"google.golang.org/protobuf/proto"
+)
+
+// shadowTarget is implemented by clientImpl, whose predictShadow bypasses the
+// predict cache, fallback and attribution tracking of client
+type shadowTarget interface {
+	predictShadow(request *protocol.PredictRequest, scene string, opts ...option.Option) (*protocol.PredictResponse, error)
+}
+
+type shadowPredictClient struct {
+	Client
+	secondary Client
+	shadow    *core.ShadowPredictor
+}
+
+// NewShadowPredictClient returns a Client whose Predict is sent to primary and
+// returns its response, meanwhile a sampled part of the requests are sent to
+// secondary asynchronously, and the contents predicted are compared by
+// core.ShadowPredictor. Secondary can be another client, e.g. of another tenant or
+// hosts, or nil to use primary with core.ShadowPredictConfig.Options, e.g.
+// option.WithStage, whose predict cache, fallback and attribution are bypassed
+// by the secondary requests. Release also releases secondary if it's not primary
+func NewShadowPredictClient(primary Client, secondary Client, config *core.ShadowPredictConfig) Client {
+	if secondary == nil {
+		secondary = primary
+	}
+	return &shadowPredictClient{
+		Client:    primary,
+		secondary: secondary,
+		shadow:    core.NewShadowPredictor(config, predictContentIds),
+	}
+}
+
+func (c *shadowPredictClient) Predict(request *protocol.PredictRequest, scene string,
+	opts ...option.Option) (*protocol.PredictResponse, error) {
+	response, err := c.Client.Predict(request, scene, opts...)
+	if err != nil {
+		return nil, err
+	}
+	c.shadow.Shadow(scene, request, response, func(shadowRequest proto.Message,
+		shadowOpts []option.Option) (proto.Message, error) {
+		// the request id of primary request is not reused
+		secondaryOpts := append(append(opts[:len(opts):len(opts)], shadowOpts...), option.WithRequestId(""))
+		if target, ok := c.secondary.(shadowTarget); ok {
+			return target.predictShadow(shadowRequest.(*protocol.PredictRequest), scene, secondaryOpts...)
+		}
+		return c.secondary.Predict(shadowRequest.(*protocol.PredictRequest), scene, secondaryOpts...)
+	})
+	return response, nil
+}
+
+func (c *shadowPredictClient) Release() {
+	c.Client.Release()
+	if c.secondary != c.Client {
+		c.secondary.Release()
+	}
+}
+
+func predictContentIds(response proto.Message) []string {
+	contents := response.(*protocol.PredictResponse).GetValue().GetResponseContents()
+	ids := make([]string, 0, len(contents))
+	for _, content := range contents {
+		ids = append(ids, content.GetContentId())
+	}
+	return ids
+}
@@ -28,14 +28,23 @@ type mediaURL struct {
 	// The URL of uploading real-time user data
 	// Example: https://tob.sgsnssdk.com/data/api/media/media_demo/user?method=write
 	writeUsersURL string
+	// The URL of importing daily offline user data
+	// Example: https://tob.sgsnssdk.com/data/api/media/media_demo/user?method=import
+	importUsersURL string
 
 	// The URL of uploading real-time content data
 	// Example: https://tob.sgsnssdk.com/data/api/media/media_demo/content?method=write
 	writeContentsURL string
+	// The URL of importing daily offline content data
+	// Example: https://tob.sgsnssdk.com/data/api/media/media_demo/content?method=import
+	importContentsURL string
 
 	// The URL of uploading real-time user event data
 	// Example: https://tob.sgsnssdk.com/data/api/media/media_demo/user_event?method=write
 	writeUserEventsURL string
+	// The URL of importing daily offline user event data
+	// Example: https://tob.sgsnssdk.com/data/api/media/media_demo/user_event?method=import
+	importUserEventsURL string
 
 	// The URL template of "predict" request, which need fill with "scene" info when use
 	// Example: https://tob.sgsnssdk.com/predict/api/media/media_demo/home
@@ -49,8 +58,11 @@ type mediaURL struct {
 func (receiver *mediaURL) Refresh(host string) {
 	receiver.cu.Refresh(host)
 	receiver.writeUsersURL = receiver.generateUploadURL(host, "user", "write")
+	receiver.importUsersURL = receiver.generateUploadURL(host, "user", "import")
 	receiver.writeContentsURL = receiver.generateUploadURL(host, "content", "write")
+	receiver.importContentsURL = receiver.generateUploadURL(host, "content", "import")
 	receiver.writeUserEventsURL = receiver.generateUploadURL(host, "user_event", "write")
+	receiver.importUserEventsURL = receiver.generateUploadURL(host, "user_event", "import")
 	receiver.predictURLFormat = receiver.generatePredictURLFormat(host)
 	receiver.ackImpressionURL = receiver.generateAckURL(host)
 }
@@ -0,0 +1,193 @@
+package media
+
+import (
+	"fmt"
+
+	"github.com/byteplus-sdk/sdk-go/core"
+	"github.com/byteplus-sdk/sdk-go/core/logs"
+	"github.com/byteplus-sdk/sdk-go/core/option"
+	"github.com/byteplus-sdk/sdk-go/media/protocol"
+	"google.golang.org/protobuf/proto"
+)
+
+// WriteUsersWithRetry
+//
+// Writes users by client.WriteUsers, and resubmits only the users failed
+// with retryable errors, the Item of FailedItem in report is *protocol.User
+func WriteUsersWithRetry(client Client, request *protocol.WriteUsersRequest,
+	config *core.PartialRetryConfig, opts ...option.Option) (*core.PartialRetryReport, error) {
+	config = core.RetryConfigWithRequestId(config, opts)
+	return core.RetryPartialFailures(config, usersItems(request.Users),
+		usersWriter(client, request.Extra, opts), usersFailedItems)
+}
+
+// WriteContentsWithRetry
+//
+// Writes contents by client.WriteContents, and resubmits only the contents
+// failed with retryable errors, the Item of FailedItem in report is *protocol.Content
+func WriteContentsWithRetry(client Client, request *protocol.WriteContentsRequest,
+	config *core.PartialRetryConfig, opts ...option.Option) (*core.PartialRetryReport, error) {
+	config = core.RetryConfigWithRequestId(config, opts)
+	return core.RetryPartialFailures(config, contentsItems(request.Contents),
+		contentsWriter(client, request.Extra, opts), contentsFailedItems)
+}
+
+// WriteUserEventsWithRetry
+//
+// Writes user events by client.WriteUserEvents, and resubmits only the user events
+// failed with retryable errors, the Item of FailedItem in report is *protocol.UserEvent
+func WriteUserEventsWithRetry(client Client, request *protocol.WriteUserEventsRequest,
+	config *core.PartialRetryConfig, opts ...option.Option) (*core.PartialRetryReport, error) {
+	config = core.RetryConfigWithRequestId(config, opts)
+	return core.RetryPartialFailures(config, userEventsItems(request.UserEvents),
+		userEventsWriter(client, request.Extra, opts), userEventsFailedItems)
+}
+
+// ReplayDeadLetters
+//
+// Resubmits the users, contents and user events of dead letters by
+// WriteUsers, WriteContents and WriteUserEvents, returns the dead letters
+// still failed, including the ones whose item isn't supported by media
+func ReplayDeadLetters(client Client, letters []*core.DeadLetter,
+	opts ...option.Option) ([]*core.DeadLetter, error) {
+	var users, contents, userEvents, stillFailed []*core.DeadLetter
+	for _, letter := range letters {
+		switch letter.Item.(type) {
+		case *protocol.User:
+			users = append(users, letter)
+		case *protocol.Content:
+			contents = append(contents, letter)
+		case *protocol.UserEvent:
+			userEvents = append(userEvents, letter)
+		default:
+			logs.Warn("[ReplayDeadLetters] unsupported dead letter type:%s", letter.Type)
+			stillFailed = append(stillFailed, letter)
+		}
+	}
+	var lastErr error
+	replay := func(letters []*core.DeadLetter, write core.PartialWriteFunc, failedItems core.FailedItemsFunc) {
+		if len(letters) == 0 {
+			return
+		}
+		failed, err := core.ResubmitDeadLetters(letters, core.RequestIdPrefix(opts), write, failedItems)
+		if err != nil {
+			lastErr = err
+		}
+		stillFailed = append(stillFailed, failed...)
+	}
+	replay(users, usersWriter(client, nil, opts), usersFailedItems)
+	replay(contents, contentsWriter(client, nil, opts), contentsFailedItems)
+	replay(userEvents, userEventsWriter(client, nil, opts), userEventsFailedItems)
+	return stillFailed, lastErr
+}
+
+func usersItems(users []*protocol.User) []interface{} {
+	items := make([]interface{}, 0, len(users))
+	for _, user := range users {
+		items = append(items, user)
+	}
+	return items
+}
+
+func usersWriter(client Client, extra map[string]string, opts []option.Option) core.PartialWriteFunc {
+	return func(items []interface{}, requestId string) (proto.Message, error) {
+		users := make([]*protocol.User, 0, len(items))
+		for _, item := range items {
+			users = append(users, item.(*protocol.User))
+		}
+		request := &protocol.WriteUsersRequest{Users: users, Extra: extra}
+		response, err := client.WriteUsers(request, core.RetryOptions(opts, requestId)...)
+		if err != nil {
+			return nil, err
+		}
+		return response, nil
+	}
+}
+
+func usersFailedItems(items []interface{}, response proto.Message) []*core.FailedItem {
+	matcher := core.NewItemMatcher(items, userKey)
+	var result []*core.FailedItem
+	for _, userErr := range response.(*protocol.WriteUsersResponse).GetErrors() {
+		result = append(result, matcher.Match(userErr.GetUser(), userErr.GetMessage()))
+	}
+	return result
+}
+
+func contentsItems(contents []*protocol.Content) []interface{} {
+	items := make([]interface{}, 0, len(contents))
+	for _, content := range contents {
+		items = append(items, content)
+	}
+	return items
+}
+
+func contentsWriter(client Client, extra map[string]string, opts []option.Option) core.PartialWriteFunc {
+	return func(items []interface{}, requestId string) (proto.Message, error) {
+		contents := make([]*protocol.Content, 0, len(items))
+		for _, item := range items {
+			contents = append(contents, item.(*protocol.Content))
+		}
+		request := &protocol.WriteContentsRequest{Contents: contents, Extra: extra}
+		response, err := client.WriteContents(request, core.RetryOptions(opts, requestId)...)
+		if err != nil {
+			return nil, err
+		}
+		return response, nil
+	}
+}
+
+func contentsFailedItems(items []interface{}, response proto.Message) []*core.FailedItem {
+	matcher := core.NewItemMatcher(items, contentKey)
+	var result []*core.FailedItem
+	for _, contentErr := range response.(*protocol.WriteContentsResponse).GetErrors() {
+		result = append(result, matcher.Match(contentErr.GetContent(), contentErr.GetMessage()))
+	}
+	return result
+}
+
+func userEventsItems(userEvents []*protocol.UserEvent) []interface{} {
+	items := make([]interface{}, 0, len(userEvents))
+	for _, userEvent := range userEvents {
+		items = append(items, userEvent)
+	}
+	return items
+}
+
+func userEventsWriter(client Client, extra map[string]string, opts []option.Option) core.PartialWriteFunc {
+	return func(items []interface{}, requestId string) (proto.Message, error) {
+		userEvents := make([]*protocol.UserEvent, 0, len(items))
+		for _, item := range items {
+			userEvents = append(userEvents, item.(*protocol.UserEvent))
+		}
+		request := &protocol.WriteUserEventsRequest{UserEvents: userEvents, Extra: extra}
+		response, err := client.WriteUserEvents(request, core.RetryOptions(opts, requestId)...)
+		if err != nil {
+			return nil, err
+		}
+		return response, nil
+	}
+}
+
+func userEventsFailedItems(items []interface{}, response proto.Message) []*core.FailedItem {
+	matcher := core.NewItemMatcher(items, userEventKey)
+	var result []*core.FailedItem
+	for _, userEventErr := range response.(*protocol.WriteUserEventsResponse).GetErrors() {
+		result = append(result, matcher.Match(userEventErr.GetUserEvent(), userEventErr.GetMessage()))
+	}
+	return result
+}
+
+func userKey(item interface{}) string {
+	return item.(*protocol.User).GetUserId()
+}
+
+func contentKey(item interface{}) string {
+	return item.(*protocol.Content).GetContentId()
+}
+
+// userEventKey identifies the user event by its user, type, timestamp and item
+func userEventKey(item interface{}) string {
+	userEvent := item.(*protocol.UserEvent)
+	return fmt.Sprintf("%s|%s|%d|%s", userEvent.GetUserId(), userEvent.GetEventType(),
+		userEvent.GetEventTimestamp(), userEvent.GetContentId())
+}
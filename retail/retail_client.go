@@ -3,6 +3,7 @@ package retail
 import (
 	"github.com/byteplus-sdk/sdk-go/common"
 	. "github.com/byteplus-sdk/sdk-go/common/protocol"
+	"github.com/byteplus-sdk/sdk-go/core"
 	"github.com/byteplus-sdk/sdk-go/core/option"
 	. "github.com/byteplus-sdk/sdk-go/retail/protocol"
 )
@@ -14,6 +15,13 @@ type Client interface {
 	// release resources
 	Release()
 
+	// HealthSnapshot
+	//
+	// Returns the health state of every host observed by the sdk, including
+	// the failure rate in window, recent ping latencies, the last ping error
+	// and the available hosts, which can be used to show the routing state.
+	HealthSnapshot() *core.HealthSnapshot
+
 	// WriteUsers
 	//
 	// Writes at most 2000 users at a time. Exceeding 2000 in a request protocol.results protocol.in
@@ -68,6 +76,8 @@ type Client interface {
 	// UserEvents.  Note: This is processing realtime data, so we won't dedupe
 	// the requests.
 	// Please make sure the requests are deduplicated before sending over.
+	// When AttributionConfig is set, the empty attribution fields of the events
+	// are filled from the recent Predict results, modifying the request in place.
 	WriteUserEvents(request *WriteUserEventsRequest, opts ...option.Option) (*WriteUserEventsResponse, error)
 
 	//ImportUserEvents
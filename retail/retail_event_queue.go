@@ -0,0 +1,56 @@
+package retail
+
+import (
+	. "github.com/byteplus-sdk/sdk-go/core"
+	"github.com/byteplus-sdk/sdk-go/core/option"
+	. "github.com/byteplus-sdk/sdk-go/retail/protocol"
+	"google.golang.org/protobuf/proto"
+)
+
+// UserEventQueue persists user events in a local write-ahead log before
+// Append returns, and writes them asynchronously by WriteUserEvents
+type UserEventQueue struct {
+	queue *EventQueue
+}
+
+// NewUserEventQueue
+//
+// Creates the queue with the WAL of config.WAL.Dir, the user events
+// not accepted by server before restart are resent
+func NewUserEventQueue(client Client, config *EventQueueConfig,
+	opts ...option.Option) (*UserEventQueue, error) {
+	decode := func(record []byte) (interface{}, error) {
+		userEvent := &UserEvent{}
+		if err := proto.Unmarshal(record, userEvent); err != nil {
+			return nil, err
+		}
+		return userEvent, nil
+	}
+	queue, err := NewEventQueue(config, decode, userEventsWriter(client, nil, opts), userEventsFailedItems)
+	if err != nil {
+		return nil, err
+	}
+	return &UserEventQueue{queue: queue}, nil
+}
+
+// Append persists the user events, they are acknowledged when it returns without error
+func (receiver *UserEventQueue) Append(userEvents []*UserEvent) error {
+	records := make([][]byte, 0, len(userEvents))
+	for _, userEvent := range userEvents {
+		record, err := proto.Marshal(userEvent)
+		if err != nil {
+			return err
+		}
+		records = append(records, record)
+	}
+	return receiver.queue.Append(records)
+}
+
+// Pending returns the count of the user events not accepted by server yet
+func (receiver *UserEventQueue) Pending() uint64 {
+	return receiver.queue.Pending()
+}
+
+func (receiver *UserEventQueue) Close() error {
+	return receiver.queue.Close()
+}
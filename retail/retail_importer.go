@@ -0,0 +1,92 @@
+package retail
+
+import (
+	"github.com/byteplus-sdk/sdk-go/common"
+	. "github.com/byteplus-sdk/sdk-go/common/protocol"
+	. "github.com/byteplus-sdk/sdk-go/core"
+	"github.com/byteplus-sdk/sdk-go/core/option"
+	. "github.com/byteplus-sdk/sdk-go/retail/protocol"
+	"google.golang.org/protobuf/proto"
+)
+
+// ImportUsersFromReader
+//
+// Reads users from reader, e.g. the RecordReader of csv or jsonl file opened by
+// OpenRecordReader, the fields of record are mapped to User by mapping, then
+// imports them by ImportUsers in batches and waits for the operations
+func ImportUsersFromReader(client Client, reader RecordReader, mapping FieldMapping,
+	config *common.ImportConfig, opts ...option.Option) (*common.ImportSummary, error) {
+	newUser := func() proto.Message {
+		return &User{}
+	}
+	importUsers := func(messages []proto.Message, date string, dateEnd bool) (*OperationResponse, error) {
+		users := make([]*User, 0, len(messages))
+		for _, message := range messages {
+			users = append(users, message.(*User))
+		}
+		request := &ImportUsersRequest{
+			InputConfig: &UsersInputConfig{
+				Source: &UsersInputConfig_UsersInlineSource{
+					UsersInlineSource: &UsersInlineSource{Users: users},
+				},
+			},
+			DateConfig: &DateConfig{Date: date, IsEnd: dateEnd},
+		}
+		return client.ImportUsers(request, opts...)
+	}
+	return common.ImportMessages(client, reader, mapping, newUser, importUsers, config)
+}
+
+// ImportProductsFromReader
+//
+// Reads products from reader, the fields of record are mapped to Product by
+// mapping, then imports them by ImportProducts in batches and waits for the operations
+func ImportProductsFromReader(client Client, reader RecordReader, mapping FieldMapping,
+	config *common.ImportConfig, opts ...option.Option) (*common.ImportSummary, error) {
+	newProduct := func() proto.Message {
+		return &Product{}
+	}
+	importProducts := func(messages []proto.Message, date string, dateEnd bool) (*OperationResponse, error) {
+		products := make([]*Product, 0, len(messages))
+		for _, message := range messages {
+			products = append(products, message.(*Product))
+		}
+		request := &ImportProductsRequest{
+			InputConfig: &ProductsInputConfig{
+				Source: &ProductsInputConfig_ProductsInlineSource{
+					ProductsInlineSource: &ProductsInlineSource{Products: products},
+				},
+			},
+			DateConfig: &DateConfig{Date: date, IsEnd: dateEnd},
+		}
+		return client.ImportProducts(request, opts...)
+	}
+	return common.ImportMessages(client, reader, mapping, newProduct, importProducts, config)
+}
+
+// ImportUserEventsFromReader
+//
+// Reads user events from reader, the fields of record are mapped to UserEvent by
+// mapping, then imports them by ImportUserEvents in batches and waits for the operations
+func ImportUserEventsFromReader(client Client, reader RecordReader, mapping FieldMapping,
+	config *common.ImportConfig, opts ...option.Option) (*common.ImportSummary, error) {
+	newUserEvent := func() proto.Message {
+		return &UserEvent{}
+	}
+	importUserEvents := func(messages []proto.Message, date string, dateEnd bool) (*OperationResponse, error) {
+		userEvents := make([]*UserEvent, 0, len(messages))
+		for _, message := range messages {
+			userEvents = append(userEvents, message.(*UserEvent))
+		}
+		request := &ImportUserEventsRequest{
+			InputConfig: &UserEventsInputConfig{
+				Source: &UserEventsInputConfig_UserEventsInlineSource{
+					UserEventsInlineSource: &UserEventsInlineSource{UserEvents: userEvents},
+				},
+			},
+			DateConfig: &DateConfig{Date: date, IsEnd: dateEnd},
+		}
+		return client.ImportUserEvents(request, opts...)
+	}
+	return common.ImportMessages(client, reader, mapping, newUserEvent, importUserEvents, config)
+}
@@ -0,0 +1,72 @@
+package retail
+
+import (
+	. "github.com/byteplus-sdk/sdk-go/core"
+	"github.com/byteplus-sdk/sdk-go/core/option"
+	. "github.com/byteplus-sdk/sdk-go/retail/protocol"
+	"google.golang.org/protobuf/proto"
+)
+
+// shadowTarget is implemented by clientImpl, whose predictShadow bypasses the
+// predict cache, fallback and attribution tracking of client
+type shadowTarget interface {
+	predictShadow(request *PredictRequest, scene string, opts ...option.Option) (*PredictResponse, error)
+}
+
+type shadowPredictClient struct {
+	Client
+	secondary Client
+	shadow    *ShadowPredictor
+}
+
+// NewShadowPredictClient returns a Client whose Predict is sent to primary and
+// returns its response, meanwhile a sampled part of the requests are sent to
+// secondary asynchronously, and the products predicted are compared by
+// ShadowPredictor. Secondary can be another client, e.g. of another tenant or
+// hosts, or nil to use primary with ShadowPredictConfig.Options, e.g.
+// option.WithStage, whose predict cache, fallback and attribution are bypassed
+// by the secondary requests. Release also releases secondary if it's not primary
+func NewShadowPredictClient(primary Client, secondary Client, config *ShadowPredictConfig) Client {
+	if secondary == nil {
+		secondary = primary
+	}
+	return &shadowPredictClient{
+		Client:    primary,
+		secondary: secondary,
+		shadow:    NewShadowPredictor(config, predictProductIds),
+	}
+}
+
+func (c *shadowPredictClient) Predict(request *PredictRequest, scene string,
+	opts ...option.Option) (*PredictResponse, error) {
+	response, err := c.Client.Predict(request, scene, opts...)
+	if err != nil {
+		return nil, err
+	}
+	c.shadow.Shadow(scene, request, response, func(shadowRequest proto.Message,
+		shadowOpts []option.Option) (proto.Message, error) {
+		// the request id of primary request is not reused
+		secondaryOpts := append(append(opts[:len(opts):len(opts)], shadowOpts...), option.WithRequestId(""))
+		if target, ok := c.secondary.(shadowTarget); ok {
+			return target.predictShadow(shadowRequest.(*PredictRequest), scene, secondaryOpts...)
+		}
+		return c.secondary.Predict(shadowRequest.(*PredictRequest), scene, secondaryOpts...)
+	})
+	return response, nil
+}
+
+func (c *shadowPredictClient) Release() {
+	c.Client.Release()
+	if c.secondary != c.Client {
+		c.secondary.Release()
+	}
+}
+
+func predictProductIds(response proto.Message) []string {
+	products := response.(*PredictResponse).GetValue().GetResponseProducts()
+	ids := make([]string, 0, len(products))
+	for _, product := range products {
+		ids = append(ids, product.GetProductId())
+	}
+	return ids
+}
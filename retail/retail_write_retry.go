@@ -0,0 +1,193 @@
+package retail
+
+import (
+	"fmt"
+
+	. "github.com/byteplus-sdk/sdk-go/core"
+	"github.com/byteplus-sdk/sdk-go/core/logs"
+	"github.com/byteplus-sdk/sdk-go/core/option"
+	. "github.com/byteplus-sdk/sdk-go/retail/protocol"
+	"google.golang.org/protobuf/proto"
+)
+
+// WriteUsersWithRetry
+//
+// Writes users by client.WriteUsers, and resubmits only the users failed
+// with retryable errors, the Item of FailedItem in report is *User
+func WriteUsersWithRetry(client Client, request *WriteUsersRequest,
+	config *PartialRetryConfig, opts ...option.Option) (*PartialRetryReport, error) {
+	config = RetryConfigWithRequestId(config, opts)
+	return RetryPartialFailures(config, usersItems(request.Users),
+		usersWriter(client, request.Extra, opts), usersFailedItems)
+}
+
+// WriteProductsWithRetry
+//
+// Writes products by client.WriteProducts, and resubmits only the products
+// failed with retryable errors, the Item of FailedItem in report is *Product
+func WriteProductsWithRetry(client Client, request *WriteProductsRequest,
+	config *PartialRetryConfig, opts ...option.Option) (*PartialRetryReport, error) {
+	config = RetryConfigWithRequestId(config, opts)
+	return RetryPartialFailures(config, productsItems(request.Products),
+		productsWriter(client, request.Extra, opts), productsFailedItems)
+}
+
+// WriteUserEventsWithRetry
+//
+// Writes user events by client.WriteUserEvents, and resubmits only the user events
+// failed with retryable errors, the Item of FailedItem in report is *UserEvent
+func WriteUserEventsWithRetry(client Client, request *WriteUserEventsRequest,
+	config *PartialRetryConfig, opts ...option.Option) (*PartialRetryReport, error) {
+	config = RetryConfigWithRequestId(config, opts)
+	return RetryPartialFailures(config, userEventsItems(request.UserEvents),
+		userEventsWriter(client, request.Extra, opts), userEventsFailedItems)
+}
+
+// ReplayDeadLetters
+//
+// Resubmits the users, products and user events of dead letters by
+// WriteUsers, WriteProducts and WriteUserEvents, returns the dead letters
+// still failed, including the ones whose item isn't supported by retail
+func ReplayDeadLetters(client Client, letters []*DeadLetter,
+	opts ...option.Option) ([]*DeadLetter, error) {
+	var users, products, userEvents, stillFailed []*DeadLetter
+	for _, letter := range letters {
+		switch letter.Item.(type) {
+		case *User:
+			users = append(users, letter)
+		case *Product:
+			products = append(products, letter)
+		case *UserEvent:
+			userEvents = append(userEvents, letter)
+		default:
+			logs.Warn("[ReplayDeadLetters] unsupported dead letter type:%s", letter.Type)
+			stillFailed = append(stillFailed, letter)
+		}
+	}
+	var lastErr error
+	replay := func(letters []*DeadLetter, write PartialWriteFunc, failedItems FailedItemsFunc) {
+		if len(letters) == 0 {
+			return
+		}
+		failed, err := ResubmitDeadLetters(letters, RequestIdPrefix(opts), write, failedItems)
+		if err != nil {
+			lastErr = err
+		}
+		stillFailed = append(stillFailed, failed...)
+	}
+	replay(users, usersWriter(client, nil, opts), usersFailedItems)
+	replay(products, productsWriter(client, nil, opts), productsFailedItems)
+	replay(userEvents, userEventsWriter(client, nil, opts), userEventsFailedItems)
+	return stillFailed, lastErr
+}
+
+func usersItems(users []*User) []interface{} {
+	items := make([]interface{}, 0, len(users))
+	for _, user := range users {
+		items = append(items, user)
+	}
+	return items
+}
+
+func usersWriter(client Client, extra map[string]string, opts []option.Option) PartialWriteFunc {
+	return func(items []interface{}, requestId string) (proto.Message, error) {
+		users := make([]*User, 0, len(items))
+		for _, item := range items {
+			users = append(users, item.(*User))
+		}
+		request := &WriteUsersRequest{Users: users, Extra: extra}
+		response, err := client.WriteUsers(request, RetryOptions(opts, requestId)...)
+		if err != nil {
+			return nil, err
+		}
+		return response, nil
+	}
+}
+
+func usersFailedItems(items []interface{}, response proto.Message) []*FailedItem {
+	matcher := NewItemMatcher(items, userKey)
+	var result []*FailedItem
+	for _, userErr := range response.(*WriteUsersResponse).GetErrors() {
+		result = append(result, matcher.Match(userErr.GetUser(), userErr.GetMessage()))
+	}
+	return result
+}
+
+func productsItems(products []*Product) []interface{} {
+	items := make([]interface{}, 0, len(products))
+	for _, product := range products {
+		items = append(items, product)
+	}
+	return items
+}
+
+func productsWriter(client Client, extra map[string]string, opts []option.Option) PartialWriteFunc {
+	return func(items []interface{}, requestId string) (proto.Message, error) {
+		products := make([]*Product, 0, len(items))
+		for _, item := range items {
+			products = append(products, item.(*Product))
+		}
+		request := &WriteProductsRequest{Products: products, Extra: extra}
+		response, err := client.WriteProducts(request, RetryOptions(opts, requestId)...)
+		if err != nil {
+			return nil, err
+		}
+		return response, nil
+	}
+}
+
+func productsFailedItems(items []interface{}, response proto.Message) []*FailedItem {
+	matcher := NewItemMatcher(items, productKey)
+	var result []*FailedItem
+	for _, productErr := range response.(*WriteProductsResponse).GetErrors() {
+		result = append(result, matcher.Match(productErr.GetProduct(), productErr.GetMessage()))
+	}
+	return result
+}
+
+func userEventsItems(userEvents []*UserEvent) []interface{} {
+	items := make([]interface{}, 0, len(userEvents))
+	for _, userEvent := range userEvents {
+		items = append(items, userEvent)
+	}
+	return items
+}
+
+func userEventsWriter(client Client, extra map[string]string, opts []option.Option) PartialWriteFunc {
+	return func(items []interface{}, requestId string) (proto.Message, error) {
+		userEvents := make([]*UserEvent, 0, len(items))
+		for _, item := range items {
+			userEvents = append(userEvents, item.(*UserEvent))
+		}
+		request := &WriteUserEventsRequest{UserEvents: userEvents, Extra: extra}
+		response, err := client.WriteUserEvents(request, RetryOptions(opts, requestId)...)
+		if err != nil {
+			return nil, err
+		}
+		return response, nil
+	}
+}
+
+func userEventsFailedItems(items []interface{}, response proto.Message) []*FailedItem {
+	matcher := NewItemMatcher(items, userEventKey)
+	var result []*FailedItem
+	for _, userEventErr := range response.(*WriteUserEventsResponse).GetErrors() {
+		result = append(result, matcher.Match(userEventErr.GetUserEvent(), userEventErr.GetMessage()))
+	}
+	return result
+}
+
+func userKey(item interface{}) string {
+	return item.(*User).GetUserId()
+}
+
+func productKey(item interface{}) string {
+	return item.(*Product).GetProductId()
+}
+
+// userEventKey identifies the user event by its user, type, timestamp and item
+func userEventKey(item interface{}) string {
+	userEvent := item.(*UserEvent)
+	return fmt.Sprintf("%s|%s|%d|%s", userEvent.GetUserId(), userEvent.GetEventType(),
+		userEvent.GetEventTimestamp(), userEvent.GetProductId())
+}
@@ -0,0 +1,17 @@
+// Package migration helps to migrate from retail to retailv2, it converts the
+// User, Product and UserEvent messages between retail/protocol and
+// retailv2/protocol, and mirrors the writes of retail client to retailv2 client
+package migration
+
+//go:generate go run gen.go
+
+func copyStringMap(from map[string]string) map[string]string {
+	if from == nil {
+		return nil
+	}
+	result := make(map[string]string, len(from))
+	for k, v := range from {
+		result[k] = v
+	}
+	return result
+}
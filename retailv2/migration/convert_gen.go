@@ -0,0 +1,518 @@
+// Code generated by gen.go. DO NOT EDIT.
+
+package migration
+
+import (
+	v1 "github.com/byteplus-sdk/sdk-go/retail/protocol"
+	v2 "github.com/byteplus-sdk/sdk-go/retailv2/protocol"
+)
+
+// UserToV2 converts v1.User to v2.User, the nil is converted to nil
+func UserToV2(from *v1.User) *v2.User {
+	if from == nil {
+		return nil
+	}
+	return &v2.User{
+		UserId:                from.UserId,
+		Gender:                from.Gender,
+		Age:                   from.Age,
+		Tags:                  append([]string(nil), from.Tags...),
+		ActivationChannel:     from.ActivationChannel,
+		MembershipLevel:       from.MembershipLevel,
+		RegistrationTimestamp: from.RegistrationTimestamp,
+		Location:              userLocationToV2(from.Location),
+		Extra:                 copyStringMap(from.Extra),
+	}
+}
+
+// UsersToV2 converts each of v1.User by UserToV2
+func UsersToV2(from []*v1.User) []*v2.User {
+	if from == nil {
+		return nil
+	}
+	result := make([]*v2.User, 0, len(from))
+	for _, item := range from {
+		result = append(result, UserToV2(item))
+	}
+	return result
+}
+
+func userLocationToV2(from *v1.User_Location) *v2.User_Location {
+	if from == nil {
+		return nil
+	}
+	return &v2.User_Location{
+		Country:        from.Country,
+		City:           from.City,
+		DistrictOrArea: from.DistrictOrArea,
+		Postcode:       from.Postcode,
+	}
+}
+
+// UserFromV2 converts v2.User to v1.User, the nil is converted to nil
+func UserFromV2(from *v2.User) *v1.User {
+	if from == nil {
+		return nil
+	}
+	return &v1.User{
+		UserId:                from.UserId,
+		Gender:                from.Gender,
+		Age:                   from.Age,
+		Tags:                  append([]string(nil), from.Tags...),
+		ActivationChannel:     from.ActivationChannel,
+		MembershipLevel:       from.MembershipLevel,
+		RegistrationTimestamp: from.RegistrationTimestamp,
+		Location:              userLocationFromV2(from.Location),
+		Extra:                 copyStringMap(from.Extra),
+	}
+}
+
+// UsersFromV2 converts each of v2.User by UserFromV2
+func UsersFromV2(from []*v2.User) []*v1.User {
+	if from == nil {
+		return nil
+	}
+	result := make([]*v1.User, 0, len(from))
+	for _, item := range from {
+		result = append(result, UserFromV2(item))
+	}
+	return result
+}
+
+func userLocationFromV2(from *v2.User_Location) *v1.User_Location {
+	if from == nil {
+		return nil
+	}
+	return &v1.User_Location{
+		Country:        from.Country,
+		City:           from.City,
+		DistrictOrArea: from.DistrictOrArea,
+		Postcode:       from.Postcode,
+	}
+}
+
+// ProductToV2 converts v1.Product to v2.Product, the nil is converted to nil
+func ProductToV2(from *v1.Product) *v2.Product {
+	if from == nil {
+		return nil
+	}
+	return &v2.Product{
+		ProductId:       from.ProductId,
+		Categories:      productCategorySliceToV2(from.Categories),
+		Brands:          productBrandSliceToV2(from.Brands),
+		Price:           productPriceToV2(from.Price),
+		IsRecommendable: from.IsRecommendable,
+		Title:           from.Title,
+		QualityScore:    from.QualityScore,
+		Tags:            append([]string(nil), from.Tags...),
+		Display:         productDisplayToV2(from.Display),
+		ProductSpec:     productProductSpecToV2(from.ProductSpec),
+		Seller:          productSellerToV2(from.Seller),
+		Extra:           copyStringMap(from.Extra),
+	}
+}
+
+// ProductsToV2 converts each of v1.Product by ProductToV2
+func ProductsToV2(from []*v1.Product) []*v2.Product {
+	if from == nil {
+		return nil
+	}
+	result := make([]*v2.Product, 0, len(from))
+	for _, item := range from {
+		result = append(result, ProductToV2(item))
+	}
+	return result
+}
+
+func productCategoryToV2(from *v1.Product_Category) *v2.Product_Category {
+	if from == nil {
+		return nil
+	}
+	return &v2.Product_Category{
+		CategoryDepth: from.CategoryDepth,
+		CategoryNodes: productCategoryCategoryNodeSliceToV2(from.CategoryNodes),
+	}
+}
+
+func productCategorySliceToV2(from []*v1.Product_Category) []*v2.Product_Category {
+	if from == nil {
+		return nil
+	}
+	result := make([]*v2.Product_Category, 0, len(from))
+	for _, item := range from {
+		result = append(result, productCategoryToV2(item))
+	}
+	return result
+}
+
+func productCategoryCategoryNodeToV2(from *v1.Product_Category_CategoryNode) *v2.Product_Category_CategoryNode {
+	if from == nil {
+		return nil
+	}
+	return &v2.Product_Category_CategoryNode{
+		IdOrName: from.IdOrName,
+	}
+}
+
+func productCategoryCategoryNodeSliceToV2(from []*v1.Product_Category_CategoryNode) []*v2.Product_Category_CategoryNode {
+	if from == nil {
+		return nil
+	}
+	result := make([]*v2.Product_Category_CategoryNode, 0, len(from))
+	for _, item := range from {
+		result = append(result, productCategoryCategoryNodeToV2(item))
+	}
+	return result
+}
+
+func productBrandToV2(from *v1.Product_Brand) *v2.Product_Brand {
+	if from == nil {
+		return nil
+	}
+	return &v2.Product_Brand{
+		BrandDepth: from.BrandDepth,
+		IdOrName:   from.IdOrName,
+	}
+}
+
+func productBrandSliceToV2(from []*v1.Product_Brand) []*v2.Product_Brand {
+	if from == nil {
+		return nil
+	}
+	result := make([]*v2.Product_Brand, 0, len(from))
+	for _, item := range from {
+		result = append(result, productBrandToV2(item))
+	}
+	return result
+}
+
+func productPriceToV2(from *v1.Product_Price) *v2.Product_Price {
+	if from == nil {
+		return nil
+	}
+	return &v2.Product_Price{
+		CurrentPrice: from.CurrentPrice,
+		OriginPrice:  from.OriginPrice,
+	}
+}
+
+func productDisplayToV2(from *v1.Product_Display) *v2.Product_Display {
+	if from == nil {
+		return nil
+	}
+	return &v2.Product_Display{
+		ListingPageDisplayTags: append([]string(nil), from.ListingPageDisplayTags...),
+		DetailPageDisplayTags:  append([]string(nil), from.DetailPageDisplayTags...),
+		ListingPageDisplayType: from.ListingPageDisplayType,
+		CoverMultimediaUrl:     from.CoverMultimediaUrl,
+	}
+}
+
+func productProductSpecToV2(from *v1.Product_ProductSpec) *v2.Product_ProductSpec {
+	if from == nil {
+		return nil
+	}
+	return &v2.Product_ProductSpec{
+		ProductGroupId:   from.ProductGroupId,
+		UserRating:       from.UserRating,
+		CommentCount:     from.CommentCount,
+		Source:           from.Source,
+		PublishTimestamp: from.PublishTimestamp,
+	}
+}
+
+func productSellerToV2(from *v1.Product_Seller) *v2.Product_Seller {
+	if from == nil {
+		return nil
+	}
+	return &v2.Product_Seller{
+		Id:           from.Id,
+		SellerLevel:  from.SellerLevel,
+		SellerRating: from.SellerRating,
+	}
+}
+
+// ProductFromV2 converts v2.Product to v1.Product, the nil is converted to nil
+func ProductFromV2(from *v2.Product) *v1.Product {
+	if from == nil {
+		return nil
+	}
+	return &v1.Product{
+		ProductId:       from.ProductId,
+		Categories:      productCategorySliceFromV2(from.Categories),
+		Brands:          productBrandSliceFromV2(from.Brands),
+		Price:           productPriceFromV2(from.Price),
+		IsRecommendable: from.IsRecommendable,
+		Title:           from.Title,
+		QualityScore:    from.QualityScore,
+		Tags:            append([]string(nil), from.Tags...),
+		Display:         productDisplayFromV2(from.Display),
+		ProductSpec:     productProductSpecFromV2(from.ProductSpec),
+		Seller:          productSellerFromV2(from.Seller),
+		Extra:           copyStringMap(from.Extra),
+	}
+}
+
+// ProductsFromV2 converts each of v2.Product by ProductFromV2
+func ProductsFromV2(from []*v2.Product) []*v1.Product {
+	if from == nil {
+		return nil
+	}
+	result := make([]*v1.Product, 0, len(from))
+	for _, item := range from {
+		result = append(result, ProductFromV2(item))
+	}
+	return result
+}
+
+func productCategoryFromV2(from *v2.Product_Category) *v1.Product_Category {
+	if from == nil {
+		return nil
+	}
+	return &v1.Product_Category{
+		CategoryDepth: from.CategoryDepth,
+		CategoryNodes: productCategoryCategoryNodeSliceFromV2(from.CategoryNodes),
+	}
+}
+
+func productCategorySliceFromV2(from []*v2.Product_Category) []*v1.Product_Category {
+	if from == nil {
+		return nil
+	}
+	result := make([]*v1.Product_Category, 0, len(from))
+	for _, item := range from {
+		result = append(result, productCategoryFromV2(item))
+	}
+	return result
+}
+
+func productCategoryCategoryNodeFromV2(from *v2.Product_Category_CategoryNode) *v1.Product_Category_CategoryNode {
+	if from == nil {
+		return nil
+	}
+	return &v1.Product_Category_CategoryNode{
+		IdOrName: from.IdOrName,
+	}
+}
+
+func productCategoryCategoryNodeSliceFromV2(from []*v2.Product_Category_CategoryNode) []*v1.Product_Category_CategoryNode {
+	if from == nil {
+		return nil
+	}
+	result := make([]*v1.Product_Category_CategoryNode, 0, len(from))
+	for _, item := range from {
+		result = append(result, productCategoryCategoryNodeFromV2(item))
+	}
+	return result
+}
+
+func productBrandFromV2(from *v2.Product_Brand) *v1.Product_Brand {
+	if from == nil {
+		return nil
+	}
+	return &v1.Product_Brand{
+		BrandDepth: from.BrandDepth,
+		IdOrName:   from.IdOrName,
+	}
+}
+
+func productBrandSliceFromV2(from []*v2.Product_Brand) []*v1.Product_Brand {
+	if from == nil {
+		return nil
+	}
+	result := make([]*v1.Product_Brand, 0, len(from))
+	for _, item := range from {
+		result = append(result, productBrandFromV2(item))
+	}
+	return result
+}
+
+func productPriceFromV2(from *v2.Product_Price) *v1.Product_Price {
+	if from == nil {
+		return nil
+	}
+	return &v1.Product_Price{
+		CurrentPrice: from.CurrentPrice,
+		OriginPrice:  from.OriginPrice,
+	}
+}
+
+func productDisplayFromV2(from *v2.Product_Display) *v1.Product_Display {
+	if from == nil {
+		return nil
+	}
+	return &v1.Product_Display{
+		ListingPageDisplayTags: append([]string(nil), from.ListingPageDisplayTags...),
+		DetailPageDisplayTags:  append([]string(nil), from.DetailPageDisplayTags...),
+		ListingPageDisplayType: from.ListingPageDisplayType,
+		CoverMultimediaUrl:     from.CoverMultimediaUrl,
+	}
+}
+
+func productProductSpecFromV2(from *v2.Product_ProductSpec) *v1.Product_ProductSpec {
+	if from == nil {
+		return nil
+	}
+	return &v1.Product_ProductSpec{
+		ProductGroupId:   from.ProductGroupId,
+		UserRating:       from.UserRating,
+		CommentCount:     from.CommentCount,
+		Source:           from.Source,
+		PublishTimestamp: from.PublishTimestamp,
+	}
+}
+
+func productSellerFromV2(from *v2.Product_Seller) *v1.Product_Seller {
+	if from == nil {
+		return nil
+	}
+	return &v1.Product_Seller{
+		Id:           from.Id,
+		SellerLevel:  from.SellerLevel,
+		SellerRating: from.SellerRating,
+	}
+}
+
+// UserEventToV2 converts v1.UserEvent to v2.UserEvent, the nil is converted to nil
+func UserEventToV2(from *v1.UserEvent) *v2.UserEvent {
+	if from == nil {
+		return nil
+	}
+	return &v2.UserEvent{
+		UserId:             from.UserId,
+		EventType:          from.EventType,
+		EventTimestamp:     from.EventTimestamp,
+		Scene:              userEventSceneToV2(from.Scene),
+		ProductId:          from.ProductId,
+		Device:             userEventDeviceToV2(from.Device),
+		Context:            userEventContextToV2(from.Context),
+		AttributionToken:   from.AttributionToken,
+		RecInfo:            from.RecInfo,
+		TrafficSource:      from.TrafficSource,
+		PurchaseCount:      from.PurchaseCount,
+		DetailPageStayTime: from.DetailPageStayTime,
+		Extra:              copyStringMap(from.Extra),
+	}
+}
+
+// UserEventsToV2 converts each of v1.UserEvent by UserEventToV2
+func UserEventsToV2(from []*v1.UserEvent) []*v2.UserEvent {
+	if from == nil {
+		return nil
+	}
+	result := make([]*v2.UserEvent, 0, len(from))
+	for _, item := range from {
+		result = append(result, UserEventToV2(item))
+	}
+	return result
+}
+
+func userEventSceneToV2(from *v1.UserEvent_Scene) *v2.UserEvent_Scene {
+	if from == nil {
+		return nil
+	}
+	return &v2.UserEvent_Scene{
+		SceneName:  from.SceneName,
+		PageNumber: from.PageNumber,
+		Offset:     from.Offset,
+	}
+}
+
+func userEventDeviceToV2(from *v1.UserEvent_Device) *v2.UserEvent_Device {
+	if from == nil {
+		return nil
+	}
+	return &v2.UserEvent_Device{
+		Platform:    from.Platform,
+		OsType:      from.OsType,
+		AppVersion:  from.AppVersion,
+		DeviceModel: from.DeviceModel,
+		DeviceBrand: from.DeviceBrand,
+		OsVersion:   from.OsVersion,
+		BrowserType: from.BrowserType,
+		UserAgent:   from.UserAgent,
+		Network:     from.Network,
+	}
+}
+
+func userEventContextToV2(from *v1.UserEvent_Context) *v2.UserEvent_Context {
+	if from == nil {
+		return nil
+	}
+	return &v2.UserEvent_Context{
+		Query:         from.Query,
+		RootProductId: from.RootProductId,
+	}
+}
+
+// UserEventFromV2 converts v2.UserEvent to v1.UserEvent, the nil is converted to nil
+func UserEventFromV2(from *v2.UserEvent) *v1.UserEvent {
+	if from == nil {
+		return nil
+	}
+	return &v1.UserEvent{
+		UserId:             from.UserId,
+		EventType:          from.EventType,
+		EventTimestamp:     from.EventTimestamp,
+		Scene:              userEventSceneFromV2(from.Scene),
+		ProductId:          from.ProductId,
+		Device:             userEventDeviceFromV2(from.Device),
+		Context:            userEventContextFromV2(from.Context),
+		AttributionToken:   from.AttributionToken,
+		RecInfo:            from.RecInfo,
+		TrafficSource:      from.TrafficSource,
+		PurchaseCount:      from.PurchaseCount,
+		DetailPageStayTime: from.DetailPageStayTime,
+		Extra:              copyStringMap(from.Extra),
+	}
+}
+
+// UserEventsFromV2 converts each of v2.UserEvent by UserEventFromV2
+func UserEventsFromV2(from []*v2.UserEvent) []*v1.UserEvent {
+	if from == nil {
+		return nil
+	}
+	result := make([]*v1.UserEvent, 0, len(from))
+	for _, item := range from {
+		result = append(result, UserEventFromV2(item))
+	}
+	return result
+}
+
+func userEventSceneFromV2(from *v2.UserEvent_Scene) *v1.UserEvent_Scene {
+	if from == nil {
+		return nil
+	}
+	return &v1.UserEvent_Scene{
+		SceneName:  from.SceneName,
+		PageNumber: from.PageNumber,
+		Offset:     from.Offset,
+	}
+}
+
+func userEventDeviceFromV2(from *v2.UserEvent_Device) *v1.UserEvent_Device {
+	if from == nil {
+		return nil
+	}
+	return &v1.UserEvent_Device{
+		Platform:    from.Platform,
+		OsType:      from.OsType,
+		AppVersion:  from.AppVersion,
+		DeviceModel: from.DeviceModel,
+		DeviceBrand: from.DeviceBrand,
+		OsVersion:   from.OsVersion,
+		BrowserType: from.BrowserType,
+		UserAgent:   from.UserAgent,
+		Network:     from.Network,
+	}
+}
+
+func userEventContextFromV2(from *v2.UserEvent_Context) *v1.UserEvent_Context {
+	if from == nil {
+		return nil
+	}
+	return &v1.UserEvent_Context{
+		Query:         from.Query,
+		RootProductId: from.RootProductId,
+	}
+}
@@ -0,0 +1,95 @@
+package migration
+
+import (
+	"testing"
+
+	v1 "github.com/byteplus-sdk/sdk-go/retail/protocol"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestConvert(t *testing.T) {
+	user := &v1.User{
+		UserId:   "u1",
+		Tags:     []string{"new"},
+		Location: &v1.User_Location{City: "Singapore"},
+		Extra:    map[string]string{"k": "v"},
+	}
+	product := &v1.Product{
+		ProductId: "p1",
+		Categories: []*v1.Product_Category{{
+			CategoryDepth: 1,
+			CategoryNodes: []*v1.Product_Category_CategoryNode{{IdOrName: "shoes"}},
+		}},
+		Brands:      []*v1.Product_Brand{{BrandDepth: 1, IdOrName: "brand"}},
+		Price:       &v1.Product_Price{CurrentPrice: 100, OriginPrice: 120},
+		Display:     &v1.Product_Display{DetailPageDisplayTags: []string{"hot"}},
+		ProductSpec: &v1.Product_ProductSpec{UserRating: 4.5, ProductGroupId: "g1"},
+		Seller:      &v1.Product_Seller{Id: "s1"},
+		Extra:       map[string]string{"k": "v"},
+	}
+	userEvent := &v1.UserEvent{
+		UserId:         "u1",
+		EventType:      "purchase",
+		EventTimestamp: 1640000000,
+		Scene:          &v1.UserEvent_Scene{SceneName: "home", Offset: 3},
+		ProductId:      "p1",
+		Device:         &v1.UserEvent_Device{Platform: "app"},
+		Context:        &v1.UserEvent_Context{Query: "shoes"},
+		PurchaseCount:  2,
+	}
+	tests := []struct {
+		name      string
+		message   proto.Message
+		roundTrip func() (proto.Message, proto.Message)
+	}{
+		{
+			name:    "user",
+			message: user,
+			roundTrip: func() (proto.Message, proto.Message) {
+				v2User := UserToV2(user)
+				return v2User, UserFromV2(v2User)
+			},
+		},
+		{
+			name:    "product",
+			message: product,
+			roundTrip: func() (proto.Message, proto.Message) {
+				v2Product := ProductToV2(product)
+				return v2Product, ProductFromV2(v2Product)
+			},
+		},
+		{
+			name:    "user_event",
+			message: userEvent,
+			roundTrip: func() (proto.Message, proto.Message) {
+				v2UserEvent := UserEventToV2(userEvent)
+				return v2UserEvent, UserEventFromV2(v2UserEvent)
+			},
+		},
+	}
+	marshal := proto.MarshalOptions{Deterministic: true}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			converted, back := tt.roundTrip()
+			// both protos have the same field numbers, so the wire formats are the same
+			want, _ := marshal.Marshal(tt.message)
+			got, _ := marshal.Marshal(converted)
+			if string(got) != string(want) {
+				t.Errorf("converted = %v, want the same fields as %v", converted, tt.message)
+			}
+			if !proto.Equal(back, tt.message) {
+				t.Errorf("round trip = %v, want %v", back, tt.message)
+			}
+		})
+	}
+}
+
+func TestConvert_nil(t *testing.T) {
+	if UserToV2(nil) != nil || ProductsToV2(nil) != nil || UserEventFromV2(nil) != nil {
+		t.Errorf("nil should be converted to nil")
+	}
+	users := UsersToV2([]*v1.User{{UserId: "u1"}, nil})
+	if len(users) != 2 || users[0].GetUserId() != "u1" || users[1] != nil {
+		t.Errorf("UsersToV2() = %v", users)
+	}
+}
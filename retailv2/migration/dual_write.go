@@ -0,0 +1,269 @@
+package migration
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+	"sync/atomic"
+
+	"github.com/byteplus-sdk/sdk-go/core"
+	"github.com/byteplus-sdk/sdk-go/core/logs"
+	"github.com/byteplus-sdk/sdk-go/core/option"
+	"github.com/byteplus-sdk/sdk-go/retail"
+	v1 "github.com/byteplus-sdk/sdk-go/retail/protocol"
+	"github.com/byteplus-sdk/sdk-go/retailv2"
+	v2 "github.com/byteplus-sdk/sdk-go/retailv2/protocol"
+)
+
+const defaultDualWriteMaxPending = 100
+
+// DualWriteConfig is the config of DualWriteClient
+type DualWriteConfig struct {
+	// Max count of the shadow writes in flight, the writes are not mirrored and
+	// counted as dropped when exceeding, so a slow retailv2 target doesn't pile
+	// up goroutines, default is 100
+	MaxPending int
+	// Called with the difference of the responses of a mirrored write, in the
+	// goroutine of the shadow write. The difference is logged if it's not set
+	OnDiff func(diff *WriteDiff)
+}
+
+// WriteDiff is the difference between the responses of the retail client and
+// the retailv2 client to the same write
+type WriteDiff struct {
+	// The write method, e.g. WriteUsers
+	Method string
+	// The status codes of responses, 0 if the call failed with error
+	PrimaryCode int32
+	ShadowCode  int32
+	PrimaryErr  error
+	ShadowErr   error
+	// The ids of the items failed in only one of the responses
+	PrimaryOnlyFailed []string
+	ShadowOnlyFailed  []string
+}
+
+func (receiver *WriteDiff) String() string {
+	return fmt.Sprintf("%s, primary code:%d err:%v failed:%v, shadow code:%d err:%v failed:%v",
+		receiver.Method, receiver.PrimaryCode, receiver.PrimaryErr, receiver.PrimaryOnlyFailed,
+		receiver.ShadowCode, receiver.ShadowErr, receiver.ShadowOnlyFailed)
+}
+
+// DualWriteStats counts the mirrored writes of DualWriteClient
+type DualWriteStats struct {
+	// The count of writes mirrored to the retailv2 client and completed
+	Mirrored int64
+	// The count of mirrored writes whose responses are different
+	Differed int64
+	// The count of writes not mirrored as too many shadow writes are pending
+	Dropped int64
+}
+
+// DualWriteClient implements retail.Client. The Write methods are sent to the
+// retail client and their results are returned, and the requests are also
+// converted and written to the retailv2 client asynchronously in shadow mode,
+// whose responses are compared with the retail ones. The other methods are
+// only sent to the retail client.
+// The options of Write methods are also passed to the shadow writes, except
+// that the request id set by option.WithRequestId is not shared, each shadow
+// write has its own request id
+type DualWriteClient struct {
+	retail.Client
+	shadow     retailv2.Client
+	onDiff     func(diff *WriteDiff)
+	maxPending int64
+	pending    sync.WaitGroup
+	inflight   int64
+	mirrored   int64
+	differed   int64
+	dropped    int64
+}
+
+func NewDualWriteClient(primary retail.Client, shadow retailv2.Client,
+	config *DualWriteConfig) *DualWriteClient {
+	client := &DualWriteClient{
+		Client:     primary,
+		shadow:     shadow,
+		maxPending: defaultDualWriteMaxPending,
+		onDiff: func(diff *WriteDiff) {
+			logs.Warn("[DualWrite] responses are different, %s", diff)
+		},
+	}
+	if config != nil && config.MaxPending > 0 {
+		client.maxPending = int64(config.MaxPending)
+	}
+	if config != nil && config.OnDiff != nil {
+		client.onDiff = config.OnDiff
+	}
+	return client
+}
+
+func (receiver *DualWriteClient) WriteUsers(request *v1.WriteUsersRequest,
+	opts ...option.Option) (*v1.WriteUsersResponse, error) {
+	response, err := receiver.Client.WriteUsers(request, opts...)
+	primary := newWriteResult(response.GetStatus().GetCode(), err)
+	for _, userError := range response.GetErrors() {
+		primary.failed = append(primary.failed, userError.GetUser().GetUserId())
+	}
+	shadowRequest := &v2.WriteUsersRequest{
+		Users: UsersToV2(request.GetUsers()),
+		Extra: copyStringMap(request.GetExtra()),
+	}
+	receiver.mirror("WriteUsers", primary, opts, func(opts []option.Option) *writeResult {
+		shadowResponse, shadowErr := receiver.shadow.WriteUsers(shadowRequest, opts...)
+		shadow := newWriteResult(shadowResponse.GetStatus().GetCode(), shadowErr)
+		for _, userError := range shadowResponse.GetErrors() {
+			shadow.failed = append(shadow.failed, userError.GetUser().GetUserId())
+		}
+		return shadow
+	})
+	return response, err
+}
+
+func (receiver *DualWriteClient) WriteProducts(request *v1.WriteProductsRequest,
+	opts ...option.Option) (*v1.WriteProductsResponse, error) {
+	response, err := receiver.Client.WriteProducts(request, opts...)
+	primary := newWriteResult(response.GetStatus().GetCode(), err)
+	for _, productError := range response.GetErrors() {
+		primary.failed = append(primary.failed, productError.GetProduct().GetProductId())
+	}
+	shadowRequest := &v2.WriteProductsRequest{
+		Products: ProductsToV2(request.GetProducts()),
+		Extra:    copyStringMap(request.GetExtra()),
+	}
+	receiver.mirror("WriteProducts", primary, opts, func(opts []option.Option) *writeResult {
+		shadowResponse, shadowErr := receiver.shadow.WriteProducts(shadowRequest, opts...)
+		shadow := newWriteResult(shadowResponse.GetStatus().GetCode(), shadowErr)
+		for _, productError := range shadowResponse.GetErrors() {
+			shadow.failed = append(shadow.failed, productError.GetProduct().GetProductId())
+		}
+		return shadow
+	})
+	return response, err
+}
+
+// WriteUserEvents converts the request after it's written to the retail client,
+// so the attribution filled by the retail client is also mirrored
+func (receiver *DualWriteClient) WriteUserEvents(request *v1.WriteUserEventsRequest,
+	opts ...option.Option) (*v1.WriteUserEventsResponse, error) {
+	response, err := receiver.Client.WriteUserEvents(request, opts...)
+	primary := newWriteResult(response.GetStatus().GetCode(), err)
+	for _, userEventError := range response.GetErrors() {
+		userEvent := userEventError.GetUserEvent()
+		primary.failed = append(primary.failed, userEventKey(userEvent.GetUserId(),
+			userEvent.GetEventType(), userEvent.GetProductId(), userEvent.GetEventTimestamp()))
+	}
+	shadowRequest := &v2.WriteUserEventsRequest{
+		UserEvents: UserEventsToV2(request.GetUserEvents()),
+		Extra:      copyStringMap(request.GetExtra()),
+	}
+	receiver.mirror("WriteUserEvents", primary, opts, func(opts []option.Option) *writeResult {
+		shadowResponse, shadowErr := receiver.shadow.WriteUserEvents(shadowRequest, opts...)
+		shadow := newWriteResult(shadowResponse.GetStatus().GetCode(), shadowErr)
+		for _, userEventError := range shadowResponse.GetErrors() {
+			userEvent := userEventError.GetUserEvent()
+			shadow.failed = append(shadow.failed, userEventKey(userEvent.GetUserId(),
+				userEvent.GetEventType(), userEvent.GetProductId(), userEvent.GetEventTimestamp()))
+		}
+		return shadow
+	})
+	return response, err
+}
+
+// Stats returns the counts of the completed mirrored writes
+func (receiver *DualWriteClient) Stats() *DualWriteStats {
+	return &DualWriteStats{
+		Mirrored: atomic.LoadInt64(&receiver.mirrored),
+		Differed: atomic.LoadInt64(&receiver.differed),
+		Dropped:  atomic.LoadInt64(&receiver.dropped),
+	}
+}
+
+// Wait blocks until the pending shadow writes are completed
+func (receiver *DualWriteClient) Wait() {
+	receiver.pending.Wait()
+}
+
+// Release waits for the pending shadow writes, then releases both clients
+func (receiver *DualWriteClient) Release() {
+	receiver.Wait()
+	receiver.Client.Release()
+	receiver.shadow.Release()
+}
+
+func (receiver *DualWriteClient) mirror(method string, primary *writeResult,
+	opts []option.Option, write func(opts []option.Option) *writeResult) {
+	if atomic.AddInt64(&receiver.inflight, 1) > receiver.maxPending {
+		atomic.AddInt64(&receiver.inflight, -1)
+		atomic.AddInt64(&receiver.dropped, 1)
+		logs.Debug("[DualWrite] too many pending shadow writes, drop %s", method)
+		return
+	}
+	// the shadow write generates its own request id
+	shadowOpts := append(opts[:len(opts):len(opts)], option.WithRequestId(""))
+	receiver.pending.Add(1)
+	core.AsyncExecute(func() {
+		defer receiver.pending.Done()
+		defer atomic.AddInt64(&receiver.inflight, -1)
+		shadow := write(shadowOpts)
+		atomic.AddInt64(&receiver.mirrored, 1)
+		if diff := compareWriteResults(method, primary, shadow); diff != nil {
+			atomic.AddInt64(&receiver.differed, 1)
+			receiver.onDiff(diff)
+		}
+	})
+}
+
+type writeResult struct {
+	code int32
+	err  error
+	// the ids of the failed items
+	failed []string
+}
+
+func newWriteResult(code int32, err error) *writeResult {
+	if err != nil {
+		code = 0
+	}
+	return &writeResult{code: code, err: err}
+}
+
+func userEventKey(userId string, eventType string, productId string, timestamp int64) string {
+	return fmt.Sprintf("%s/%s/%s/%d", userId, eventType, productId, timestamp)
+}
+
+// compareWriteResults returns nil if the calls both succeed or both fail, with
+// the same status code and failed items
+func compareWriteResults(method string, primary *writeResult, shadow *writeResult) *WriteDiff {
+	primaryOnly := subtractIds(primary.failed, shadow.failed)
+	shadowOnly := subtractIds(shadow.failed, primary.failed)
+	if primary.code == shadow.code && (primary.err == nil) == (shadow.err == nil) &&
+		len(primaryOnly) == 0 && len(shadowOnly) == 0 {
+		return nil
+	}
+	return &WriteDiff{
+		Method:            method,
+		PrimaryCode:       primary.code,
+		ShadowCode:        shadow.code,
+		PrimaryErr:        primary.err,
+		ShadowErr:         shadow.err,
+		PrimaryOnlyFailed: primaryOnly,
+		ShadowOnlyFailed:  shadowOnly,
+	}
+}
+
+// subtractIds returns the sorted ids in ids but not in others
+func subtractIds(ids []string, others []string) []string {
+	exclude := make(map[string]bool, len(others))
+	for _, id := range others {
+		exclude[id] = true
+	}
+	var result []string
+	for _, id := range ids {
+		if !exclude[id] {
+			result = append(result, id)
+		}
+	}
+	sort.Strings(result)
+	return result
+}
@@ -0,0 +1,150 @@
+package migration
+
+import (
+	"errors"
+	"reflect"
+	"sync"
+	"testing"
+
+	"github.com/byteplus-sdk/sdk-go/common/protocol"
+	"github.com/byteplus-sdk/sdk-go/core/option"
+	"github.com/byteplus-sdk/sdk-go/retail"
+	v1 "github.com/byteplus-sdk/sdk-go/retail/protocol"
+	"github.com/byteplus-sdk/sdk-go/retailv2"
+	v2 "github.com/byteplus-sdk/sdk-go/retailv2/protocol"
+)
+
+type mockRetailClient struct {
+	retail.Client
+	response *v1.WriteUsersResponse
+	err      error
+}
+
+func (m *mockRetailClient) WriteUsers(request *v1.WriteUsersRequest,
+	opts ...option.Option) (*v1.WriteUsersResponse, error) {
+	return m.response, m.err
+}
+
+type mockRetailV2Client struct {
+	retailv2.Client
+	lock       sync.Mutex
+	requests   []*v2.WriteUsersRequest
+	requestIds []string
+	response   *v2.WriteUsersResponse
+	err        error
+	// blocks the writes until it's closed if it's not nil
+	block chan struct{}
+}
+
+func (m *mockRetailV2Client) WriteUsers(request *v2.WriteUsersRequest,
+	opts ...option.Option) (*v2.WriteUsersResponse, error) {
+	if m.block != nil {
+		<-m.block
+	}
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	m.requests = append(m.requests, request)
+	m.requestIds = append(m.requestIds, option.Conv2Options(opts...).RequestId)
+	return m.response, m.err
+}
+
+func TestDualWriteClient_WriteUsers(t *testing.T) {
+	success := &protocol.Status{Code: 0}
+	partial := &protocol.Status{Code: 1001}
+	tests := []struct {
+		name         string
+		primary      *v1.WriteUsersResponse
+		primaryErr   error
+		shadow       *v2.WriteUsersResponse
+		shadowErr    error
+		wantDiff     *WriteDiff
+		wantDiffered int64
+	}{
+		{
+			name:    "same",
+			primary: &v1.WriteUsersResponse{Status: success},
+			shadow:  &v2.WriteUsersResponse{Status: success},
+		},
+		{
+			name: "different_failed_items",
+			primary: &v1.WriteUsersResponse{Status: partial, Errors: []*v1.UserError{
+				{User: &v1.User{UserId: "u1"}},
+			}},
+			shadow: &v2.WriteUsersResponse{Status: partial, Errors: []*v2.UserError{
+				{User: &v2.User{UserId: "u2"}},
+			}},
+			wantDiff: &WriteDiff{
+				Method:            "WriteUsers",
+				PrimaryCode:       1001,
+				ShadowCode:        1001,
+				PrimaryOnlyFailed: []string{"u1"},
+				ShadowOnlyFailed:  []string{"u2"},
+			},
+			wantDiffered: 1,
+		},
+		{
+			name:      "shadow_error",
+			primary:   &v1.WriteUsersResponse{Status: success},
+			shadowErr: errors.New("timeout"),
+			wantDiff: &WriteDiff{
+				Method:    "WriteUsers",
+				ShadowErr: errors.New("timeout"),
+			},
+			wantDiffered: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			primary := &mockRetailClient{response: tt.primary, err: tt.primaryErr}
+			shadow := &mockRetailV2Client{response: tt.shadow, err: tt.shadowErr}
+			var gotDiff *WriteDiff
+			client := NewDualWriteClient(primary, shadow, &DualWriteConfig{
+				OnDiff: func(diff *WriteDiff) { gotDiff = diff },
+			})
+			request := &v1.WriteUsersRequest{Users: []*v1.User{{UserId: "u1"}, {UserId: "u2"}}}
+			response, err := client.WriteUsers(request, option.WithRequestId("primary"))
+			if response != tt.primary || err != tt.primaryErr {
+				t.Errorf("WriteUsers() = %v, %v, want the primary response", response, err)
+			}
+			client.Wait()
+			if len(shadow.requests) != 1 || len(shadow.requests[0].GetUsers()) != 2 {
+				t.Fatalf("shadow requests = %v", shadow.requests)
+			}
+			if shadow.requestIds[0] != "" {
+				t.Errorf("shadow request id = %q, want it's not shared", shadow.requestIds[0])
+			}
+			if !reflect.DeepEqual(gotDiff, tt.wantDiff) {
+				t.Errorf("diff = %v, want %v", gotDiff, tt.wantDiff)
+			}
+			if stats := client.Stats(); stats.Mirrored != 1 || stats.Differed != tt.wantDiffered {
+				t.Errorf("Stats() = %+v", stats)
+			}
+		})
+	}
+}
+
+func TestDualWriteClient_maxPending(t *testing.T) {
+	success := &protocol.Status{Code: 0}
+	primary := &mockRetailClient{response: &v1.WriteUsersResponse{Status: success}}
+	shadow := &mockRetailV2Client{response: &v2.WriteUsersResponse{Status: success}, block: make(chan struct{})}
+	client := NewDualWriteClient(primary, shadow, &DualWriteConfig{MaxPending: 2})
+	request := &v1.WriteUsersRequest{Users: []*v1.User{{UserId: "u1"}}}
+	for i := 0; i < 3; i++ {
+		if _, err := client.WriteUsers(request); err != nil {
+			t.Fatalf("WriteUsers() error = %v", err)
+		}
+	}
+	close(shadow.block)
+	client.Wait()
+	if stats := client.Stats(); stats.Mirrored != 2 || stats.Dropped != 1 {
+		t.Errorf("Stats() = %+v", stats)
+	}
+	// the pending writes are released after completed
+	if _, err := client.WriteUsers(request); err != nil {
+		t.Fatalf("WriteUsers() error = %v", err)
+	}
+	client.Wait()
+	if stats := client.Stats(); stats.Mirrored != 3 || stats.Dropped != 1 {
+		t.Errorf("Stats() after completed = %+v", stats)
+	}
+}
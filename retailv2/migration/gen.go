@@ -0,0 +1,209 @@
+//go:build ignore
+// +build ignore
+
+// gen generates convert_gen.go, the converters between the User, Product and
+// UserEvent messages of retail/protocol and retailv2/protocol, by walking the
+// descriptors of both packages. Run it by `go generate` after the protos change
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"io/ioutil"
+	"log"
+	"strings"
+
+	v1 "github.com/byteplus-sdk/sdk-go/retail/protocol"
+	v2 "github.com/byteplus-sdk/sdk-go/retailv2/protocol"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+const output = "convert_gen.go"
+
+var plurals = map[string]string{
+	"User":      "Users",
+	"Product":   "Products",
+	"UserEvent": "UserEvents",
+}
+
+type generator struct {
+	buf  bytes.Buffer
+	v1   protoreflect.FileDescriptor
+	v2   protoreflect.FileDescriptor
+	done map[protoreflect.FullName]bool
+	// the nested messages used by repeated fields, which need slice converters
+	slices map[protoreflect.FullName]bool
+}
+
+func main() {
+	g := &generator{
+		v1:     v1.File_byteplus_retail_proto,
+		v2:     v2.File_byteplus_retailv2_proto,
+		done:   make(map[protoreflect.FullName]bool),
+		slices: make(map[protoreflect.FullName]bool),
+	}
+	g.printf("// Code generated by gen.go. DO NOT EDIT.\n\n")
+	g.printf("package migration\n\n")
+	g.printf("import (\n")
+	g.printf("v1 %q\n", "github.com/byteplus-sdk/sdk-go/retail/protocol")
+	g.printf("v2 %q\n", "github.com/byteplus-sdk/sdk-go/retailv2/protocol")
+	g.printf(")\n")
+	for _, name := range []protoreflect.Name{"User", "Product", "UserEvent"} {
+		from := g.v1.Messages().ByName(name)
+		to := g.v2.Messages().ByName(name)
+		if from == nil || to == nil {
+			log.Fatalf("message %s is missing", name)
+		}
+		g.message(from, to, "v1", "v2", "ToV2")
+		g.message(to, from, "v2", "v1", "FromV2")
+	}
+	source, err := format.Source(g.buf.Bytes())
+	if err != nil {
+		log.Fatalf("format fail, err:%s\n%s", err, g.buf.String())
+	}
+	if err := ioutil.WriteFile(output, source, 0644); err != nil {
+		log.Fatalf("write fail, err:%s", err)
+	}
+}
+
+func (g *generator) printf(format string, args ...interface{}) {
+	fmt.Fprintf(&g.buf, format, args...)
+}
+
+// message generates the converter of a message, and its slice if it's a top level
+// message or used by repeated fields, then the converters of its nested messages
+func (g *generator) message(from, to protoreflect.MessageDescriptor, fromPkg, toPkg, suffix string) {
+	key := protoreflect.FullName(fromPkg) + "." + from.FullName()
+	if g.done[key] {
+		return
+	}
+	g.done[key] = true
+	name, sliceName := funcNames(from, suffix)
+	fromType := fromPkg + "." + goTypeName(from)
+	toType := toPkg + "." + goTypeName(to)
+	var nested [][2]protoreflect.MessageDescriptor
+
+	g.printf("\n")
+	if from.Parent() == from.ParentFile() {
+		g.printf("// %s converts %s to %s, the nil is converted to nil\n", name, fromType, toType)
+	}
+	g.printf("func %s(from *%s) *%s {\n", name, fromType, toType)
+	g.printf("if from == nil {\nreturn nil\n}\n")
+	g.printf("return &%s{\n", toType)
+	fields := from.Fields()
+	for i := 0; i < fields.Len(); i++ {
+		field := fields.Get(i)
+		toField := to.Fields().ByName(field.Name())
+		if toField == nil {
+			g.printf("// %s is dropped as %s has no such field\n", field.Name(), toType)
+			continue
+		}
+		if field.Kind() != toField.Kind() || field.Cardinality() != toField.Cardinality() ||
+			field.IsMap() != toField.IsMap() {
+			log.Fatalf("field %s of %s has different types", field.Name(), from.FullName())
+		}
+		goName := goCamelCase(string(field.Name()))
+		switch {
+		case field.IsMap():
+			if field.MapKey().Kind() != protoreflect.StringKind || field.MapValue().Kind() != protoreflect.StringKind {
+				log.Fatalf("map field %s of %s is unsupported", field.Name(), from.FullName())
+			}
+			g.printf("%s: copyStringMap(from.%s),\n", goName, goName)
+		case field.Kind() == protoreflect.MessageKind:
+			nested = append(nested, [2]protoreflect.MessageDescriptor{field.Message(), toField.Message()})
+			fieldFunc, fieldSliceFunc := funcNames(field.Message(), suffix)
+			if field.IsList() {
+				g.slices[protoreflect.FullName(fromPkg)+"."+field.Message().FullName()] = true
+				g.printf("%s: %s(from.%s),\n", goName, fieldSliceFunc, goName)
+			} else {
+				g.printf("%s: %s(from.%s),\n", goName, fieldFunc, goName)
+			}
+		case field.Kind() == protoreflect.EnumKind || field.Kind() == protoreflect.GroupKind ||
+			field.ContainingOneof() != nil:
+			log.Fatalf("field %s of %s is unsupported", field.Name(), from.FullName())
+		case field.IsList():
+			g.printf("%s: append([]%s(nil), from.%s...),\n", goName, goScalarType(field.Kind()), goName)
+		default:
+			g.printf("%s: from.%s,\n", goName, goName)
+		}
+	}
+	g.printf("}\n}\n")
+
+	topLevel := from.Parent() == from.ParentFile()
+	if topLevel || g.slices[key] {
+		g.slice(name, sliceName, fromType, toType, topLevel)
+	}
+	for _, pair := range nested {
+		g.message(pair[0], pair[1], fromPkg, toPkg, suffix)
+	}
+}
+
+func (g *generator) slice(name, sliceName, fromType, toType string, topLevel bool) {
+	g.printf("\n")
+	if topLevel {
+		g.printf("// %s converts each of %s by %s\n", sliceName, fromType, name)
+	}
+	g.printf("func %s(from []*%s) []*%s {\n", sliceName, fromType, toType)
+	g.printf("if from == nil {\nreturn nil\n}\n")
+	g.printf("result := make([]*%s, 0, len(from))\n", toType)
+	g.printf("for _, item := range from {\nresult = append(result, %s(item))\n}\n", name)
+	g.printf("return result\n}\n")
+}
+
+// funcNames returns the names of the converters of message and its slice, the
+// converters of top level messages are exported, e.g. UserToV2 and UsersToV2
+func funcNames(message protoreflect.MessageDescriptor, suffix string) (string, string) {
+	if plural, exist := plurals[string(message.Name())]; exist && message.Parent() == message.ParentFile() {
+		return string(message.Name()) + suffix, plural + suffix
+	}
+	name := strings.ReplaceAll(goTypeName(message), "_", "")
+	name = strings.ToLower(name[:1]) + name[1:]
+	return name + suffix, name + "Slice" + suffix
+}
+
+// goTypeName returns the go type name of message, e.g. Product_Category_CategoryNode
+func goTypeName(message protoreflect.MessageDescriptor) string {
+	name := string(message.Name())
+	for parent := message.Parent(); parent != message.ParentFile(); parent = parent.Parent() {
+		name = string(parent.Name()) + "_" + name
+	}
+	return name
+}
+
+// goCamelCase returns the go field name of proto field name, e.g. user_id to UserId
+func goCamelCase(name string) string {
+	var result strings.Builder
+	for _, part := range strings.Split(name, "_") {
+		if part == "" {
+			continue
+		}
+		result.WriteString(strings.ToUpper(part[:1]) + part[1:])
+	}
+	return result.String()
+}
+
+func goScalarType(kind protoreflect.Kind) string {
+	switch kind {
+	case protoreflect.StringKind:
+		return "string"
+	case protoreflect.BoolKind:
+		return "bool"
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		return "int32"
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		return "int64"
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		return "uint32"
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		return "uint64"
+	case protoreflect.FloatKind:
+		return "float32"
+	case protoreflect.DoubleKind:
+		return "float64"
+	case protoreflect.BytesKind:
+		return "[]byte"
+	}
+	log.Fatalf("kind %s is unsupported", kind)
+	return ""
+}
@@ -375,6 +375,414 @@ func (x *WriteUsersResponse) GetErrors() []*UserError {
 	return nil
 }
 
+// The inline source for the input config for ImportUsers method.
+type UsersInlineSource struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Optional.
+	// A list of users to import. The max # of items supported is 2k.
+	Users []*User `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+}
+
+func (x *UsersInlineSource) Reset() {
+	*x = UsersInlineSource{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_byteplus_retailv2_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UsersInlineSource) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UsersInlineSource) ProtoMessage() {}
+
+func (x *UsersInlineSource) ProtoReflect() protoreflect.Message {
+	mi := &file_byteplus_retailv2_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UsersInlineSource.ProtoReflect.Descriptor instead.
+func (*UsersInlineSource) Descriptor() ([]byte, []int) {
+	return file_byteplus_retailv2_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *UsersInlineSource) GetUsers() []*User {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+// The input config for the source data.
+type UsersInputConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Required.
+	// The source of the input.
+	//
+	// Types that are assignable to Source:
+	//	*UsersInputConfig_UsersInlineSource
+	Source isUsersInputConfig_Source `protobuf_oneof:"source"`
+}
+
+func (x *UsersInputConfig) Reset() {
+	*x = UsersInputConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_byteplus_retailv2_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UsersInputConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UsersInputConfig) ProtoMessage() {}
+
+func (x *UsersInputConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_byteplus_retailv2_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UsersInputConfig.ProtoReflect.Descriptor instead.
+func (*UsersInputConfig) Descriptor() ([]byte, []int) {
+	return file_byteplus_retailv2_proto_rawDescGZIP(), []int{5}
+}
+
+func (m *UsersInputConfig) GetSource() isUsersInputConfig_Source {
+	if m != nil {
+		return m.Source
+	}
+	return nil
+}
+
+func (x *UsersInputConfig) GetUsersInlineSource() *UsersInlineSource {
+	if x, ok := x.GetSource().(*UsersInputConfig_UsersInlineSource); ok {
+		return x.UsersInlineSource
+	}
+	return nil
+}
+
+type isUsersInputConfig_Source interface {
+	isUsersInputConfig_Source()
+}
+
+type UsersInputConfig_UsersInlineSource struct {
+	// The Inline source for the input content for users.
+	UsersInlineSource *UsersInlineSource `protobuf:"bytes,1,opt,name=users_inline_source,json=usersInlineSource,proto3,oneof"`
+}
+
+func (*UsersInputConfig_UsersInlineSource) isUsersInputConfig_Source() {}
+
+// The config proto for the date.
+type DateConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Required.
+	// This should be the same as all dates from `XXXInputConfig`
+	// Any violation will result in a standard 400 error.
+	// The value of date should to be formatted like this:
+	// " yyyy-mm-dd ", for example, "2021-06-10"
+	Date string `protobuf:"bytes,2,opt,name=date,proto3" json:"date,omitempty"`
+	// Optional.
+	// If true, this means the transmission of the data on `date` is 100% done.
+	// If not called or delayed, an email alert is fired.
+	// Note: This has a significant impact on the performance, so please make sure
+	// you call this correctly (timely and accurately).
+	// Once a `date` is finalized, any new data with this `date` will be rejected.
+	// There, please make sure `is_end` is sent when all the data of the `date`
+	// is done transmitting. You could do this by sending the last request as an
+	// empty request with only `is_end` being true after all the previous requests
+	// done transmitting.
+	IsEnd bool `protobuf:"varint,3,opt,name=is_end,json=isEnd,proto3" json:"is_end,omitempty"`
+}
+
+func (x *DateConfig) Reset() {
+	*x = DateConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_byteplus_retailv2_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DateConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DateConfig) ProtoMessage() {}
+
+func (x *DateConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_byteplus_retailv2_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DateConfig.ProtoReflect.Descriptor instead.
+func (*DateConfig) Descriptor() ([]byte, []int) {
+	return file_byteplus_retailv2_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *DateConfig) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *DateConfig) GetIsEnd() bool {
+	if x != nil {
+		return x.IsEnd
+	}
+	return false
+}
+
+// Configuration of destination for Import related errors.
+type ImportErrorsConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Required.
+	// Errors destination. Currently only default to inline.
+	//
+	// Types that are assignable to Destination:
+	//	*ImportErrorsConfig_EmptyDestination
+	Destination isImportErrorsConfig_Destination `protobuf_oneof:"destination"`
+}
+
+func (x *ImportErrorsConfig) Reset() {
+	*x = ImportErrorsConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_byteplus_retailv2_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportErrorsConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportErrorsConfig) ProtoMessage() {}
+
+func (x *ImportErrorsConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_byteplus_retailv2_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportErrorsConfig.ProtoReflect.Descriptor instead.
+func (*ImportErrorsConfig) Descriptor() ([]byte, []int) {
+	return file_byteplus_retailv2_proto_rawDescGZIP(), []int{7}
+}
+
+func (m *ImportErrorsConfig) GetDestination() isImportErrorsConfig_Destination {
+	if m != nil {
+		return m.Destination
+	}
+	return nil
+}
+
+func (x *ImportErrorsConfig) GetEmptyDestination() string {
+	if x, ok := x.GetDestination().(*ImportErrorsConfig_EmptyDestination); ok {
+		return x.EmptyDestination
+	}
+	return ""
+}
+
+type isImportErrorsConfig_Destination interface {
+	isImportErrorsConfig_Destination()
+}
+
+type ImportErrorsConfig_EmptyDestination struct {
+	// This is just a placeholder.
+	EmptyDestination string `protobuf:"bytes,1,opt,name=empty_destination,json=emptyDestination,proto3,oneof"`
+}
+
+func (*ImportErrorsConfig_EmptyDestination) isImportErrorsConfig_Destination() {}
+
+// Request proto for the ImportUsers request.
+type ImportUsersRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Required.
+	// The desired input config of the data.
+	InputConfig *UsersInputConfig `protobuf:"bytes,1,opt,name=input_config,json=inputConfig,proto3" json:"input_config,omitempty"`
+	// Required.
+	// The desired date config of the data.
+	DateConfig *DateConfig `protobuf:"bytes,2,opt,name=date_config,json=dateConfig,proto3" json:"date_config,omitempty"`
+	// Optional.
+	// The desired location of errors incurred during the Import.
+	ErrorsConfig *ImportErrorsConfig `protobuf:"bytes,3,opt,name=errors_config,json=errorsConfig,proto3" json:"errors_config,omitempty"`
+	// Optional.
+	// This is a catch-all field to pass all the additional information.
+	// Please provide as much information as possible.
+	Extra map[string]string `protobuf:"bytes,100,rep,name=extra,proto3" json:"extra,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *ImportUsersRequest) Reset() {
+	*x = ImportUsersRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_byteplus_retailv2_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportUsersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportUsersRequest) ProtoMessage() {}
+
+func (x *ImportUsersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_byteplus_retailv2_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportUsersRequest.ProtoReflect.Descriptor instead.
+func (*ImportUsersRequest) Descriptor() ([]byte, []int) {
+	return file_byteplus_retailv2_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ImportUsersRequest) GetInputConfig() *UsersInputConfig {
+	if x != nil {
+		return x.InputConfig
+	}
+	return nil
+}
+
+func (x *ImportUsersRequest) GetDateConfig() *DateConfig {
+	if x != nil {
+		return x.DateConfig
+	}
+	return nil
+}
+
+func (x *ImportUsersRequest) GetErrorsConfig() *ImportErrorsConfig {
+	if x != nil {
+		return x.ErrorsConfig
+	}
+	return nil
+}
+
+func (x *ImportUsersRequest) GetExtra() map[string]string {
+	if x != nil {
+		return x.Extra
+	}
+	return nil
+}
+
+// The response proto for ImportUsers call.
+type ImportUsersResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Output only.
+	// The status of the import call.
+	// When `status.code` is 0, all payload are successfully imported.
+	// If `status.code` is 1001, `error_samples` is populated.
+	// Other non-zero values indicate all payload failed.
+	Status *protocol.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	// Output only.
+	// This field is populated when `status.code` is 1001.
+	// When populated, this field contains the error samples.
+	ErrorSamples []*UserError `protobuf:"bytes,2,rep,name=error_samples,json=errorSamples,proto3" json:"error_samples,omitempty"`
+}
+
+func (x *ImportUsersResponse) Reset() {
+	*x = ImportUsersResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_byteplus_retailv2_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportUsersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportUsersResponse) ProtoMessage() {}
+
+func (x *ImportUsersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_byteplus_retailv2_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportUsersResponse.ProtoReflect.Descriptor instead.
+func (*ImportUsersResponse) Descriptor() ([]byte, []int) {
+	return file_byteplus_retailv2_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ImportUsersResponse) GetStatus() *protocol.Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *ImportUsersResponse) GetErrorSamples() []*UserError {
+	if x != nil {
+		return x.ErrorSamples
+	}
+	return nil
+}
+
 // The product proto.
 type Product struct {
 	state         protoimpl.MessageState
@@ -466,7 +874,7 @@ type Product struct {
 func (x *Product) Reset() {
 	*x = Product{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_byteplus_retailv2_proto_msgTypes[4]
+		mi := &file_byteplus_retailv2_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -479,7 +887,7 @@ func (x *Product) String() string {
 func (*Product) ProtoMessage() {}
 
 func (x *Product) ProtoReflect() protoreflect.Message {
-	mi := &file_byteplus_retailv2_proto_msgTypes[4]
+	mi := &file_byteplus_retailv2_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -492,7 +900,7 @@ func (x *Product) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Product.ProtoReflect.Descriptor instead.
 func (*Product) Descriptor() ([]byte, []int) {
-	return file_byteplus_retailv2_proto_rawDescGZIP(), []int{4}
+	return file_byteplus_retailv2_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *Product) GetProductId() string {
@@ -597,7 +1005,7 @@ type WriteProductsRequest struct {
 func (x *WriteProductsRequest) Reset() {
 	*x = WriteProductsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_byteplus_retailv2_proto_msgTypes[5]
+		mi := &file_byteplus_retailv2_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -610,7 +1018,7 @@ func (x *WriteProductsRequest) String() string {
 func (*WriteProductsRequest) ProtoMessage() {}
 
 func (x *WriteProductsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_byteplus_retailv2_proto_msgTypes[5]
+	mi := &file_byteplus_retailv2_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -623,7 +1031,7 @@ func (x *WriteProductsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WriteProductsRequest.ProtoReflect.Descriptor instead.
 func (*WriteProductsRequest) Descriptor() ([]byte, []int) {
-	return file_byteplus_retailv2_proto_rawDescGZIP(), []int{5}
+	return file_byteplus_retailv2_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *WriteProductsRequest) GetProducts() []*Product {
@@ -657,7 +1065,7 @@ type ProductError struct {
 func (x *ProductError) Reset() {
 	*x = ProductError{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_byteplus_retailv2_proto_msgTypes[6]
+		mi := &file_byteplus_retailv2_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -670,7 +1078,70 @@ func (x *ProductError) String() string {
 func (*ProductError) ProtoMessage() {}
 
 func (x *ProductError) ProtoReflect() protoreflect.Message {
-	mi := &file_byteplus_retailv2_proto_msgTypes[6]
+	mi := &file_byteplus_retailv2_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProductError.ProtoReflect.Descriptor instead.
+func (*ProductError) Descriptor() ([]byte, []int) {
+	return file_byteplus_retailv2_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ProductError) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ProductError) GetProduct() *Product {
+	if x != nil {
+		return x.Product
+	}
+	return nil
+}
+
+// The response for the WriteProduct call.
+type WriteProductsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Output only.
+	// When `status.code` is 0, all payload are successfully written.
+	// If `status.code` is 1001, `errors` is populated.
+	// Other non-zero values indicate all payload failed.
+	Status *protocol.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	// Output only.
+	// This field is populated when `status.code` is 1001.
+	// When populated, this field contains the errors.
+	Errors []*ProductError `protobuf:"bytes,2,rep,name=errors,proto3" json:"errors,omitempty"`
+}
+
+func (x *WriteProductsResponse) Reset() {
+	*x = WriteProductsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_byteplus_retailv2_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WriteProductsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WriteProductsResponse) ProtoMessage() {}
+
+func (x *WriteProductsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_byteplus_retailv2_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -681,59 +1152,263 @@ func (x *ProductError) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ProductError.ProtoReflect.Descriptor instead.
-func (*ProductError) Descriptor() ([]byte, []int) {
-	return file_byteplus_retailv2_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use WriteProductsResponse.ProtoReflect.Descriptor instead.
+func (*WriteProductsResponse) Descriptor() ([]byte, []int) {
+	return file_byteplus_retailv2_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *WriteProductsResponse) GetStatus() *protocol.Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *WriteProductsResponse) GetErrors() []*ProductError {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+// The inline source for the input config for ImportProducts method.
+type ProductsInlineSource struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Optional.
+	// A list of products to import.
+	// The max # of items allowed is 2k.
+	Products []*Product `protobuf:"bytes,1,rep,name=products,proto3" json:"products,omitempty"`
+}
+
+func (x *ProductsInlineSource) Reset() {
+	*x = ProductsInlineSource{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_byteplus_retailv2_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProductsInlineSource) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProductsInlineSource) ProtoMessage() {}
+
+func (x *ProductsInlineSource) ProtoReflect() protoreflect.Message {
+	mi := &file_byteplus_retailv2_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProductsInlineSource.ProtoReflect.Descriptor instead.
+func (*ProductsInlineSource) Descriptor() ([]byte, []int) {
+	return file_byteplus_retailv2_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ProductsInlineSource) GetProducts() []*Product {
+	if x != nil {
+		return x.Products
+	}
+	return nil
+}
+
+// The input config source.
+type ProductsInputConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Required.
+	// The source of the input.
+	//
+	// Types that are assignable to Source:
+	//	*ProductsInputConfig_ProductsInlineSource
+	Source isProductsInputConfig_Source `protobuf_oneof:"source"`
+}
+
+func (x *ProductsInputConfig) Reset() {
+	*x = ProductsInputConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_byteplus_retailv2_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProductsInputConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProductsInputConfig) ProtoMessage() {}
+
+func (x *ProductsInputConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_byteplus_retailv2_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProductsInputConfig.ProtoReflect.Descriptor instead.
+func (*ProductsInputConfig) Descriptor() ([]byte, []int) {
+	return file_byteplus_retailv2_proto_rawDescGZIP(), []int{15}
+}
+
+func (m *ProductsInputConfig) GetSource() isProductsInputConfig_Source {
+	if m != nil {
+		return m.Source
+	}
+	return nil
+}
+
+func (x *ProductsInputConfig) GetProductsInlineSource() *ProductsInlineSource {
+	if x, ok := x.GetSource().(*ProductsInputConfig_ProductsInlineSource); ok {
+		return x.ProductsInlineSource
+	}
+	return nil
+}
+
+type isProductsInputConfig_Source interface {
+	isProductsInputConfig_Source()
+}
+
+type ProductsInputConfig_ProductsInlineSource struct {
+	// The Inline source for the input content for products.
+	ProductsInlineSource *ProductsInlineSource `protobuf:"bytes,1,opt,name=products_inline_source,json=productsInlineSource,proto3,oneof"`
+}
+
+func (*ProductsInputConfig_ProductsInlineSource) isProductsInputConfig_Source() {}
+
+// Request proto for the ImportProducts request.
+type ImportProductsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Required.
+	// The desired input config of the data.
+	InputConfig *ProductsInputConfig `protobuf:"bytes,1,opt,name=input_config,json=inputConfig,proto3" json:"input_config,omitempty"`
+	// Required.
+	// The desired date config of the data.
+	DateConfig *DateConfig `protobuf:"bytes,2,opt,name=date_config,json=dateConfig,proto3" json:"date_config,omitempty"`
+	// Optional.
+	// The desired config of errors incurred during the Import.
+	ErrorsConfig *ImportErrorsConfig `protobuf:"bytes,3,opt,name=errors_config,json=errorsConfig,proto3" json:"errors_config,omitempty"`
+	// Optional.
+	// This is a catch-all field to pass all the additional information.
+	// Please provide as much information as possible.
+	Extra map[string]string `protobuf:"bytes,100,rep,name=extra,proto3" json:"extra,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *ImportProductsRequest) Reset() {
+	*x = ImportProductsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_byteplus_retailv2_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportProductsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportProductsRequest) ProtoMessage() {}
+
+func (x *ImportProductsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_byteplus_retailv2_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportProductsRequest.ProtoReflect.Descriptor instead.
+func (*ImportProductsRequest) Descriptor() ([]byte, []int) {
+	return file_byteplus_retailv2_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ImportProductsRequest) GetInputConfig() *ProductsInputConfig {
+	if x != nil {
+		return x.InputConfig
+	}
+	return nil
+}
+
+func (x *ImportProductsRequest) GetDateConfig() *DateConfig {
+	if x != nil {
+		return x.DateConfig
+	}
+	return nil
 }
 
-func (x *ProductError) GetMessage() string {
+func (x *ImportProductsRequest) GetErrorsConfig() *ImportErrorsConfig {
 	if x != nil {
-		return x.Message
+		return x.ErrorsConfig
 	}
-	return ""
+	return nil
 }
 
-func (x *ProductError) GetProduct() *Product {
+func (x *ImportProductsRequest) GetExtra() map[string]string {
 	if x != nil {
-		return x.Product
+		return x.Extra
 	}
 	return nil
 }
 
-// The response for the WriteProduct call.
-type WriteProductsResponse struct {
+// The response proto for ImportProducts call.
+type ImportProductsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	// Output only.
-	// When `status.code` is 0, all payload are successfully written.
-	// If `status.code` is 1001, `errors` is populated.
+	// The status of the import call.
+	// When `status.code` is 0, all payload are successfully imported.
+	// If `status.code` is 1001, `error_samples` is populated.
 	// Other non-zero values indicate all payload failed.
 	Status *protocol.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
 	// Output only.
 	// This field is populated when `status.code` is 1001.
-	// When populated, this field contains the errors.
-	Errors []*ProductError `protobuf:"bytes,2,rep,name=errors,proto3" json:"errors,omitempty"`
+	// When populated, this field contains the error samples.
+	ErrorSamples []*ProductError `protobuf:"bytes,2,rep,name=error_samples,json=errorSamples,proto3" json:"error_samples,omitempty"`
 }
 
-func (x *WriteProductsResponse) Reset() {
-	*x = WriteProductsResponse{}
+func (x *ImportProductsResponse) Reset() {
+	*x = ImportProductsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_byteplus_retailv2_proto_msgTypes[7]
+		mi := &file_byteplus_retailv2_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *WriteProductsResponse) String() string {
+func (x *ImportProductsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WriteProductsResponse) ProtoMessage() {}
+func (*ImportProductsResponse) ProtoMessage() {}
 
-func (x *WriteProductsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_byteplus_retailv2_proto_msgTypes[7]
+func (x *ImportProductsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_byteplus_retailv2_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -744,21 +1419,21 @@ func (x *WriteProductsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WriteProductsResponse.ProtoReflect.Descriptor instead.
-func (*WriteProductsResponse) Descriptor() ([]byte, []int) {
-	return file_byteplus_retailv2_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use ImportProductsResponse.ProtoReflect.Descriptor instead.
+func (*ImportProductsResponse) Descriptor() ([]byte, []int) {
+	return file_byteplus_retailv2_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *WriteProductsResponse) GetStatus() *protocol.Status {
+func (x *ImportProductsResponse) GetStatus() *protocol.Status {
 	if x != nil {
 		return x.Status
 	}
 	return nil
 }
 
-func (x *WriteProductsResponse) GetErrors() []*ProductError {
+func (x *ImportProductsResponse) GetErrorSamples() []*ProductError {
 	if x != nil {
-		return x.Errors
+		return x.ErrorSamples
 	}
 	return nil
 }
@@ -868,7 +1543,7 @@ type UserEvent struct {
 func (x *UserEvent) Reset() {
 	*x = UserEvent{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_byteplus_retailv2_proto_msgTypes[8]
+		mi := &file_byteplus_retailv2_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -881,7 +1556,7 @@ func (x *UserEvent) String() string {
 func (*UserEvent) ProtoMessage() {}
 
 func (x *UserEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_byteplus_retailv2_proto_msgTypes[8]
+	mi := &file_byteplus_retailv2_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -894,7 +1569,7 @@ func (x *UserEvent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UserEvent.ProtoReflect.Descriptor instead.
 func (*UserEvent) Descriptor() ([]byte, []int) {
-	return file_byteplus_retailv2_proto_rawDescGZIP(), []int{8}
+	return file_byteplus_retailv2_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *UserEvent) GetUserId() string {
@@ -967,59 +1642,293 @@ func (x *UserEvent) GetTrafficSource() string {
 	return ""
 }
 
-func (x *UserEvent) GetPurchaseCount() int32 {
-	if x != nil {
-		return x.PurchaseCount
+func (x *UserEvent) GetPurchaseCount() int32 {
+	if x != nil {
+		return x.PurchaseCount
+	}
+	return 0
+}
+
+func (x *UserEvent) GetDetailPageStayTime() int32 {
+	if x != nil {
+		return x.DetailPageStayTime
+	}
+	return 0
+}
+
+func (x *UserEvent) GetExtra() map[string]string {
+	if x != nil {
+		return x.Extra
+	}
+	return nil
+}
+
+// Request proto for WriteUserEvent method.
+type WriteUserEventsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Required. A list of user events to write.
+	// The max # of events allowed is 2000.
+	UserEvents []*UserEvent `protobuf:"bytes,1,rep,name=user_events,json=userEvents,proto3" json:"user_events,omitempty"`
+	// Optional.
+	// This is a catch-all field to pass all the additional information.
+	// Please provide as much information as possible.
+	Extra map[string]string `protobuf:"bytes,100,rep,name=extra,proto3" json:"extra,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *WriteUserEventsRequest) Reset() {
+	*x = WriteUserEventsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_byteplus_retailv2_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WriteUserEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WriteUserEventsRequest) ProtoMessage() {}
+
+func (x *WriteUserEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_byteplus_retailv2_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WriteUserEventsRequest.ProtoReflect.Descriptor instead.
+func (*WriteUserEventsRequest) Descriptor() ([]byte, []int) {
+	return file_byteplus_retailv2_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *WriteUserEventsRequest) GetUserEvents() []*UserEvent {
+	if x != nil {
+		return x.UserEvents
+	}
+	return nil
+}
+
+func (x *WriteUserEventsRequest) GetExtra() map[string]string {
+	if x != nil {
+		return x.Extra
+	}
+	return nil
+}
+
+// The error detail for a single user event.
+type UserEventError struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Output only.
+	// The detailed error message.
+	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	// Output only.
+	// This is the exact same user proto that was passed in the request.
+	UserEvent *UserEvent `protobuf:"bytes,2,opt,name=user_event,json=userEvent,proto3" json:"user_event,omitempty"`
+}
+
+func (x *UserEventError) Reset() {
+	*x = UserEventError{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_byteplus_retailv2_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UserEventError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserEventError) ProtoMessage() {}
+
+func (x *UserEventError) ProtoReflect() protoreflect.Message {
+	mi := &file_byteplus_retailv2_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserEventError.ProtoReflect.Descriptor instead.
+func (*UserEventError) Descriptor() ([]byte, []int) {
+	return file_byteplus_retailv2_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *UserEventError) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *UserEventError) GetUserEvent() *UserEvent {
+	if x != nil {
+		return x.UserEvent
+	}
+	return nil
+}
+
+// The response proto for WriteUserEvents.
+type WriteUserEventsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Output only.
+	// When `status.code` is 0, all payload are successfully written.
+	// If `status.code` is 1001, `errors` is populated.
+	// Other non-zero values indicate all payload failed.
+	Status *protocol.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	// Output only.
+	// This field is populated when `status.code` is 1001.
+	// When populated, this field contains the errors.
+	Errors []*UserEventError `protobuf:"bytes,2,rep,name=errors,proto3" json:"errors,omitempty"`
+}
+
+func (x *WriteUserEventsResponse) Reset() {
+	*x = WriteUserEventsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_byteplus_retailv2_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WriteUserEventsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WriteUserEventsResponse) ProtoMessage() {}
+
+func (x *WriteUserEventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_byteplus_retailv2_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WriteUserEventsResponse.ProtoReflect.Descriptor instead.
+func (*WriteUserEventsResponse) Descriptor() ([]byte, []int) {
+	return file_byteplus_retailv2_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *WriteUserEventsResponse) GetStatus() *protocol.Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *WriteUserEventsResponse) GetErrors() []*UserEventError {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+// The inline source for the input config for ImportUserEvents method.
+type UserEventsInlineSource struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Optional.
+	// A list of user events to import.
+	// The max # of items allowed is 2k.
+	UserEvents []*UserEvent `protobuf:"bytes,1,rep,name=user_events,json=userEvents,proto3" json:"user_events,omitempty"`
+}
+
+func (x *UserEventsInlineSource) Reset() {
+	*x = UserEventsInlineSource{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_byteplus_retailv2_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UserEventsInlineSource) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserEventsInlineSource) ProtoMessage() {}
+
+func (x *UserEventsInlineSource) ProtoReflect() protoreflect.Message {
+	mi := &file_byteplus_retailv2_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *UserEvent) GetDetailPageStayTime() int32 {
-	if x != nil {
-		return x.DetailPageStayTime
-	}
-	return 0
+// Deprecated: Use UserEventsInlineSource.ProtoReflect.Descriptor instead.
+func (*UserEventsInlineSource) Descriptor() ([]byte, []int) {
+	return file_byteplus_retailv2_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *UserEvent) GetExtra() map[string]string {
+func (x *UserEventsInlineSource) GetUserEvents() []*UserEvent {
 	if x != nil {
-		return x.Extra
+		return x.UserEvents
 	}
 	return nil
 }
 
-// Request proto for WriteUserEvent method.
-type WriteUserEventsRequest struct {
+// The input config source.
+type UserEventsInputConfig struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Required. A list of user events to write.
-	// The max # of events allowed is 2000.
-	UserEvents []*UserEvent `protobuf:"bytes,1,rep,name=user_events,json=userEvents,proto3" json:"user_events,omitempty"`
-	// Optional.
-	// This is a catch-all field to pass all the additional information.
-	// Please provide as much information as possible.
-	Extra map[string]string `protobuf:"bytes,100,rep,name=extra,proto3" json:"extra,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Required.
+	// The source of the input.
+	//
+	// Types that are assignable to Source:
+	//	*UserEventsInputConfig_UserEventsInlineSource
+	Source isUserEventsInputConfig_Source `protobuf_oneof:"source"`
 }
 
-func (x *WriteUserEventsRequest) Reset() {
-	*x = WriteUserEventsRequest{}
+func (x *UserEventsInputConfig) Reset() {
+	*x = UserEventsInputConfig{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_byteplus_retailv2_proto_msgTypes[9]
+		mi := &file_byteplus_retailv2_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *WriteUserEventsRequest) String() string {
+func (x *UserEventsInputConfig) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WriteUserEventsRequest) ProtoMessage() {}
+func (*UserEventsInputConfig) ProtoMessage() {}
 
-func (x *WriteUserEventsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_byteplus_retailv2_proto_msgTypes[9]
+func (x *UserEventsInputConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_byteplus_retailv2_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1030,56 +1939,74 @@ func (x *WriteUserEventsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WriteUserEventsRequest.ProtoReflect.Descriptor instead.
-func (*WriteUserEventsRequest) Descriptor() ([]byte, []int) {
-	return file_byteplus_retailv2_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use UserEventsInputConfig.ProtoReflect.Descriptor instead.
+func (*UserEventsInputConfig) Descriptor() ([]byte, []int) {
+	return file_byteplus_retailv2_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *WriteUserEventsRequest) GetUserEvents() []*UserEvent {
-	if x != nil {
-		return x.UserEvents
+func (m *UserEventsInputConfig) GetSource() isUserEventsInputConfig_Source {
+	if m != nil {
+		return m.Source
 	}
 	return nil
 }
 
-func (x *WriteUserEventsRequest) GetExtra() map[string]string {
-	if x != nil {
-		return x.Extra
+func (x *UserEventsInputConfig) GetUserEventsInlineSource() *UserEventsInlineSource {
+	if x, ok := x.GetSource().(*UserEventsInputConfig_UserEventsInlineSource); ok {
+		return x.UserEventsInlineSource
 	}
 	return nil
 }
 
-// The error detail for a single user event.
-type UserEventError struct {
+type isUserEventsInputConfig_Source interface {
+	isUserEventsInputConfig_Source()
+}
+
+type UserEventsInputConfig_UserEventsInlineSource struct {
+	// The Inline source for the input content for UserEvents.
+	UserEventsInlineSource *UserEventsInlineSource `protobuf:"bytes,1,opt,name=user_events_inline_source,json=userEventsInlineSource,proto3,oneof"`
+}
+
+func (*UserEventsInputConfig_UserEventsInlineSource) isUserEventsInputConfig_Source() {}
+
+// Request proto for the ImportUserEvents request.
+type ImportUserEventsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Output only.
-	// The detailed error message.
-	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
-	// Output only.
-	// This is the exact same user proto that was passed in the request.
-	UserEvent *UserEvent `protobuf:"bytes,2,opt,name=user_event,json=userEvent,proto3" json:"user_event,omitempty"`
+	// Required.
+	// The desired input location of the data.
+	InputConfig *UserEventsInputConfig `protobuf:"bytes,1,opt,name=input_config,json=inputConfig,proto3" json:"input_config,omitempty"`
+	// Required.
+	// The desired date config of the data.
+	DateConfig *DateConfig `protobuf:"bytes,2,opt,name=date_config,json=dateConfig,proto3" json:"date_config,omitempty"`
+	// Optional.
+	// The desired location of errors incurred during the Import.
+	ErrorsConfig *ImportErrorsConfig `protobuf:"bytes,3,opt,name=errors_config,json=errorsConfig,proto3" json:"errors_config,omitempty"`
+	// Optional.
+	// This is a catch-all field to pass all the additional information.
+	// Please provide as much information as possible.
+	Extra map[string]string `protobuf:"bytes,100,rep,name=extra,proto3" json:"extra,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
-func (x *UserEventError) Reset() {
-	*x = UserEventError{}
+func (x *ImportUserEventsRequest) Reset() {
+	*x = ImportUserEventsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_byteplus_retailv2_proto_msgTypes[10]
+		mi := &file_byteplus_retailv2_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UserEventError) String() string {
+func (x *ImportUserEventsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserEventError) ProtoMessage() {}
+func (*ImportUserEventsRequest) ProtoMessage() {}
 
-func (x *UserEventError) ProtoReflect() protoreflect.Message {
-	mi := &file_byteplus_retailv2_proto_msgTypes[10]
+func (x *ImportUserEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_byteplus_retailv2_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1090,59 +2017,73 @@ func (x *UserEventError) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserEventError.ProtoReflect.Descriptor instead.
-func (*UserEventError) Descriptor() ([]byte, []int) {
-	return file_byteplus_retailv2_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use ImportUserEventsRequest.ProtoReflect.Descriptor instead.
+func (*ImportUserEventsRequest) Descriptor() ([]byte, []int) {
+	return file_byteplus_retailv2_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *UserEventError) GetMessage() string {
+func (x *ImportUserEventsRequest) GetInputConfig() *UserEventsInputConfig {
 	if x != nil {
-		return x.Message
+		return x.InputConfig
 	}
-	return ""
+	return nil
 }
 
-func (x *UserEventError) GetUserEvent() *UserEvent {
+func (x *ImportUserEventsRequest) GetDateConfig() *DateConfig {
 	if x != nil {
-		return x.UserEvent
+		return x.DateConfig
 	}
 	return nil
 }
 
-// The response proto for WriteUserEvents.
-type WriteUserEventsResponse struct {
+func (x *ImportUserEventsRequest) GetErrorsConfig() *ImportErrorsConfig {
+	if x != nil {
+		return x.ErrorsConfig
+	}
+	return nil
+}
+
+func (x *ImportUserEventsRequest) GetExtra() map[string]string {
+	if x != nil {
+		return x.Extra
+	}
+	return nil
+}
+
+// The response for the ImportUserEvents call.
+type ImportUserEventsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	// Output only.
 	// When `status.code` is 0, all payload are successfully written.
-	// If `status.code` is 1001, `errors` is populated.
+	// If `status.code` is 1001, `error_samples` is populated.
 	// Other non-zero values indicate all payload failed.
 	Status *protocol.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
 	// Output only.
 	// This field is populated when `status.code` is 1001.
-	// When populated, this field contains the errors.
-	Errors []*UserEventError `protobuf:"bytes,2,rep,name=errors,proto3" json:"errors,omitempty"`
+	// When populated, this field contains the error samples.
+	ErrorSamples []*UserEventError `protobuf:"bytes,2,rep,name=error_samples,json=errorSamples,proto3" json:"error_samples,omitempty"`
 }
 
-func (x *WriteUserEventsResponse) Reset() {
-	*x = WriteUserEventsResponse{}
+func (x *ImportUserEventsResponse) Reset() {
+	*x = ImportUserEventsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_byteplus_retailv2_proto_msgTypes[11]
+		mi := &file_byteplus_retailv2_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *WriteUserEventsResponse) String() string {
+func (x *ImportUserEventsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WriteUserEventsResponse) ProtoMessage() {}
+func (*ImportUserEventsResponse) ProtoMessage() {}
 
-func (x *WriteUserEventsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_byteplus_retailv2_proto_msgTypes[11]
+func (x *ImportUserEventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_byteplus_retailv2_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1153,21 +2094,21 @@ func (x *WriteUserEventsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WriteUserEventsResponse.ProtoReflect.Descriptor instead.
-func (*WriteUserEventsResponse) Descriptor() ([]byte, []int) {
-	return file_byteplus_retailv2_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use ImportUserEventsResponse.ProtoReflect.Descriptor instead.
+func (*ImportUserEventsResponse) Descriptor() ([]byte, []int) {
+	return file_byteplus_retailv2_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *WriteUserEventsResponse) GetStatus() *protocol.Status {
+func (x *ImportUserEventsResponse) GetStatus() *protocol.Status {
 	if x != nil {
 		return x.Status
 	}
 	return nil
 }
 
-func (x *WriteUserEventsResponse) GetErrors() []*UserEventError {
+func (x *ImportUserEventsResponse) GetErrorSamples() []*UserEventError {
 	if x != nil {
-		return x.Errors
+		return x.ErrorSamples
 	}
 	return nil
 }
@@ -1203,7 +2144,7 @@ type PredictRequest struct {
 func (x *PredictRequest) Reset() {
 	*x = PredictRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_byteplus_retailv2_proto_msgTypes[12]
+		mi := &file_byteplus_retailv2_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1216,7 +2157,7 @@ func (x *PredictRequest) String() string {
 func (*PredictRequest) ProtoMessage() {}
 
 func (x *PredictRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_byteplus_retailv2_proto_msgTypes[12]
+	mi := &file_byteplus_retailv2_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1229,7 +2170,7 @@ func (x *PredictRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PredictRequest.ProtoReflect.Descriptor instead.
 func (*PredictRequest) Descriptor() ([]byte, []int) {
-	return file_byteplus_retailv2_proto_rawDescGZIP(), []int{12}
+	return file_byteplus_retailv2_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *PredictRequest) GetUserId() string {
@@ -1284,7 +2225,7 @@ type PredictResult struct {
 func (x *PredictResult) Reset() {
 	*x = PredictResult{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_byteplus_retailv2_proto_msgTypes[13]
+		mi := &file_byteplus_retailv2_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1297,7 +2238,7 @@ func (x *PredictResult) String() string {
 func (*PredictResult) ProtoMessage() {}
 
 func (x *PredictResult) ProtoReflect() protoreflect.Message {
-	mi := &file_byteplus_retailv2_proto_msgTypes[13]
+	mi := &file_byteplus_retailv2_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1310,7 +2251,7 @@ func (x *PredictResult) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PredictResult.ProtoReflect.Descriptor instead.
 func (*PredictResult) Descriptor() ([]byte, []int) {
-	return file_byteplus_retailv2_proto_rawDescGZIP(), []int{13}
+	return file_byteplus_retailv2_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *PredictResult) GetResponseProducts() []*PredictResult_ResponseProduct {
@@ -1347,7 +2288,7 @@ type PredictResponse struct {
 func (x *PredictResponse) Reset() {
 	*x = PredictResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_byteplus_retailv2_proto_msgTypes[14]
+		mi := &file_byteplus_retailv2_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1360,7 +2301,7 @@ func (x *PredictResponse) String() string {
 func (*PredictResponse) ProtoMessage() {}
 
 func (x *PredictResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_byteplus_retailv2_proto_msgTypes[14]
+	mi := &file_byteplus_retailv2_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1373,7 +2314,7 @@ func (x *PredictResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PredictResponse.ProtoReflect.Descriptor instead.
 func (*PredictResponse) Descriptor() ([]byte, []int) {
-	return file_byteplus_retailv2_proto_rawDescGZIP(), []int{14}
+	return file_byteplus_retailv2_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *PredictResponse) GetStatus() *protocol.Status {
@@ -1431,7 +2372,7 @@ type AckServerImpressionsRequest struct {
 func (x *AckServerImpressionsRequest) Reset() {
 	*x = AckServerImpressionsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_byteplus_retailv2_proto_msgTypes[15]
+		mi := &file_byteplus_retailv2_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1444,7 +2385,7 @@ func (x *AckServerImpressionsRequest) String() string {
 func (*AckServerImpressionsRequest) ProtoMessage() {}
 
 func (x *AckServerImpressionsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_byteplus_retailv2_proto_msgTypes[15]
+	mi := &file_byteplus_retailv2_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1457,7 +2398,7 @@ func (x *AckServerImpressionsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AckServerImpressionsRequest.ProtoReflect.Descriptor instead.
 func (*AckServerImpressionsRequest) Descriptor() ([]byte, []int) {
-	return file_byteplus_retailv2_proto_rawDescGZIP(), []int{15}
+	return file_byteplus_retailv2_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *AckServerImpressionsRequest) GetPredictRequestId() string {
@@ -1516,7 +2457,7 @@ type AckServerImpressionsResponse struct {
 func (x *AckServerImpressionsResponse) Reset() {
 	*x = AckServerImpressionsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_byteplus_retailv2_proto_msgTypes[16]
+		mi := &file_byteplus_retailv2_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1529,7 +2470,7 @@ func (x *AckServerImpressionsResponse) String() string {
 func (*AckServerImpressionsResponse) ProtoMessage() {}
 
 func (x *AckServerImpressionsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_byteplus_retailv2_proto_msgTypes[16]
+	mi := &file_byteplus_retailv2_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1542,7 +2483,7 @@ func (x *AckServerImpressionsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AckServerImpressionsResponse.ProtoReflect.Descriptor instead.
 func (*AckServerImpressionsResponse) Descriptor() ([]byte, []int) {
-	return file_byteplus_retailv2_proto_rawDescGZIP(), []int{16}
+	return file_byteplus_retailv2_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *AckServerImpressionsResponse) GetStatus() *protocol.Status {
@@ -1589,7 +2530,7 @@ type User_Location struct {
 func (x *User_Location) Reset() {
 	*x = User_Location{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_byteplus_retailv2_proto_msgTypes[17]
+		mi := &file_byteplus_retailv2_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1602,7 +2543,7 @@ func (x *User_Location) String() string {
 func (*User_Location) ProtoMessage() {}
 
 func (x *User_Location) ProtoReflect() protoreflect.Message {
-	mi := &file_byteplus_retailv2_proto_msgTypes[17]
+	mi := &file_byteplus_retailv2_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1664,7 +2605,7 @@ type Product_Category struct {
 func (x *Product_Category) Reset() {
 	*x = Product_Category{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_byteplus_retailv2_proto_msgTypes[20]
+		mi := &file_byteplus_retailv2_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1677,7 +2618,7 @@ func (x *Product_Category) String() string {
 func (*Product_Category) ProtoMessage() {}
 
 func (x *Product_Category) ProtoReflect() protoreflect.Message {
-	mi := &file_byteplus_retailv2_proto_msgTypes[20]
+	mi := &file_byteplus_retailv2_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1690,7 +2631,7 @@ func (x *Product_Category) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Product_Category.ProtoReflect.Descriptor instead.
 func (*Product_Category) Descriptor() ([]byte, []int) {
-	return file_byteplus_retailv2_proto_rawDescGZIP(), []int{4, 0}
+	return file_byteplus_retailv2_proto_rawDescGZIP(), []int{10, 0}
 }
 
 func (x *Product_Category) GetCategoryDepth() int32 {
@@ -1724,7 +2665,7 @@ type Product_Brand struct {
 func (x *Product_Brand) Reset() {
 	*x = Product_Brand{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_byteplus_retailv2_proto_msgTypes[21]
+		mi := &file_byteplus_retailv2_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1737,7 +2678,7 @@ func (x *Product_Brand) String() string {
 func (*Product_Brand) ProtoMessage() {}
 
 func (x *Product_Brand) ProtoReflect() protoreflect.Message {
-	mi := &file_byteplus_retailv2_proto_msgTypes[21]
+	mi := &file_byteplus_retailv2_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1750,7 +2691,7 @@ func (x *Product_Brand) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Product_Brand.ProtoReflect.Descriptor instead.
 func (*Product_Brand) Descriptor() ([]byte, []int) {
-	return file_byteplus_retailv2_proto_rawDescGZIP(), []int{4, 1}
+	return file_byteplus_retailv2_proto_rawDescGZIP(), []int{10, 1}
 }
 
 func (x *Product_Brand) GetBrandDepth() int32 {
@@ -1788,7 +2729,7 @@ type Product_Price struct {
 func (x *Product_Price) Reset() {
 	*x = Product_Price{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_byteplus_retailv2_proto_msgTypes[22]
+		mi := &file_byteplus_retailv2_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1801,7 +2742,7 @@ func (x *Product_Price) String() string {
 func (*Product_Price) ProtoMessage() {}
 
 func (x *Product_Price) ProtoReflect() protoreflect.Message {
-	mi := &file_byteplus_retailv2_proto_msgTypes[22]
+	mi := &file_byteplus_retailv2_proto_msgTypes[37]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1814,7 +2755,7 @@ func (x *Product_Price) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Product_Price.ProtoReflect.Descriptor instead.
 func (*Product_Price) Descriptor() ([]byte, []int) {
-	return file_byteplus_retailv2_proto_rawDescGZIP(), []int{4, 2}
+	return file_byteplus_retailv2_proto_rawDescGZIP(), []int{10, 2}
 }
 
 func (x *Product_Price) GetCurrentPrice() int64 {
@@ -1862,7 +2803,7 @@ type Product_Display struct {
 func (x *Product_Display) Reset() {
 	*x = Product_Display{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_byteplus_retailv2_proto_msgTypes[23]
+		mi := &file_byteplus_retailv2_proto_msgTypes[38]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1875,7 +2816,7 @@ func (x *Product_Display) String() string {
 func (*Product_Display) ProtoMessage() {}
 
 func (x *Product_Display) ProtoReflect() protoreflect.Message {
-	mi := &file_byteplus_retailv2_proto_msgTypes[23]
+	mi := &file_byteplus_retailv2_proto_msgTypes[38]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1888,7 +2829,7 @@ func (x *Product_Display) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Product_Display.ProtoReflect.Descriptor instead.
 func (*Product_Display) Descriptor() ([]byte, []int) {
-	return file_byteplus_retailv2_proto_rawDescGZIP(), []int{4, 3}
+	return file_byteplus_retailv2_proto_rawDescGZIP(), []int{10, 3}
 }
 
 func (x *Product_Display) GetListingPageDisplayTags() []string {
@@ -1950,7 +2891,7 @@ type Product_ProductSpec struct {
 func (x *Product_ProductSpec) Reset() {
 	*x = Product_ProductSpec{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_byteplus_retailv2_proto_msgTypes[24]
+		mi := &file_byteplus_retailv2_proto_msgTypes[39]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1963,7 +2904,7 @@ func (x *Product_ProductSpec) String() string {
 func (*Product_ProductSpec) ProtoMessage() {}
 
 func (x *Product_ProductSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_byteplus_retailv2_proto_msgTypes[24]
+	mi := &file_byteplus_retailv2_proto_msgTypes[39]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1976,7 +2917,7 @@ func (x *Product_ProductSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Product_ProductSpec.ProtoReflect.Descriptor instead.
 func (*Product_ProductSpec) Descriptor() ([]byte, []int) {
-	return file_byteplus_retailv2_proto_rawDescGZIP(), []int{4, 4}
+	return file_byteplus_retailv2_proto_rawDescGZIP(), []int{10, 4}
 }
 
 func (x *Product_ProductSpec) GetProductGroupId() string {
@@ -2039,7 +2980,7 @@ type Product_Seller struct {
 func (x *Product_Seller) Reset() {
 	*x = Product_Seller{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_byteplus_retailv2_proto_msgTypes[25]
+		mi := &file_byteplus_retailv2_proto_msgTypes[40]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2052,7 +2993,7 @@ func (x *Product_Seller) String() string {
 func (*Product_Seller) ProtoMessage() {}
 
 func (x *Product_Seller) ProtoReflect() protoreflect.Message {
-	mi := &file_byteplus_retailv2_proto_msgTypes[25]
+	mi := &file_byteplus_retailv2_proto_msgTypes[40]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2065,7 +3006,7 @@ func (x *Product_Seller) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Product_Seller.ProtoReflect.Descriptor instead.
 func (*Product_Seller) Descriptor() ([]byte, []int) {
-	return file_byteplus_retailv2_proto_rawDescGZIP(), []int{4, 5}
+	return file_byteplus_retailv2_proto_rawDescGZIP(), []int{10, 5}
 }
 
 func (x *Product_Seller) GetId() string {
@@ -2106,7 +3047,7 @@ type Product_Category_CategoryNode struct {
 func (x *Product_Category_CategoryNode) Reset() {
 	*x = Product_Category_CategoryNode{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_byteplus_retailv2_proto_msgTypes[27]
+		mi := &file_byteplus_retailv2_proto_msgTypes[42]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2119,7 +3060,7 @@ func (x *Product_Category_CategoryNode) String() string {
 func (*Product_Category_CategoryNode) ProtoMessage() {}
 
 func (x *Product_Category_CategoryNode) ProtoReflect() protoreflect.Message {
-	mi := &file_byteplus_retailv2_proto_msgTypes[27]
+	mi := &file_byteplus_retailv2_proto_msgTypes[42]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2132,7 +3073,7 @@ func (x *Product_Category_CategoryNode) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Product_Category_CategoryNode.ProtoReflect.Descriptor instead.
 func (*Product_Category_CategoryNode) Descriptor() ([]byte, []int) {
-	return file_byteplus_retailv2_proto_rawDescGZIP(), []int{4, 0, 0}
+	return file_byteplus_retailv2_proto_rawDescGZIP(), []int{10, 0, 0}
 }
 
 func (x *Product_Category_CategoryNode) GetIdOrName() string {
@@ -2172,7 +3113,7 @@ type UserEvent_Scene struct {
 func (x *UserEvent_Scene) Reset() {
 	*x = UserEvent_Scene{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_byteplus_retailv2_proto_msgTypes[29]
+		mi := &file_byteplus_retailv2_proto_msgTypes[45]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2185,7 +3126,7 @@ func (x *UserEvent_Scene) String() string {
 func (*UserEvent_Scene) ProtoMessage() {}
 
 func (x *UserEvent_Scene) ProtoReflect() protoreflect.Message {
-	mi := &file_byteplus_retailv2_proto_msgTypes[29]
+	mi := &file_byteplus_retailv2_proto_msgTypes[45]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2198,7 +3139,7 @@ func (x *UserEvent_Scene) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UserEvent_Scene.ProtoReflect.Descriptor instead.
 func (*UserEvent_Scene) Descriptor() ([]byte, []int) {
-	return file_byteplus_retailv2_proto_rawDescGZIP(), []int{8, 0}
+	return file_byteplus_retailv2_proto_rawDescGZIP(), []int{18, 0}
 }
 
 func (x *UserEvent_Scene) GetSceneName() string {
@@ -2290,7 +3231,7 @@ type UserEvent_Device struct {
 func (x *UserEvent_Device) Reset() {
 	*x = UserEvent_Device{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_byteplus_retailv2_proto_msgTypes[30]
+		mi := &file_byteplus_retailv2_proto_msgTypes[46]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2303,7 +3244,7 @@ func (x *UserEvent_Device) String() string {
 func (*UserEvent_Device) ProtoMessage() {}
 
 func (x *UserEvent_Device) ProtoReflect() protoreflect.Message {
-	mi := &file_byteplus_retailv2_proto_msgTypes[30]
+	mi := &file_byteplus_retailv2_proto_msgTypes[46]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2316,7 +3257,7 @@ func (x *UserEvent_Device) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UserEvent_Device.ProtoReflect.Descriptor instead.
 func (*UserEvent_Device) Descriptor() ([]byte, []int) {
-	return file_byteplus_retailv2_proto_rawDescGZIP(), []int{8, 1}
+	return file_byteplus_retailv2_proto_rawDescGZIP(), []int{18, 1}
 }
 
 func (x *UserEvent_Device) GetPlatform() string {
@@ -2400,7 +3341,7 @@ type UserEvent_Context struct {
 func (x *UserEvent_Context) Reset() {
 	*x = UserEvent_Context{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_byteplus_retailv2_proto_msgTypes[31]
+		mi := &file_byteplus_retailv2_proto_msgTypes[47]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2413,7 +3354,7 @@ func (x *UserEvent_Context) String() string {
 func (*UserEvent_Context) ProtoMessage() {}
 
 func (x *UserEvent_Context) ProtoReflect() protoreflect.Message {
-	mi := &file_byteplus_retailv2_proto_msgTypes[31]
+	mi := &file_byteplus_retailv2_proto_msgTypes[47]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2426,7 +3367,7 @@ func (x *UserEvent_Context) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UserEvent_Context.ProtoReflect.Descriptor instead.
 func (*UserEvent_Context) Descriptor() ([]byte, []int) {
-	return file_byteplus_retailv2_proto_rawDescGZIP(), []int{8, 2}
+	return file_byteplus_retailv2_proto_rawDescGZIP(), []int{18, 2}
 }
 
 func (x *UserEvent_Context) GetQuery() string {
@@ -2464,7 +3405,7 @@ type PredictRequest_Context struct {
 func (x *PredictRequest_Context) Reset() {
 	*x = PredictRequest_Context{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_byteplus_retailv2_proto_msgTypes[34]
+		mi := &file_byteplus_retailv2_proto_msgTypes[51]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2477,7 +3418,7 @@ func (x *PredictRequest_Context) String() string {
 func (*PredictRequest_Context) ProtoMessage() {}
 
 func (x *PredictRequest_Context) ProtoReflect() protoreflect.Message {
-	mi := &file_byteplus_retailv2_proto_msgTypes[34]
+	mi := &file_byteplus_retailv2_proto_msgTypes[51]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2490,7 +3431,7 @@ func (x *PredictRequest_Context) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PredictRequest_Context.ProtoReflect.Descriptor instead.
 func (*PredictRequest_Context) Descriptor() ([]byte, []int) {
-	return file_byteplus_retailv2_proto_rawDescGZIP(), []int{12, 0}
+	return file_byteplus_retailv2_proto_rawDescGZIP(), []int{26, 0}
 }
 
 func (x *PredictRequest_Context) GetRootProduct() *Product {
@@ -2545,7 +3486,7 @@ type PredictResult_ResponseProduct struct {
 func (x *PredictResult_ResponseProduct) Reset() {
 	*x = PredictResult_ResponseProduct{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_byteplus_retailv2_proto_msgTypes[36]
+		mi := &file_byteplus_retailv2_proto_msgTypes[53]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2558,7 +3499,7 @@ func (x *PredictResult_ResponseProduct) String() string {
 func (*PredictResult_ResponseProduct) ProtoMessage() {}
 
 func (x *PredictResult_ResponseProduct) ProtoReflect() protoreflect.Message {
-	mi := &file_byteplus_retailv2_proto_msgTypes[36]
+	mi := &file_byteplus_retailv2_proto_msgTypes[53]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2571,7 +3512,7 @@ func (x *PredictResult_ResponseProduct) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PredictResult_ResponseProduct.ProtoReflect.Descriptor instead.
 func (*PredictResult_ResponseProduct) Descriptor() ([]byte, []int) {
-	return file_byteplus_retailv2_proto_rawDescGZIP(), []int{13, 0}
+	return file_byteplus_retailv2_proto_rawDescGZIP(), []int{27, 0}
 }
 
 func (x *PredictResult_ResponseProduct) GetProductId() string {
@@ -2649,7 +3590,7 @@ type AckServerImpressionsRequest_AlteredProduct struct {
 func (x *AckServerImpressionsRequest_AlteredProduct) Reset() {
 	*x = AckServerImpressionsRequest_AlteredProduct{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_byteplus_retailv2_proto_msgTypes[39]
+		mi := &file_byteplus_retailv2_proto_msgTypes[56]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2662,7 +3603,7 @@ func (x *AckServerImpressionsRequest_AlteredProduct) String() string {
 func (*AckServerImpressionsRequest_AlteredProduct) ProtoMessage() {}
 
 func (x *AckServerImpressionsRequest_AlteredProduct) ProtoReflect() protoreflect.Message {
-	mi := &file_byteplus_retailv2_proto_msgTypes[39]
+	mi := &file_byteplus_retailv2_proto_msgTypes[56]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2675,7 +3616,7 @@ func (x *AckServerImpressionsRequest_AlteredProduct) ProtoReflect() protoreflect
 
 // Deprecated: Use AckServerImpressionsRequest_AlteredProduct.ProtoReflect.Descriptor instead.
 func (*AckServerImpressionsRequest_AlteredProduct) Descriptor() ([]byte, []int) {
-	return file_byteplus_retailv2_proto_rawDescGZIP(), []int{15, 0}
+	return file_byteplus_retailv2_proto_rawDescGZIP(), []int{29, 0}
 }
 
 func (x *AckServerImpressionsRequest_AlteredProduct) GetProductId() string {
@@ -2784,7 +3725,64 @@ var file_byteplus_retailv2_proto_rawDesc = []byte{
 	0x0a, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26,
 	0x2e, 0x62, 0x79, 0x74, 0x65, 0x64, 0x61, 0x6e, 0x63, 0x65, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x70,
 	0x6c, 0x75, 0x73, 0x2e, 0x72, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x76, 0x32, 0x2e, 0x55, 0x73, 0x65,
-	0x72, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x22, 0xcc,
+	0x72, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x22, 0x4c,
+	0x0a, 0x11, 0x55, 0x73, 0x65, 0x72, 0x73, 0x49, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x53, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x12, 0x37, 0x0a, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x21, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x64, 0x61, 0x6e, 0x63, 0x65, 0x2e, 0x62,
+	0x79, 0x74, 0x65, 0x70, 0x6c, 0x75, 0x73, 0x2e, 0x72, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x76, 0x32,
+	0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x22, 0x7e, 0x0a, 0x10,
+	0x55, 0x73, 0x65, 0x72, 0x73, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x12, 0x60, 0x0a, 0x13, 0x75, 0x73, 0x65, 0x72, 0x73, 0x5f, 0x69, 0x6e, 0x6c, 0x69, 0x6e, 0x65,
+	0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2e, 0x2e,
+	0x62, 0x79, 0x74, 0x65, 0x64, 0x61, 0x6e, 0x63, 0x65, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x70, 0x6c,
+	0x75, 0x73, 0x2e, 0x72, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x76, 0x32, 0x2e, 0x55, 0x73, 0x65, 0x72,
+	0x73, 0x49, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x48, 0x00, 0x52,
+	0x11, 0x75, 0x73, 0x65, 0x72, 0x73, 0x49, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x53, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x42, 0x08, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x22, 0x37, 0x0a, 0x0a,
+	0x44, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61,
+	0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x61, 0x74, 0x65, 0x12, 0x15,
+	0x0a, 0x06, 0x69, 0x73, 0x5f, 0x65, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05,
+	0x69, 0x73, 0x45, 0x6e, 0x64, 0x22, 0x52, 0x0a, 0x12, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x45,
+	0x72, 0x72, 0x6f, 0x72, 0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x2d, 0x0a, 0x11, 0x65,
+	0x6d, 0x70, 0x74, 0x79, 0x5f, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x10, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x44,
+	0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x0d, 0x0a, 0x0b, 0x64, 0x65,
+	0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x92, 0x03, 0x0a, 0x12, 0x49, 0x6d,
+	0x70, 0x6f, 0x72, 0x74, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x50, 0x0a, 0x0c, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x64, 0x61, 0x6e,
+	0x63, 0x65, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x70, 0x6c, 0x75, 0x73, 0x2e, 0x72, 0x65, 0x74, 0x61,
+	0x69, 0x6c, 0x76, 0x32, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x73, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x0b, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x12, 0x48, 0x0a, 0x0b, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x64, 0x61,
+	0x6e, 0x63, 0x65, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x70, 0x6c, 0x75, 0x73, 0x2e, 0x72, 0x65, 0x74,
+	0x61, 0x69, 0x6c, 0x76, 0x32, 0x2e, 0x44, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x52, 0x0a, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x54, 0x0a, 0x0d,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x64, 0x61, 0x6e, 0x63, 0x65, 0x2e,
+	0x62, 0x79, 0x74, 0x65, 0x70, 0x6c, 0x75, 0x73, 0x2e, 0x72, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x76,
+	0x32, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x52, 0x0c, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x12, 0x50, 0x0a, 0x05, 0x65, 0x78, 0x74, 0x72, 0x61, 0x18, 0x64, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x3a, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x64, 0x61, 0x6e, 0x63, 0x65, 0x2e, 0x62, 0x79,
+	0x74, 0x65, 0x70, 0x6c, 0x75, 0x73, 0x2e, 0x72, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x76, 0x32, 0x2e,
+	0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x2e, 0x45, 0x78, 0x74, 0x72, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x65,
+	0x78, 0x74, 0x72, 0x61, 0x1a, 0x38, 0x0a, 0x0a, 0x45, 0x78, 0x74, 0x72, 0x61, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x9d,
+	0x01, 0x0a, 0x13, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x39, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x64, 0x61, 0x6e,
+	0x63, 0x65, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x70, 0x6c, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x6d, 0x6d,
+	0x6f, 0x6e, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x4b, 0x0a, 0x0d, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x73, 0x61, 0x6d, 0x70, 0x6c,
+	0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x64,
+	0x61, 0x6e, 0x63, 0x65, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x70, 0x6c, 0x75, 0x73, 0x2e, 0x72, 0x65,
+	0x74, 0x61, 0x69, 0x6c, 0x76, 0x32, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x45, 0x72, 0x72, 0x6f, 0x72,
+	0x52, 0x0c, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x22, 0xcc,
 	0x0c, 0x0a, 0x07, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72,
 	0x6f, 0x64, 0x75, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
 	0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x64, 0x12, 0x4d, 0x0a, 0x0a, 0x63, 0x61, 0x74,
@@ -2916,254 +3914,364 @@ var file_byteplus_retailv2_proto_rawDesc = []byte{
 	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x64, 0x61, 0x6e, 0x63,
 	0x65, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x70, 0x6c, 0x75, 0x73, 0x2e, 0x72, 0x65, 0x74, 0x61, 0x69,
 	0x6c, 0x76, 0x32, 0x2e, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72,
-	0x52, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x22, 0xf8, 0x08, 0x0a, 0x09, 0x55, 0x73, 0x65,
-	0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12,
-	0x1d, 0x0a, 0x0a, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x09, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x27,
-	0x0a, 0x0f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
-	0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x69,
-	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x42, 0x0a, 0x05, 0x73, 0x63, 0x65, 0x6e, 0x65,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x64, 0x61, 0x6e,
-	0x63, 0x65, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x70, 0x6c, 0x75, 0x73, 0x2e, 0x72, 0x65, 0x74, 0x61,
-	0x69, 0x6c, 0x76, 0x32, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x53,
-	0x63, 0x65, 0x6e, 0x65, 0x52, 0x05, 0x73, 0x63, 0x65, 0x6e, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70,
-	0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x09, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x64, 0x12, 0x45, 0x0a, 0x06, 0x64, 0x65,
-	0x76, 0x69, 0x63, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x62, 0x79, 0x74,
-	0x65, 0x64, 0x61, 0x6e, 0x63, 0x65, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x70, 0x6c, 0x75, 0x73, 0x2e,
-	0x72, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x76, 0x32, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x45, 0x76, 0x65,
-	0x6e, 0x74, 0x2e, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x52, 0x06, 0x64, 0x65, 0x76, 0x69, 0x63,
-	0x65, 0x12, 0x48, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x18, 0x07, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x64, 0x61, 0x6e, 0x63, 0x65, 0x2e, 0x62,
+	0x52, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x22, 0x58, 0x0a, 0x14, 0x50, 0x72, 0x6f, 0x64,
+	0x75, 0x63, 0x74, 0x73, 0x49, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x12, 0x40, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x24, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x64, 0x61, 0x6e, 0x63, 0x65, 0x2e, 0x62,
 	0x79, 0x74, 0x65, 0x70, 0x6c, 0x75, 0x73, 0x2e, 0x72, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x76, 0x32,
-	0x2e, 0x55, 0x73, 0x65, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65,
-	0x78, 0x74, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x12, 0x2b, 0x0a, 0x11, 0x61,
-	0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
-	0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74,
-	0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x65, 0x63, 0x5f,
-	0x69, 0x6e, 0x66, 0x6f, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x65, 0x63, 0x49,
-	0x6e, 0x66, 0x6f, 0x12, 0x25, 0x0a, 0x0e, 0x74, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x5f, 0x73,
-	0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x74, 0x72, 0x61,
-	0x66, 0x66, 0x69, 0x63, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x75,
-	0x72, 0x63, 0x68, 0x61, 0x73, 0x65, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0b, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x0d, 0x70, 0x75, 0x72, 0x63, 0x68, 0x61, 0x73, 0x65, 0x43, 0x6f, 0x75, 0x6e,
-	0x74, 0x12, 0x31, 0x0a, 0x15, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x5f, 0x70, 0x61, 0x67, 0x65,
-	0x5f, 0x73, 0x74, 0x61, 0x79, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x12, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x50, 0x61, 0x67, 0x65, 0x53, 0x74, 0x61, 0x79,
-	0x54, 0x69, 0x6d, 0x65, 0x12, 0x47, 0x0a, 0x05, 0x65, 0x78, 0x74, 0x72, 0x61, 0x18, 0x64, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x31, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x64, 0x61, 0x6e, 0x63, 0x65, 0x2e,
+	0x2e, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63,
+	0x74, 0x73, 0x22, 0x8a, 0x01, 0x0a, 0x13, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x73, 0x49,
+	0x6e, 0x70, 0x75, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x69, 0x0a, 0x16, 0x70, 0x72,
+	0x6f, 0x64, 0x75, 0x63, 0x74, 0x73, 0x5f, 0x69, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x5f, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x31, 0x2e, 0x62, 0x79, 0x74,
+	0x65, 0x64, 0x61, 0x6e, 0x63, 0x65, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x70, 0x6c, 0x75, 0x73, 0x2e,
+	0x72, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x76, 0x32, 0x2e, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74,
+	0x73, 0x49, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x48, 0x00, 0x52,
+	0x14, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x73, 0x49, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x53,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x42, 0x08, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x22,
+	0x9b, 0x03, 0x0a, 0x15, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63,
+	0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x53, 0x0a, 0x0c, 0x69, 0x6e, 0x70,
+	0x75, 0x74, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x30, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x64, 0x61, 0x6e, 0x63, 0x65, 0x2e, 0x62, 0x79, 0x74, 0x65,
+	0x70, 0x6c, 0x75, 0x73, 0x2e, 0x72, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x76, 0x32, 0x2e, 0x50, 0x72,
+	0x6f, 0x64, 0x75, 0x63, 0x74, 0x73, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x52, 0x0b, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x48,
+	0x0a, 0x0b, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x64, 0x61, 0x6e, 0x63, 0x65, 0x2e,
 	0x62, 0x79, 0x74, 0x65, 0x70, 0x6c, 0x75, 0x73, 0x2e, 0x72, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x76,
-	0x32, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x45, 0x78, 0x74, 0x72,
-	0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x65, 0x78, 0x74, 0x72, 0x61, 0x1a, 0x5f, 0x0a,
-	0x05, 0x53, 0x63, 0x65, 0x6e, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x63, 0x65, 0x6e, 0x65, 0x5f,
-	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x63, 0x65, 0x6e,
-	0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x6e, 0x75,
-	0x6d, 0x62, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x65,
-	0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x1a, 0x9f,
-	0x02, 0x0a, 0x06, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x6c, 0x61,
-	0x74, 0x66, 0x6f, 0x72, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x6c, 0x61,
-	0x74, 0x66, 0x6f, 0x72, 0x6d, 0x12, 0x17, 0x0a, 0x07, 0x6f, 0x73, 0x5f, 0x74, 0x79, 0x70, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6f, 0x73, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1f,
-	0x0a, 0x0b, 0x61, 0x70, 0x70, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x70, 0x70, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12,
-	0x21, 0x0a, 0x0c, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x4d, 0x6f, 0x64,
-	0x65, 0x6c, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x62, 0x72, 0x61,
-	0x6e, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65,
-	0x42, 0x72, 0x61, 0x6e, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x6f, 0x73, 0x5f, 0x76, 0x65, 0x72, 0x73,
-	0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6f, 0x73, 0x56, 0x65, 0x72,
-	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x72, 0x6f, 0x77, 0x73, 0x65, 0x72, 0x5f,
-	0x74, 0x79, 0x70, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x62, 0x72, 0x6f, 0x77,
-	0x73, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x73, 0x65, 0x72, 0x5f,
-	0x61, 0x67, 0x65, 0x6e, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x75, 0x73, 0x65,
-	0x72, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72,
-	0x6b, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b,
-	0x1a, 0x47, 0x0a, 0x07, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x71,
-	0x75, 0x65, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x71, 0x75, 0x65, 0x72,
-	0x79, 0x12, 0x26, 0x0a, 0x0f, 0x72, 0x6f, 0x6f, 0x74, 0x5f, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63,
-	0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x72, 0x6f, 0x6f, 0x74,
-	0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x64, 0x1a, 0x38, 0x0a, 0x0a, 0x45, 0x78, 0x74,
+	0x32, 0x2e, 0x44, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x0a, 0x64, 0x61,
+	0x74, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x54, 0x0a, 0x0d, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x73, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x2f, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x64, 0x61, 0x6e, 0x63, 0x65, 0x2e, 0x62, 0x79, 0x74, 0x65,
+	0x70, 0x6c, 0x75, 0x73, 0x2e, 0x72, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x76, 0x32, 0x2e, 0x49, 0x6d,
+	0x70, 0x6f, 0x72, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x52, 0x0c, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x53,
+	0x0a, 0x05, 0x65, 0x78, 0x74, 0x72, 0x61, 0x18, 0x64, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3d, 0x2e,
+	0x62, 0x79, 0x74, 0x65, 0x64, 0x61, 0x6e, 0x63, 0x65, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x70, 0x6c,
+	0x75, 0x73, 0x2e, 0x72, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x76, 0x32, 0x2e, 0x49, 0x6d, 0x70, 0x6f,
+	0x72, 0x74, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x2e, 0x45, 0x78, 0x74, 0x72, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x65, 0x78,
+	0x74, 0x72, 0x61, 0x1a, 0x38, 0x0a, 0x0a, 0x45, 0x78, 0x74, 0x72, 0x61, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xa3, 0x01,
+	0x0a, 0x16, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x39, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x64,
+	0x61, 0x6e, 0x63, 0x65, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x70, 0x6c, 0x75, 0x73, 0x2e, 0x63, 0x6f,
+	0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x4e, 0x0a, 0x0d, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x73, 0x61, 0x6d,
+	0x70, 0x6c, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x62, 0x79, 0x74,
+	0x65, 0x64, 0x61, 0x6e, 0x63, 0x65, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x70, 0x6c, 0x75, 0x73, 0x2e,
+	0x72, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x76, 0x32, 0x2e, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74,
+	0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x0c, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x53, 0x61, 0x6d, 0x70,
+	0x6c, 0x65, 0x73, 0x22, 0xf8, 0x08, 0x0a, 0x09, 0x55, 0x73, 0x65, 0x72, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x76,
+	0x65, 0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x65, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x65, 0x76, 0x65,
+	0x6e, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x12, 0x42, 0x0a, 0x05, 0x73, 0x63, 0x65, 0x6e, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x2c, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x64, 0x61, 0x6e, 0x63, 0x65, 0x2e, 0x62, 0x79,
+	0x74, 0x65, 0x70, 0x6c, 0x75, 0x73, 0x2e, 0x72, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x76, 0x32, 0x2e,
+	0x55, 0x73, 0x65, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x53, 0x63, 0x65, 0x6e, 0x65, 0x52,
+	0x05, 0x73, 0x63, 0x65, 0x6e, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63,
+	0x74, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x64,
+	0x75, 0x63, 0x74, 0x49, 0x64, 0x12, 0x45, 0x0a, 0x06, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x64, 0x61, 0x6e, 0x63,
+	0x65, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x70, 0x6c, 0x75, 0x73, 0x2e, 0x72, 0x65, 0x74, 0x61, 0x69,
+	0x6c, 0x76, 0x32, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x44, 0x65,
+	0x76, 0x69, 0x63, 0x65, 0x52, 0x06, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x12, 0x48, 0x0a, 0x07,
+	0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2e, 0x2e,
+	0x62, 0x79, 0x74, 0x65, 0x64, 0x61, 0x6e, 0x63, 0x65, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x70, 0x6c,
+	0x75, 0x73, 0x2e, 0x72, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x76, 0x32, 0x2e, 0x55, 0x73, 0x65, 0x72,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x52, 0x07, 0x63,
+	0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x12, 0x2b, 0x0a, 0x11, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62,
+	0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x10, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x65, 0x63, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x65, 0x63, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x25,
+	0x0a, 0x0e, 0x74, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x74, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x53,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x75, 0x72, 0x63, 0x68, 0x61, 0x73,
+	0x65, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x70,
+	0x75, 0x72, 0x63, 0x68, 0x61, 0x73, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x31, 0x0a, 0x15,
+	0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x74, 0x61, 0x79,
+	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x05, 0x52, 0x12, 0x64, 0x65, 0x74,
+	0x61, 0x69, 0x6c, 0x50, 0x61, 0x67, 0x65, 0x53, 0x74, 0x61, 0x79, 0x54, 0x69, 0x6d, 0x65, 0x12,
+	0x47, 0x0a, 0x05, 0x65, 0x78, 0x74, 0x72, 0x61, 0x18, 0x64, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x31,
+	0x2e, 0x62, 0x79, 0x74, 0x65, 0x64, 0x61, 0x6e, 0x63, 0x65, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x70,
+	0x6c, 0x75, 0x73, 0x2e, 0x72, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x76, 0x32, 0x2e, 0x55, 0x73, 0x65,
+	0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x45, 0x78, 0x74, 0x72, 0x61, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x52, 0x05, 0x65, 0x78, 0x74, 0x72, 0x61, 0x1a, 0x5f, 0x0a, 0x05, 0x53, 0x63, 0x65, 0x6e,
+	0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x63, 0x65, 0x6e, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x63, 0x65, 0x6e, 0x65, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65,
+	0x72, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x1a, 0x9f, 0x02, 0x0a, 0x06, 0x44, 0x65,
+	0x76, 0x69, 0x63, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d,
+	0x12, 0x17, 0x0a, 0x07, 0x6f, 0x73, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x6f, 0x73, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x70, 0x70,
+	0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x61, 0x70, 0x70, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x65,
+	0x76, 0x69, 0x63, 0x65, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x12, 0x21, 0x0a,
+	0x0c, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x62, 0x72, 0x61, 0x6e, 0x64, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x42, 0x72, 0x61, 0x6e, 0x64,
+	0x12, 0x1d, 0x0a, 0x0a, 0x6f, 0x73, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6f, 0x73, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12,
+	0x21, 0x0a, 0x0c, 0x62, 0x72, 0x6f, 0x77, 0x73, 0x65, 0x72, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x62, 0x72, 0x6f, 0x77, 0x73, 0x65, 0x72, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x67, 0x65, 0x6e, 0x74,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x75, 0x73, 0x65, 0x72, 0x41, 0x67, 0x65, 0x6e,
+	0x74, 0x12, 0x18, 0x0a, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x1a, 0x47, 0x0a, 0x07, 0x43,
+	0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x12, 0x26, 0x0a, 0x0f,
+	0x72, 0x6f, 0x6f, 0x74, 0x5f, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x72, 0x6f, 0x6f, 0x74, 0x50, 0x72, 0x6f, 0x64, 0x75,
+	0x63, 0x74, 0x49, 0x64, 0x1a, 0x38, 0x0a, 0x0a, 0x45, 0x78, 0x74, 0x72, 0x61, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xf1,
+	0x01, 0x0a, 0x16, 0x57, 0x72, 0x69, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x47, 0x0a, 0x0b, 0x75, 0x73, 0x65,
+	0x72, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26,
+	0x2e, 0x62, 0x79, 0x74, 0x65, 0x64, 0x61, 0x6e, 0x63, 0x65, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x70,
+	0x6c, 0x75, 0x73, 0x2e, 0x72, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x76, 0x32, 0x2e, 0x55, 0x73, 0x65,
+	0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x0a, 0x75, 0x73, 0x65, 0x72, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x73, 0x12, 0x54, 0x0a, 0x05, 0x65, 0x78, 0x74, 0x72, 0x61, 0x18, 0x64, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x3e, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x64, 0x61, 0x6e, 0x63, 0x65, 0x2e, 0x62, 0x79,
+	0x74, 0x65, 0x70, 0x6c, 0x75, 0x73, 0x2e, 0x72, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x76, 0x32, 0x2e,
+	0x57, 0x72, 0x69, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x45, 0x78, 0x74, 0x72, 0x61, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x52, 0x05, 0x65, 0x78, 0x74, 0x72, 0x61, 0x1a, 0x38, 0x0a, 0x0a, 0x45, 0x78, 0x74, 0x72,
+	0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02,
+	0x38, 0x01, 0x22, 0x71, 0x0a, 0x0e, 0x55, 0x73, 0x65, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x45,
+	0x72, 0x72, 0x6f, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x45,
+	0x0a, 0x0a, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x26, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x64, 0x61, 0x6e, 0x63, 0x65, 0x2e, 0x62,
+	0x79, 0x74, 0x65, 0x70, 0x6c, 0x75, 0x73, 0x2e, 0x72, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x76, 0x32,
+	0x2e, 0x55, 0x73, 0x65, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x09, 0x75, 0x73, 0x65, 0x72,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x22, 0x99, 0x01, 0x0a, 0x17, 0x57, 0x72, 0x69, 0x74, 0x65, 0x55,
+	0x73, 0x65, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x39, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x21, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x64, 0x61, 0x6e, 0x63, 0x65, 0x2e, 0x62, 0x79,
+	0x74, 0x65, 0x70, 0x6c, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x43, 0x0a, 0x06,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x62,
+	0x79, 0x74, 0x65, 0x64, 0x61, 0x6e, 0x63, 0x65, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x70, 0x6c, 0x75,
+	0x73, 0x2e, 0x72, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x76, 0x32, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x73, 0x22, 0x61, 0x0a, 0x16, 0x55, 0x73, 0x65, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x49,
+	0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x47, 0x0a, 0x0b, 0x75,
+	0x73, 0x65, 0x72, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x26, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x64, 0x61, 0x6e, 0x63, 0x65, 0x2e, 0x62, 0x79, 0x74,
+	0x65, 0x70, 0x6c, 0x75, 0x73, 0x2e, 0x72, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x76, 0x32, 0x2e, 0x55,
+	0x73, 0x65, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x0a, 0x75, 0x73, 0x65, 0x72, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x73, 0x22, 0x93, 0x01, 0x0a, 0x15, 0x55, 0x73, 0x65, 0x72, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x73, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x70,
+	0x0a, 0x19, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x5f, 0x69, 0x6e,
+	0x6c, 0x69, 0x6e, 0x65, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x33, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x64, 0x61, 0x6e, 0x63, 0x65, 0x2e, 0x62, 0x79,
+	0x74, 0x65, 0x70, 0x6c, 0x75, 0x73, 0x2e, 0x72, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x76, 0x32, 0x2e,
+	0x55, 0x73, 0x65, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x49, 0x6e, 0x6c, 0x69, 0x6e, 0x65,
+	0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x48, 0x00, 0x52, 0x16, 0x75, 0x73, 0x65, 0x72, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x73, 0x49, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x42, 0x08, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x22, 0xa1, 0x03, 0x0a, 0x17, 0x49,
+	0x6d, 0x70, 0x6f, 0x72, 0x74, 0x55, 0x73, 0x65, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x55, 0x0a, 0x0c, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x5f,
+	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x62,
+	0x79, 0x74, 0x65, 0x64, 0x61, 0x6e, 0x63, 0x65, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x70, 0x6c, 0x75,
+	0x73, 0x2e, 0x72, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x76, 0x32, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x73, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x52, 0x0b, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x48, 0x0a,
+	0x0b, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x27, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x64, 0x61, 0x6e, 0x63, 0x65, 0x2e, 0x62,
+	0x79, 0x74, 0x65, 0x70, 0x6c, 0x75, 0x73, 0x2e, 0x72, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x76, 0x32,
+	0x2e, 0x44, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x0a, 0x64, 0x61, 0x74,
+	0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x54, 0x0a, 0x0d, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x73, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2f,
+	0x2e, 0x62, 0x79, 0x74, 0x65, 0x64, 0x61, 0x6e, 0x63, 0x65, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x70,
+	0x6c, 0x75, 0x73, 0x2e, 0x72, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x76, 0x32, 0x2e, 0x49, 0x6d, 0x70,
+	0x6f, 0x72, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52,
+	0x0c, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x55, 0x0a,
+	0x05, 0x65, 0x78, 0x74, 0x72, 0x61, 0x18, 0x64, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3f, 0x2e, 0x62,
+	0x79, 0x74, 0x65, 0x64, 0x61, 0x6e, 0x63, 0x65, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x70, 0x6c, 0x75,
+	0x73, 0x2e, 0x72, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x76, 0x32, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72,
+	0x74, 0x55, 0x73, 0x65, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x2e, 0x45, 0x78, 0x74, 0x72, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x65,
+	0x78, 0x74, 0x72, 0x61, 0x1a, 0x38, 0x0a, 0x0a, 0x45, 0x78, 0x74, 0x72, 0x61, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xa7,
+	0x01, 0x0a, 0x18, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x55, 0x73, 0x65, 0x72, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x39, 0x0a, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x62, 0x79,
+	0x74, 0x65, 0x64, 0x61, 0x6e, 0x63, 0x65, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x70, 0x6c, 0x75, 0x73,
+	0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x50, 0x0a, 0x0d, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f,
+	0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e,
+	0x62, 0x79, 0x74, 0x65, 0x64, 0x61, 0x6e, 0x63, 0x65, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x70, 0x6c,
+	0x75, 0x73, 0x2e, 0x72, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x76, 0x32, 0x2e, 0x55, 0x73, 0x65, 0x72,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x0c, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x22, 0xa8, 0x04, 0x0a, 0x0e, 0x50, 0x72, 0x65,
+	0x64, 0x69, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75,
+	0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73,
+	0x65, 0x72, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x42, 0x0a, 0x05, 0x73, 0x63, 0x65, 0x6e,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x64, 0x61,
+	0x6e, 0x63, 0x65, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x70, 0x6c, 0x75, 0x73, 0x2e, 0x72, 0x65, 0x74,
+	0x61, 0x69, 0x6c, 0x76, 0x32, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x2e,
+	0x53, 0x63, 0x65, 0x6e, 0x65, 0x52, 0x05, 0x73, 0x63, 0x65, 0x6e, 0x65, 0x12, 0x4d, 0x0a, 0x07,
+	0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x33, 0x2e,
+	0x62, 0x79, 0x74, 0x65, 0x64, 0x61, 0x6e, 0x63, 0x65, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x70, 0x6c,
+	0x75, 0x73, 0x2e, 0x72, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x76, 0x32, 0x2e, 0x50, 0x72, 0x65, 0x64,
+	0x69, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65,
+	0x78, 0x74, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x12, 0x4c, 0x0a, 0x05, 0x65,
+	0x78, 0x74, 0x72, 0x61, 0x18, 0x64, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x62, 0x79, 0x74,
+	0x65, 0x64, 0x61, 0x6e, 0x63, 0x65, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x70, 0x6c, 0x75, 0x73, 0x2e,
+	0x72, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x76, 0x32, 0x2e, 0x50, 0x72, 0x65, 0x64, 0x69, 0x63, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x45, 0x78, 0x74, 0x72, 0x61, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x52, 0x05, 0x65, 0x78, 0x74, 0x72, 0x61, 0x1a, 0xcd, 0x01, 0x0a, 0x07, 0x43, 0x6f,
+	0x6e, 0x74, 0x65, 0x78, 0x74, 0x12, 0x47, 0x0a, 0x0c, 0x72, 0x6f, 0x6f, 0x74, 0x5f, 0x70, 0x72,
+	0x6f, 0x64, 0x75, 0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x62, 0x79,
+	0x74, 0x65, 0x64, 0x61, 0x6e, 0x63, 0x65, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x70, 0x6c, 0x75, 0x73,
+	0x2e, 0x72, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x76, 0x32, 0x2e, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63,
+	0x74, 0x52, 0x0b, 0x72, 0x6f, 0x6f, 0x74, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x12, 0x45,
+	0x0a, 0x06, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d,
+	0x2e, 0x62, 0x79, 0x74, 0x65, 0x64, 0x61, 0x6e, 0x63, 0x65, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x70,
+	0x6c, 0x75, 0x73, 0x2e, 0x72, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x76, 0x32, 0x2e, 0x55, 0x73, 0x65,
+	0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x52, 0x06, 0x64,
+	0x65, 0x76, 0x69, 0x63, 0x65, 0x12, 0x32, 0x0a, 0x15, 0x63, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61,
+	0x74, 0x65, 0x5f, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x13, 0x63, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x50,
+	0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x64, 0x73, 0x1a, 0x38, 0x0a, 0x0a, 0x45, 0x78, 0x74,
 	0x72, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
 	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
 	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
-	0x02, 0x38, 0x01, 0x22, 0xf1, 0x01, 0x0a, 0x16, 0x57, 0x72, 0x69, 0x74, 0x65, 0x55, 0x73, 0x65,
-	0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x47,
-	0x0a, 0x0b, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x64, 0x61, 0x6e, 0x63, 0x65, 0x2e,
+	0x02, 0x38, 0x01, 0x22, 0xa0, 0x04, 0x0a, 0x0d, 0x50, 0x72, 0x65, 0x64, 0x69, 0x63, 0x74, 0x52,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x67, 0x0a, 0x11, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x5f, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x3a, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x64, 0x61, 0x6e, 0x63, 0x65, 0x2e, 0x62, 0x79, 0x74,
+	0x65, 0x70, 0x6c, 0x75, 0x73, 0x2e, 0x72, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x76, 0x32, 0x2e, 0x50,
+	0x72, 0x65, 0x64, 0x69, 0x63, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x2e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x52, 0x10, 0x72, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x73, 0x12, 0x4b,
+	0x0a, 0x05, 0x65, 0x78, 0x74, 0x72, 0x61, 0x18, 0x64, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x35, 0x2e,
+	0x62, 0x79, 0x74, 0x65, 0x64, 0x61, 0x6e, 0x63, 0x65, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x70, 0x6c,
+	0x75, 0x73, 0x2e, 0x72, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x76, 0x32, 0x2e, 0x50, 0x72, 0x65, 0x64,
+	0x69, 0x63, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x2e, 0x45, 0x78, 0x74, 0x72, 0x61, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x65, 0x78, 0x74, 0x72, 0x61, 0x1a, 0x9e, 0x02, 0x0a, 0x0f,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x12,
+	0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x64, 0x12, 0x12,
+	0x0a, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x72, 0x61,
+	0x6e, 0x6b, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x63, 0x74, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x04, 0x70, 0x63, 0x74, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x63, 0x76, 0x72, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x04, 0x70, 0x63, 0x76, 0x72, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x65,
+	0x63, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x65,
+	0x63, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x5b, 0x0a, 0x05, 0x65, 0x78, 0x74, 0x72, 0x61, 0x18, 0x64,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x45, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x64, 0x61, 0x6e, 0x63, 0x65,
+	0x2e, 0x62, 0x79, 0x74, 0x65, 0x70, 0x6c, 0x75, 0x73, 0x2e, 0x72, 0x65, 0x74, 0x61, 0x69, 0x6c,
+	0x76, 0x32, 0x2e, 0x50, 0x72, 0x65, 0x64, 0x69, 0x63, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74,
+	0x2e, 0x45, 0x78, 0x74, 0x72, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x65, 0x78, 0x74,
+	0x72, 0x61, 0x1a, 0x38, 0x0a, 0x0a, 0x45, 0x78, 0x74, 0x72, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
+	0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x38, 0x0a, 0x0a,
+	0x45, 0x78, 0x74, 0x72, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xad, 0x01, 0x0a, 0x0f, 0x50, 0x72, 0x65, 0x64, 0x69,
+	0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x39, 0x0a, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x62, 0x79, 0x74,
+	0x65, 0x64, 0x61, 0x6e, 0x63, 0x65, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x70, 0x6c, 0x75, 0x73, 0x2e,
+	0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x49, 0x64, 0x12, 0x40, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x64, 0x61, 0x6e, 0x63, 0x65, 0x2e,
 	0x62, 0x79, 0x74, 0x65, 0x70, 0x6c, 0x75, 0x73, 0x2e, 0x72, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x76,
-	0x32, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x0a, 0x75, 0x73, 0x65,
-	0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x54, 0x0a, 0x05, 0x65, 0x78, 0x74, 0x72, 0x61,
-	0x18, 0x64, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3e, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x64, 0x61, 0x6e,
-	0x63, 0x65, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x70, 0x6c, 0x75, 0x73, 0x2e, 0x72, 0x65, 0x74, 0x61,
-	0x69, 0x6c, 0x76, 0x32, 0x2e, 0x57, 0x72, 0x69, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x45, 0x76,
-	0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x45, 0x78, 0x74, 0x72,
-	0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x65, 0x78, 0x74, 0x72, 0x61, 0x1a, 0x38, 0x0a,
-	0x0a, 0x45, 0x78, 0x74, 0x72, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
-	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a,
-	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x71, 0x0a, 0x0e, 0x55, 0x73, 0x65, 0x72, 0x45,
-	0x76, 0x65, 0x6e, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x12, 0x45, 0x0a, 0x0a, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x65, 0x76, 0x65, 0x6e,
-	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x64, 0x61,
-	0x6e, 0x63, 0x65, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x70, 0x6c, 0x75, 0x73, 0x2e, 0x72, 0x65, 0x74,
-	0x61, 0x69, 0x6c, 0x76, 0x32, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52,
-	0x09, 0x75, 0x73, 0x65, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x22, 0x99, 0x01, 0x0a, 0x17, 0x57,
-	0x72, 0x69, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x39, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x64, 0x61, 0x6e,
-	0x63, 0x65, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x70, 0x6c, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x6d, 0x6d,
-	0x6f, 0x6e, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x12, 0x43, 0x0a, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x2b, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x64, 0x61, 0x6e, 0x63, 0x65, 0x2e, 0x62, 0x79,
-	0x74, 0x65, 0x70, 0x6c, 0x75, 0x73, 0x2e, 0x72, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x76, 0x32, 0x2e,
-	0x55, 0x73, 0x65, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x06,
-	0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x22, 0xa8, 0x04, 0x0a, 0x0e, 0x50, 0x72, 0x65, 0x64, 0x69,
-	0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65,
-	0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72,
-	0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x42, 0x0a, 0x05, 0x73, 0x63, 0x65, 0x6e, 0x65, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x64, 0x61, 0x6e, 0x63,
-	0x65, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x70, 0x6c, 0x75, 0x73, 0x2e, 0x72, 0x65, 0x74, 0x61, 0x69,
-	0x6c, 0x76, 0x32, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x53, 0x63,
-	0x65, 0x6e, 0x65, 0x52, 0x05, 0x73, 0x63, 0x65, 0x6e, 0x65, 0x12, 0x4d, 0x0a, 0x07, 0x63, 0x6f,
-	0x6e, 0x74, 0x65, 0x78, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x33, 0x2e, 0x62, 0x79,
+	0x32, 0x2e, 0x50, 0x72, 0x65, 0x64, 0x69, 0x63, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x91, 0x06, 0x0a, 0x1b, 0x41, 0x63, 0x6b, 0x53, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x49, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2c, 0x0a, 0x12, 0x70, 0x72, 0x65, 0x64, 0x69, 0x63,
+	0x74, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x10, 0x70, 0x72, 0x65, 0x64, 0x69, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x25, 0x0a,
+	0x0e, 0x74, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x74, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x53, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x12, 0x42, 0x0a, 0x05, 0x73, 0x63, 0x65, 0x6e, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x64, 0x61, 0x6e, 0x63, 0x65, 0x2e,
+	0x62, 0x79, 0x74, 0x65, 0x70, 0x6c, 0x75, 0x73, 0x2e, 0x72, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x76,
+	0x32, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x53, 0x63, 0x65, 0x6e,
+	0x65, 0x52, 0x05, 0x73, 0x63, 0x65, 0x6e, 0x65, 0x12, 0x72, 0x0a, 0x10, 0x61, 0x6c, 0x74, 0x65,
+	0x72, 0x65, 0x64, 0x5f, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x73, 0x18, 0x05, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x47, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x64, 0x61, 0x6e, 0x63, 0x65, 0x2e, 0x62,
+	0x79, 0x74, 0x65, 0x70, 0x6c, 0x75, 0x73, 0x2e, 0x72, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x76, 0x32,
+	0x2e, 0x41, 0x63, 0x6b, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x6d, 0x70, 0x72, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x41, 0x6c, 0x74,
+	0x65, 0x72, 0x65, 0x64, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x52, 0x0f, 0x61, 0x6c, 0x74,
+	0x65, 0x72, 0x65, 0x64, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x73, 0x12, 0x59, 0x0a, 0x05,
+	0x65, 0x78, 0x74, 0x72, 0x61, 0x18, 0x64, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x43, 0x2e, 0x62, 0x79,
 	0x74, 0x65, 0x64, 0x61, 0x6e, 0x63, 0x65, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x70, 0x6c, 0x75, 0x73,
-	0x2e, 0x72, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x76, 0x32, 0x2e, 0x50, 0x72, 0x65, 0x64, 0x69, 0x63,
-	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74,
-	0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x12, 0x4c, 0x0a, 0x05, 0x65, 0x78, 0x74,
-	0x72, 0x61, 0x18, 0x64, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x64,
-	0x61, 0x6e, 0x63, 0x65, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x70, 0x6c, 0x75, 0x73, 0x2e, 0x72, 0x65,
-	0x74, 0x61, 0x69, 0x6c, 0x76, 0x32, 0x2e, 0x50, 0x72, 0x65, 0x64, 0x69, 0x63, 0x74, 0x52, 0x65,
+	0x2e, 0x72, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x76, 0x32, 0x2e, 0x41, 0x63, 0x6b, 0x53, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x49, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65,
 	0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x45, 0x78, 0x74, 0x72, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79,
-	0x52, 0x05, 0x65, 0x78, 0x74, 0x72, 0x61, 0x1a, 0xcd, 0x01, 0x0a, 0x07, 0x43, 0x6f, 0x6e, 0x74,
-	0x65, 0x78, 0x74, 0x12, 0x47, 0x0a, 0x0c, 0x72, 0x6f, 0x6f, 0x74, 0x5f, 0x70, 0x72, 0x6f, 0x64,
-	0x75, 0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x62, 0x79, 0x74, 0x65,
-	0x64, 0x61, 0x6e, 0x63, 0x65, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x70, 0x6c, 0x75, 0x73, 0x2e, 0x72,
-	0x65, 0x74, 0x61, 0x69, 0x6c, 0x76, 0x32, 0x2e, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x52,
-	0x0b, 0x72, 0x6f, 0x6f, 0x74, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x12, 0x45, 0x0a, 0x06,
-	0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x62,
-	0x79, 0x74, 0x65, 0x64, 0x61, 0x6e, 0x63, 0x65, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x70, 0x6c, 0x75,
-	0x73, 0x2e, 0x72, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x76, 0x32, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x45,
-	0x76, 0x65, 0x6e, 0x74, 0x2e, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x52, 0x06, 0x64, 0x65, 0x76,
-	0x69, 0x63, 0x65, 0x12, 0x32, 0x0a, 0x15, 0x63, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65,
-	0x5f, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03,
-	0x28, 0x09, 0x52, 0x13, 0x63, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f,
-	0x64, 0x75, 0x63, 0x74, 0x49, 0x64, 0x73, 0x1a, 0x38, 0x0a, 0x0a, 0x45, 0x78, 0x74, 0x72, 0x61,
-	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
-	0x01, 0x22, 0xa0, 0x04, 0x0a, 0x0d, 0x50, 0x72, 0x65, 0x64, 0x69, 0x63, 0x74, 0x52, 0x65, 0x73,
-	0x75, 0x6c, 0x74, 0x12, 0x67, 0x0a, 0x11, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x5f,
-	0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3a,
-	0x2e, 0x62, 0x79, 0x74, 0x65, 0x64, 0x61, 0x6e, 0x63, 0x65, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x70,
-	0x6c, 0x75, 0x73, 0x2e, 0x72, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x76, 0x32, 0x2e, 0x50, 0x72, 0x65,
-	0x64, 0x69, 0x63, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x52, 0x10, 0x72, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x73, 0x12, 0x4b, 0x0a, 0x05,
-	0x65, 0x78, 0x74, 0x72, 0x61, 0x18, 0x64, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x35, 0x2e, 0x62, 0x79,
+	0x52, 0x05, 0x65, 0x78, 0x74, 0x72, 0x61, 0x1a, 0xb6, 0x02, 0x0a, 0x0e, 0x41, 0x6c, 0x74, 0x65,
+	0x72, 0x65, 0x64, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72,
+	0x6f, 0x64, 0x75, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x61, 0x6c, 0x74,
+	0x65, 0x72, 0x65, 0x64, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0d, 0x61, 0x6c, 0x74, 0x65, 0x72, 0x65, 0x64, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e,
+	0x12, 0x12, 0x0a, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04,
+	0x72, 0x61, 0x6e, 0x6b, 0x12, 0x26, 0x0a, 0x0f, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x5f,
+	0x69, 0x64, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x64, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x70,
+	0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x64, 0x48, 0x61, 0x73, 0x68, 0x12, 0x68, 0x0a, 0x05,
+	0x65, 0x78, 0x74, 0x72, 0x61, 0x18, 0x65, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x52, 0x2e, 0x62, 0x79,
 	0x74, 0x65, 0x64, 0x61, 0x6e, 0x63, 0x65, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x70, 0x6c, 0x75, 0x73,
-	0x2e, 0x72, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x76, 0x32, 0x2e, 0x50, 0x72, 0x65, 0x64, 0x69, 0x63,
-	0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x2e, 0x45, 0x78, 0x74, 0x72, 0x61, 0x45, 0x6e, 0x74,
-	0x72, 0x79, 0x52, 0x05, 0x65, 0x78, 0x74, 0x72, 0x61, 0x1a, 0x9e, 0x02, 0x0a, 0x0f, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x12, 0x1d, 0x0a,
-	0x0a, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04,
-	0x72, 0x61, 0x6e, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x72, 0x61, 0x6e, 0x6b,
-	0x12, 0x12, 0x0a, 0x04, 0x70, 0x63, 0x74, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x04,
-	0x70, 0x63, 0x74, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x63, 0x76, 0x72, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x01, 0x52, 0x04, 0x70, 0x63, 0x76, 0x72, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x65, 0x63, 0x5f,
-	0x69, 0x6e, 0x66, 0x6f, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x65, 0x63, 0x49,
-	0x6e, 0x66, 0x6f, 0x12, 0x5b, 0x0a, 0x05, 0x65, 0x78, 0x74, 0x72, 0x61, 0x18, 0x64, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x45, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x64, 0x61, 0x6e, 0x63, 0x65, 0x2e, 0x62,
-	0x79, 0x74, 0x65, 0x70, 0x6c, 0x75, 0x73, 0x2e, 0x72, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x76, 0x32,
-	0x2e, 0x50, 0x72, 0x65, 0x64, 0x69, 0x63, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x2e, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x2e, 0x45,
-	0x78, 0x74, 0x72, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x65, 0x78, 0x74, 0x72, 0x61,
+	0x2e, 0x72, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x76, 0x32, 0x2e, 0x41, 0x63, 0x6b, 0x53, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x49, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x41, 0x6c, 0x74, 0x65, 0x72, 0x65, 0x64, 0x50, 0x72, 0x6f,
+	0x64, 0x75, 0x63, 0x74, 0x2e, 0x45, 0x78, 0x74, 0x72, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
+	0x05, 0x65, 0x78, 0x74, 0x72, 0x61, 0x1a, 0x38, 0x0a, 0x0a, 0x45, 0x78, 0x74, 0x72, 0x61, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
 	0x1a, 0x38, 0x0a, 0x0a, 0x45, 0x78, 0x74, 0x72, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
 	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
 	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x38, 0x0a, 0x0a, 0x45, 0x78,
-	0x74, 0x72, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0x3a, 0x02, 0x38, 0x01, 0x22, 0xad, 0x01, 0x0a, 0x0f, 0x50, 0x72, 0x65, 0x64, 0x69, 0x63, 0x74,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x39, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x64,
-	0x61, 0x6e, 0x63, 0x65, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x70, 0x6c, 0x75, 0x73, 0x2e, 0x63, 0x6f,
-	0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69,
-	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x49, 0x64, 0x12, 0x40, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x2a, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x64, 0x61, 0x6e, 0x63, 0x65, 0x2e, 0x62, 0x79,
-	0x74, 0x65, 0x70, 0x6c, 0x75, 0x73, 0x2e, 0x72, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x76, 0x32, 0x2e,
-	0x50, 0x72, 0x65, 0x64, 0x69, 0x63, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x05, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x22, 0x91, 0x06, 0x0a, 0x1b, 0x41, 0x63, 0x6b, 0x53, 0x65, 0x72, 0x76,
-	0x65, 0x72, 0x49, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x2c, 0x0a, 0x12, 0x70, 0x72, 0x65, 0x64, 0x69, 0x63, 0x74, 0x5f,
-	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x10, 0x70, 0x72, 0x65, 0x64, 0x69, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x74,
-	0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0d, 0x74, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x53, 0x6f, 0x75, 0x72,
-	0x63, 0x65, 0x12, 0x42, 0x0a, 0x05, 0x73, 0x63, 0x65, 0x6e, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x2c, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x64, 0x61, 0x6e, 0x63, 0x65, 0x2e, 0x62, 0x79,
-	0x74, 0x65, 0x70, 0x6c, 0x75, 0x73, 0x2e, 0x72, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x76, 0x32, 0x2e,
-	0x55, 0x73, 0x65, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x53, 0x63, 0x65, 0x6e, 0x65, 0x52,
-	0x05, 0x73, 0x63, 0x65, 0x6e, 0x65, 0x12, 0x72, 0x0a, 0x10, 0x61, 0x6c, 0x74, 0x65, 0x72, 0x65,
-	0x64, 0x5f, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x47, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x64, 0x61, 0x6e, 0x63, 0x65, 0x2e, 0x62, 0x79, 0x74,
-	0x65, 0x70, 0x6c, 0x75, 0x73, 0x2e, 0x72, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x76, 0x32, 0x2e, 0x41,
-	0x63, 0x6b, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69,
-	0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x41, 0x6c, 0x74, 0x65, 0x72,
-	0x65, 0x64, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x52, 0x0f, 0x61, 0x6c, 0x74, 0x65, 0x72,
-	0x65, 0x64, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x73, 0x12, 0x59, 0x0a, 0x05, 0x65, 0x78,
-	0x74, 0x72, 0x61, 0x18, 0x64, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x43, 0x2e, 0x62, 0x79, 0x74, 0x65,
-	0x64, 0x61, 0x6e, 0x63, 0x65, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x70, 0x6c, 0x75, 0x73, 0x2e, 0x72,
-	0x65, 0x74, 0x61, 0x69, 0x6c, 0x76, 0x32, 0x2e, 0x41, 0x63, 0x6b, 0x53, 0x65, 0x72, 0x76, 0x65,
-	0x72, 0x49, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x2e, 0x45, 0x78, 0x74, 0x72, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05,
-	0x65, 0x78, 0x74, 0x72, 0x61, 0x1a, 0xb6, 0x02, 0x0a, 0x0e, 0x41, 0x6c, 0x74, 0x65, 0x72, 0x65,
-	0x64, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x64,
-	0x75, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72,
-	0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x61, 0x6c, 0x74, 0x65, 0x72,
-	0x65, 0x64, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0d, 0x61, 0x6c, 0x74, 0x65, 0x72, 0x65, 0x64, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x12,
-	0x0a, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x72, 0x61,
-	0x6e, 0x6b, 0x12, 0x26, 0x0a, 0x0f, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x5f, 0x69, 0x64,
-	0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x64, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x70, 0x72, 0x6f,
-	0x64, 0x75, 0x63, 0x74, 0x49, 0x64, 0x48, 0x61, 0x73, 0x68, 0x12, 0x68, 0x0a, 0x05, 0x65, 0x78,
-	0x74, 0x72, 0x61, 0x18, 0x65, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x52, 0x2e, 0x62, 0x79, 0x74, 0x65,
-	0x64, 0x61, 0x6e, 0x63, 0x65, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x70, 0x6c, 0x75, 0x73, 0x2e, 0x72,
-	0x65, 0x74, 0x61, 0x69, 0x6c, 0x76, 0x32, 0x2e, 0x41, 0x63, 0x6b, 0x53, 0x65, 0x72, 0x76, 0x65,
-	0x72, 0x49, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x2e, 0x41, 0x6c, 0x74, 0x65, 0x72, 0x65, 0x64, 0x50, 0x72, 0x6f, 0x64, 0x75,
-	0x63, 0x74, 0x2e, 0x45, 0x78, 0x74, 0x72, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x65,
-	0x78, 0x74, 0x72, 0x61, 0x1a, 0x38, 0x0a, 0x0a, 0x45, 0x78, 0x74, 0x72, 0x61, 0x45, 0x6e, 0x74,
-	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x38,
-	0x0a, 0x0a, 0x45, 0x78, 0x74, 0x72, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03,
-	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14,
-	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x59, 0x0a, 0x1c, 0x41, 0x63, 0x6b, 0x53,
-	0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x39, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x64,
-	0x61, 0x6e, 0x63, 0x65, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x70, 0x6c, 0x75, 0x73, 0x2e, 0x63, 0x6f,
-	0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x32, 0xf0, 0x04, 0x0a, 0x15, 0x42, 0x79, 0x74, 0x65, 0x70, 0x6c, 0x75, 0x73,
-	0x52, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x6d, 0x0a,
-	0x0a, 0x57, 0x72, 0x69, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x73, 0x12, 0x2e, 0x2e, 0x62, 0x79,
-	0x74, 0x65, 0x64, 0x61, 0x6e, 0x63, 0x65, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x70, 0x6c, 0x75, 0x73,
-	0x2e, 0x72, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x76, 0x32, 0x2e, 0x57, 0x72, 0x69, 0x74, 0x65, 0x55,
-	0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x62, 0x79,
-	0x74, 0x65, 0x64, 0x61, 0x6e, 0x63, 0x65, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x70, 0x6c, 0x75, 0x73,
-	0x2e, 0x72, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x76, 0x32, 0x2e, 0x57, 0x72, 0x69, 0x74, 0x65, 0x55,
-	0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x76, 0x0a, 0x0d,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x59, 0x0a, 0x1c, 0x41, 0x63,
+	0x6b, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x39, 0x0a, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x62, 0x79, 0x74,
+	0x65, 0x64, 0x61, 0x6e, 0x63, 0x65, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x70, 0x6c, 0x75, 0x73, 0x2e,
+	0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x32, 0xca, 0x07, 0x0a, 0x15, 0x42, 0x79, 0x74, 0x65, 0x70, 0x6c,
+	0x75, 0x73, 0x52, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12,
+	0x6d, 0x0a, 0x0a, 0x57, 0x72, 0x69, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x73, 0x12, 0x2e, 0x2e,
+	0x62, 0x79, 0x74, 0x65, 0x64, 0x61, 0x6e, 0x63, 0x65, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x70, 0x6c,
+	0x75, 0x73, 0x2e, 0x72, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x76, 0x32, 0x2e, 0x57, 0x72, 0x69, 0x74,
+	0x65, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e,
+	0x62, 0x79, 0x74, 0x65, 0x64, 0x61, 0x6e, 0x63, 0x65, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x70, 0x6c,
+	0x75, 0x73, 0x2e, 0x72, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x76, 0x32, 0x2e, 0x57, 0x72, 0x69, 0x74,
+	0x65, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6c,
+	0x0a, 0x0b, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x55, 0x73, 0x65, 0x72, 0x73, 0x12, 0x2f, 0x2e,
+	0x62, 0x79, 0x74, 0x65, 0x64, 0x61, 0x6e, 0x63, 0x65, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x70, 0x6c,
+	0x75, 0x73, 0x2e, 0x72, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x76, 0x32, 0x2e, 0x49, 0x6d, 0x70, 0x6f,
+	0x72, 0x74, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c,
+	0x2e, 0x62, 0x79, 0x74, 0x65, 0x64, 0x61, 0x6e, 0x63, 0x65, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x70,
+	0x6c, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x76, 0x0a, 0x0d,
 	0x57, 0x72, 0x69, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x73, 0x12, 0x31, 0x2e,
 	0x62, 0x79, 0x74, 0x65, 0x64, 0x61, 0x6e, 0x63, 0x65, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x70, 0x6c,
 	0x75, 0x73, 0x2e, 0x72, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x76, 0x32, 0x2e, 0x57, 0x72, 0x69, 0x74,
@@ -3171,36 +4279,50 @@ var file_byteplus_retailv2_proto_rawDesc = []byte{
 	0x1a, 0x32, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x64, 0x61, 0x6e, 0x63, 0x65, 0x2e, 0x62, 0x79, 0x74,
 	0x65, 0x70, 0x6c, 0x75, 0x73, 0x2e, 0x72, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x76, 0x32, 0x2e, 0x57,
 	0x72, 0x69, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x7c, 0x0a, 0x0f, 0x57, 0x72, 0x69, 0x74, 0x65, 0x55, 0x73, 0x65,
-	0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x33, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x64, 0x61,
-	0x6e, 0x63, 0x65, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x70, 0x6c, 0x75, 0x73, 0x2e, 0x72, 0x65, 0x74,
-	0x61, 0x69, 0x6c, 0x76, 0x32, 0x2e, 0x57, 0x72, 0x69, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x45,
-	0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x34, 0x2e, 0x62,
-	0x79, 0x74, 0x65, 0x64, 0x61, 0x6e, 0x63, 0x65, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x70, 0x6c, 0x75,
-	0x73, 0x2e, 0x72, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x76, 0x32, 0x2e, 0x57, 0x72, 0x69, 0x74, 0x65,
-	0x55, 0x73, 0x65, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x64, 0x0a, 0x07, 0x50, 0x72, 0x65, 0x64, 0x69, 0x63, 0x74, 0x12, 0x2b, 0x2e,
-	0x62, 0x79, 0x74, 0x65, 0x64, 0x61, 0x6e, 0x63, 0x65, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x70, 0x6c,
-	0x75, 0x73, 0x2e, 0x72, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x76, 0x32, 0x2e, 0x50, 0x72, 0x65, 0x64,
-	0x69, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x62, 0x79, 0x74,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x72, 0x0a, 0x0e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x50, 0x72,
+	0x6f, 0x64, 0x75, 0x63, 0x74, 0x73, 0x12, 0x32, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x64, 0x61, 0x6e,
+	0x63, 0x65, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x70, 0x6c, 0x75, 0x73, 0x2e, 0x72, 0x65, 0x74, 0x61,
+	0x69, 0x6c, 0x76, 0x32, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x50, 0x72, 0x6f, 0x64, 0x75,
+	0x63, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x62, 0x79, 0x74,
 	0x65, 0x64, 0x61, 0x6e, 0x63, 0x65, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x70, 0x6c, 0x75, 0x73, 0x2e,
-	0x72, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x76, 0x32, 0x2e, 0x50, 0x72, 0x65, 0x64, 0x69, 0x63, 0x74,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x8b, 0x01, 0x0a, 0x14, 0x41, 0x63, 0x6b,
-	0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
-	0x73, 0x12, 0x38, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x64, 0x61, 0x6e, 0x63, 0x65, 0x2e, 0x62, 0x79,
-	0x74, 0x65, 0x70, 0x6c, 0x75, 0x73, 0x2e, 0x72, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x76, 0x32, 0x2e,
-	0x41, 0x63, 0x6b, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73,
-	0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x39, 0x2e, 0x62, 0x79,
+	0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x7c, 0x0a, 0x0f, 0x57, 0x72, 0x69, 0x74,
+	0x65, 0x55, 0x73, 0x65, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x33, 0x2e, 0x62, 0x79,
 	0x74, 0x65, 0x64, 0x61, 0x6e, 0x63, 0x65, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x70, 0x6c, 0x75, 0x73,
-	0x2e, 0x72, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x76, 0x32, 0x2e, 0x41, 0x63, 0x6b, 0x53, 0x65, 0x72,
-	0x76, 0x65, 0x72, 0x49, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x52, 0x0a, 0x1e, 0x62, 0x79, 0x74, 0x65, 0x70, 0x6c,
-	0x75, 0x73, 0x2e, 0x73, 0x64, 0x6b, 0x2e, 0x72, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x76, 0x32, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x5a, 0x30, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
-	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x62, 0x79, 0x74, 0x65, 0x70, 0x6c, 0x75, 0x73, 0x2d, 0x73, 0x64,
-	0x6b, 0x2f, 0x73, 0x64, 0x6b, 0x2d, 0x67, 0x6f, 0x2f, 0x72, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x76,
-	0x32, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x33,
+	0x2e, 0x72, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x76, 0x32, 0x2e, 0x57, 0x72, 0x69, 0x74, 0x65, 0x55,
+	0x73, 0x65, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x34, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x64, 0x61, 0x6e, 0x63, 0x65, 0x2e, 0x62, 0x79, 0x74,
+	0x65, 0x70, 0x6c, 0x75, 0x73, 0x2e, 0x72, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x76, 0x32, 0x2e, 0x57,
+	0x72, 0x69, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x76, 0x0a, 0x10, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74,
+	0x55, 0x73, 0x65, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x34, 0x2e, 0x62, 0x79, 0x74,
+	0x65, 0x64, 0x61, 0x6e, 0x63, 0x65, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x70, 0x6c, 0x75, 0x73, 0x2e,
+	0x72, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x76, 0x32, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x55,
+	0x73, 0x65, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x2c, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x64, 0x61, 0x6e, 0x63, 0x65, 0x2e, 0x62, 0x79, 0x74,
+	0x65, 0x70, 0x6c, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x4f, 0x70, 0x65,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x64,
+	0x0a, 0x07, 0x50, 0x72, 0x65, 0x64, 0x69, 0x63, 0x74, 0x12, 0x2b, 0x2e, 0x62, 0x79, 0x74, 0x65,
+	0x64, 0x61, 0x6e, 0x63, 0x65, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x70, 0x6c, 0x75, 0x73, 0x2e, 0x72,
+	0x65, 0x74, 0x61, 0x69, 0x6c, 0x76, 0x32, 0x2e, 0x50, 0x72, 0x65, 0x64, 0x69, 0x63, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x64, 0x61, 0x6e,
+	0x63, 0x65, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x70, 0x6c, 0x75, 0x73, 0x2e, 0x72, 0x65, 0x74, 0x61,
+	0x69, 0x6c, 0x76, 0x32, 0x2e, 0x50, 0x72, 0x65, 0x64, 0x69, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x8b, 0x01, 0x0a, 0x14, 0x41, 0x63, 0x6b, 0x53, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x49, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x38, 0x2e,
+	0x62, 0x79, 0x74, 0x65, 0x64, 0x61, 0x6e, 0x63, 0x65, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x70, 0x6c,
+	0x75, 0x73, 0x2e, 0x72, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x76, 0x32, 0x2e, 0x41, 0x63, 0x6b, 0x53,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x39, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x64, 0x61,
+	0x6e, 0x63, 0x65, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x70, 0x6c, 0x75, 0x73, 0x2e, 0x72, 0x65, 0x74,
+	0x61, 0x69, 0x6c, 0x76, 0x32, 0x2e, 0x41, 0x63, 0x6b, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49,
+	0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x42, 0x52, 0x0a, 0x1e, 0x62, 0x79, 0x74, 0x65, 0x70, 0x6c, 0x75, 0x73, 0x2e, 0x73,
+	0x64, 0x6b, 0x2e, 0x72, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x76, 0x32, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x63, 0x6f, 0x6c, 0x5a, 0x30, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2f, 0x62, 0x79, 0x74, 0x65, 0x70, 0x6c, 0x75, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x73, 0x64,
+	0x6b, 0x2d, 0x67, 0x6f, 0x2f, 0x72, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x76, 0x32, 0x2f, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -3215,112 +4337,160 @@ func file_byteplus_retailv2_proto_rawDescGZIP() []byte {
 	return file_byteplus_retailv2_proto_rawDescData
 }
 
-var file_byteplus_retailv2_proto_msgTypes = make([]protoimpl.MessageInfo, 42)
+var file_byteplus_retailv2_proto_msgTypes = make([]protoimpl.MessageInfo, 59)
 var file_byteplus_retailv2_proto_goTypes = []interface{}{
 	(*User)(nil),                          // 0: bytedance.byteplus.retailv2.User
 	(*WriteUsersRequest)(nil),             // 1: bytedance.byteplus.retailv2.WriteUsersRequest
 	(*UserError)(nil),                     // 2: bytedance.byteplus.retailv2.UserError
 	(*WriteUsersResponse)(nil),            // 3: bytedance.byteplus.retailv2.WriteUsersResponse
-	(*Product)(nil),                       // 4: bytedance.byteplus.retailv2.Product
-	(*WriteProductsRequest)(nil),          // 5: bytedance.byteplus.retailv2.WriteProductsRequest
-	(*ProductError)(nil),                  // 6: bytedance.byteplus.retailv2.ProductError
-	(*WriteProductsResponse)(nil),         // 7: bytedance.byteplus.retailv2.WriteProductsResponse
-	(*UserEvent)(nil),                     // 8: bytedance.byteplus.retailv2.UserEvent
-	(*WriteUserEventsRequest)(nil),        // 9: bytedance.byteplus.retailv2.WriteUserEventsRequest
-	(*UserEventError)(nil),                // 10: bytedance.byteplus.retailv2.UserEventError
-	(*WriteUserEventsResponse)(nil),       // 11: bytedance.byteplus.retailv2.WriteUserEventsResponse
-	(*PredictRequest)(nil),                // 12: bytedance.byteplus.retailv2.PredictRequest
-	(*PredictResult)(nil),                 // 13: bytedance.byteplus.retailv2.PredictResult
-	(*PredictResponse)(nil),               // 14: bytedance.byteplus.retailv2.PredictResponse
-	(*AckServerImpressionsRequest)(nil),   // 15: bytedance.byteplus.retailv2.AckServerImpressionsRequest
-	(*AckServerImpressionsResponse)(nil),  // 16: bytedance.byteplus.retailv2.AckServerImpressionsResponse
-	(*User_Location)(nil),                 // 17: bytedance.byteplus.retailv2.User.Location
-	nil,                                   // 18: bytedance.byteplus.retailv2.User.ExtraEntry
-	nil,                                   // 19: bytedance.byteplus.retailv2.WriteUsersRequest.ExtraEntry
-	(*Product_Category)(nil),              // 20: bytedance.byteplus.retailv2.Product.Category
-	(*Product_Brand)(nil),                 // 21: bytedance.byteplus.retailv2.Product.Brand
-	(*Product_Price)(nil),                 // 22: bytedance.byteplus.retailv2.Product.Price
-	(*Product_Display)(nil),               // 23: bytedance.byteplus.retailv2.Product.Display
-	(*Product_ProductSpec)(nil),           // 24: bytedance.byteplus.retailv2.Product.ProductSpec
-	(*Product_Seller)(nil),                // 25: bytedance.byteplus.retailv2.Product.Seller
-	nil,                                   // 26: bytedance.byteplus.retailv2.Product.ExtraEntry
-	(*Product_Category_CategoryNode)(nil), // 27: bytedance.byteplus.retailv2.Product.Category.CategoryNode
-	nil,                                   // 28: bytedance.byteplus.retailv2.WriteProductsRequest.ExtraEntry
-	(*UserEvent_Scene)(nil),               // 29: bytedance.byteplus.retailv2.UserEvent.Scene
-	(*UserEvent_Device)(nil),              // 30: bytedance.byteplus.retailv2.UserEvent.Device
-	(*UserEvent_Context)(nil),             // 31: bytedance.byteplus.retailv2.UserEvent.Context
-	nil,                                   // 32: bytedance.byteplus.retailv2.UserEvent.ExtraEntry
-	nil,                                   // 33: bytedance.byteplus.retailv2.WriteUserEventsRequest.ExtraEntry
-	(*PredictRequest_Context)(nil),        // 34: bytedance.byteplus.retailv2.PredictRequest.Context
-	nil,                                   // 35: bytedance.byteplus.retailv2.PredictRequest.ExtraEntry
-	(*PredictResult_ResponseProduct)(nil), // 36: bytedance.byteplus.retailv2.PredictResult.ResponseProduct
-	nil,                                   // 37: bytedance.byteplus.retailv2.PredictResult.ExtraEntry
-	nil,                                   // 38: bytedance.byteplus.retailv2.PredictResult.ResponseProduct.ExtraEntry
-	(*AckServerImpressionsRequest_AlteredProduct)(nil), // 39: bytedance.byteplus.retailv2.AckServerImpressionsRequest.AlteredProduct
-	nil,                     // 40: bytedance.byteplus.retailv2.AckServerImpressionsRequest.ExtraEntry
-	nil,                     // 41: bytedance.byteplus.retailv2.AckServerImpressionsRequest.AlteredProduct.ExtraEntry
-	(*protocol.Status)(nil), // 42: bytedance.byteplus.common.Status
+	(*UsersInlineSource)(nil),             // 4: bytedance.byteplus.retailv2.UsersInlineSource
+	(*UsersInputConfig)(nil),              // 5: bytedance.byteplus.retailv2.UsersInputConfig
+	(*DateConfig)(nil),                    // 6: bytedance.byteplus.retailv2.DateConfig
+	(*ImportErrorsConfig)(nil),            // 7: bytedance.byteplus.retailv2.ImportErrorsConfig
+	(*ImportUsersRequest)(nil),            // 8: bytedance.byteplus.retailv2.ImportUsersRequest
+	(*ImportUsersResponse)(nil),           // 9: bytedance.byteplus.retailv2.ImportUsersResponse
+	(*Product)(nil),                       // 10: bytedance.byteplus.retailv2.Product
+	(*WriteProductsRequest)(nil),          // 11: bytedance.byteplus.retailv2.WriteProductsRequest
+	(*ProductError)(nil),                  // 12: bytedance.byteplus.retailv2.ProductError
+	(*WriteProductsResponse)(nil),         // 13: bytedance.byteplus.retailv2.WriteProductsResponse
+	(*ProductsInlineSource)(nil),          // 14: bytedance.byteplus.retailv2.ProductsInlineSource
+	(*ProductsInputConfig)(nil),           // 15: bytedance.byteplus.retailv2.ProductsInputConfig
+	(*ImportProductsRequest)(nil),         // 16: bytedance.byteplus.retailv2.ImportProductsRequest
+	(*ImportProductsResponse)(nil),        // 17: bytedance.byteplus.retailv2.ImportProductsResponse
+	(*UserEvent)(nil),                     // 18: bytedance.byteplus.retailv2.UserEvent
+	(*WriteUserEventsRequest)(nil),        // 19: bytedance.byteplus.retailv2.WriteUserEventsRequest
+	(*UserEventError)(nil),                // 20: bytedance.byteplus.retailv2.UserEventError
+	(*WriteUserEventsResponse)(nil),       // 21: bytedance.byteplus.retailv2.WriteUserEventsResponse
+	(*UserEventsInlineSource)(nil),        // 22: bytedance.byteplus.retailv2.UserEventsInlineSource
+	(*UserEventsInputConfig)(nil),         // 23: bytedance.byteplus.retailv2.UserEventsInputConfig
+	(*ImportUserEventsRequest)(nil),       // 24: bytedance.byteplus.retailv2.ImportUserEventsRequest
+	(*ImportUserEventsResponse)(nil),      // 25: bytedance.byteplus.retailv2.ImportUserEventsResponse
+	(*PredictRequest)(nil),                // 26: bytedance.byteplus.retailv2.PredictRequest
+	(*PredictResult)(nil),                 // 27: bytedance.byteplus.retailv2.PredictResult
+	(*PredictResponse)(nil),               // 28: bytedance.byteplus.retailv2.PredictResponse
+	(*AckServerImpressionsRequest)(nil),   // 29: bytedance.byteplus.retailv2.AckServerImpressionsRequest
+	(*AckServerImpressionsResponse)(nil),  // 30: bytedance.byteplus.retailv2.AckServerImpressionsResponse
+	(*User_Location)(nil),                 // 31: bytedance.byteplus.retailv2.User.Location
+	nil,                                   // 32: bytedance.byteplus.retailv2.User.ExtraEntry
+	nil,                                   // 33: bytedance.byteplus.retailv2.WriteUsersRequest.ExtraEntry
+	nil,                                   // 34: bytedance.byteplus.retailv2.ImportUsersRequest.ExtraEntry
+	(*Product_Category)(nil),              // 35: bytedance.byteplus.retailv2.Product.Category
+	(*Product_Brand)(nil),                 // 36: bytedance.byteplus.retailv2.Product.Brand
+	(*Product_Price)(nil),                 // 37: bytedance.byteplus.retailv2.Product.Price
+	(*Product_Display)(nil),               // 38: bytedance.byteplus.retailv2.Product.Display
+	(*Product_ProductSpec)(nil),           // 39: bytedance.byteplus.retailv2.Product.ProductSpec
+	(*Product_Seller)(nil),                // 40: bytedance.byteplus.retailv2.Product.Seller
+	nil,                                   // 41: bytedance.byteplus.retailv2.Product.ExtraEntry
+	(*Product_Category_CategoryNode)(nil), // 42: bytedance.byteplus.retailv2.Product.Category.CategoryNode
+	nil,                                   // 43: bytedance.byteplus.retailv2.WriteProductsRequest.ExtraEntry
+	nil,                                   // 44: bytedance.byteplus.retailv2.ImportProductsRequest.ExtraEntry
+	(*UserEvent_Scene)(nil),               // 45: bytedance.byteplus.retailv2.UserEvent.Scene
+	(*UserEvent_Device)(nil),              // 46: bytedance.byteplus.retailv2.UserEvent.Device
+	(*UserEvent_Context)(nil),             // 47: bytedance.byteplus.retailv2.UserEvent.Context
+	nil,                                   // 48: bytedance.byteplus.retailv2.UserEvent.ExtraEntry
+	nil,                                   // 49: bytedance.byteplus.retailv2.WriteUserEventsRequest.ExtraEntry
+	nil,                                   // 50: bytedance.byteplus.retailv2.ImportUserEventsRequest.ExtraEntry
+	(*PredictRequest_Context)(nil),        // 51: bytedance.byteplus.retailv2.PredictRequest.Context
+	nil,                                   // 52: bytedance.byteplus.retailv2.PredictRequest.ExtraEntry
+	(*PredictResult_ResponseProduct)(nil), // 53: bytedance.byteplus.retailv2.PredictResult.ResponseProduct
+	nil,                                   // 54: bytedance.byteplus.retailv2.PredictResult.ExtraEntry
+	nil,                                   // 55: bytedance.byteplus.retailv2.PredictResult.ResponseProduct.ExtraEntry
+	(*AckServerImpressionsRequest_AlteredProduct)(nil), // 56: bytedance.byteplus.retailv2.AckServerImpressionsRequest.AlteredProduct
+	nil,                                // 57: bytedance.byteplus.retailv2.AckServerImpressionsRequest.ExtraEntry
+	nil,                                // 58: bytedance.byteplus.retailv2.AckServerImpressionsRequest.AlteredProduct.ExtraEntry
+	(*protocol.Status)(nil),            // 59: bytedance.byteplus.common.Status
+	(*protocol.OperationResponse)(nil), // 60: bytedance.byteplus.common.OperationResponse
 }
 var file_byteplus_retailv2_proto_depIdxs = []int32{
-	17, // 0: bytedance.byteplus.retailv2.User.location:type_name -> bytedance.byteplus.retailv2.User.Location
-	18, // 1: bytedance.byteplus.retailv2.User.extra:type_name -> bytedance.byteplus.retailv2.User.ExtraEntry
+	31, // 0: bytedance.byteplus.retailv2.User.location:type_name -> bytedance.byteplus.retailv2.User.Location
+	32, // 1: bytedance.byteplus.retailv2.User.extra:type_name -> bytedance.byteplus.retailv2.User.ExtraEntry
 	0,  // 2: bytedance.byteplus.retailv2.WriteUsersRequest.users:type_name -> bytedance.byteplus.retailv2.User
-	19, // 3: bytedance.byteplus.retailv2.WriteUsersRequest.extra:type_name -> bytedance.byteplus.retailv2.WriteUsersRequest.ExtraEntry
+	33, // 3: bytedance.byteplus.retailv2.WriteUsersRequest.extra:type_name -> bytedance.byteplus.retailv2.WriteUsersRequest.ExtraEntry
 	0,  // 4: bytedance.byteplus.retailv2.UserError.user:type_name -> bytedance.byteplus.retailv2.User
-	42, // 5: bytedance.byteplus.retailv2.WriteUsersResponse.status:type_name -> bytedance.byteplus.common.Status
+	59, // 5: bytedance.byteplus.retailv2.WriteUsersResponse.status:type_name -> bytedance.byteplus.common.Status
 	2,  // 6: bytedance.byteplus.retailv2.WriteUsersResponse.errors:type_name -> bytedance.byteplus.retailv2.UserError
-	20, // 7: bytedance.byteplus.retailv2.Product.categories:type_name -> bytedance.byteplus.retailv2.Product.Category
-	21, // 8: bytedance.byteplus.retailv2.Product.brands:type_name -> bytedance.byteplus.retailv2.Product.Brand
-	22, // 9: bytedance.byteplus.retailv2.Product.price:type_name -> bytedance.byteplus.retailv2.Product.Price
-	23, // 10: bytedance.byteplus.retailv2.Product.display:type_name -> bytedance.byteplus.retailv2.Product.Display
-	24, // 11: bytedance.byteplus.retailv2.Product.product_spec:type_name -> bytedance.byteplus.retailv2.Product.ProductSpec
-	25, // 12: bytedance.byteplus.retailv2.Product.seller:type_name -> bytedance.byteplus.retailv2.Product.Seller
-	26, // 13: bytedance.byteplus.retailv2.Product.extra:type_name -> bytedance.byteplus.retailv2.Product.ExtraEntry
-	4,  // 14: bytedance.byteplus.retailv2.WriteProductsRequest.products:type_name -> bytedance.byteplus.retailv2.Product
-	28, // 15: bytedance.byteplus.retailv2.WriteProductsRequest.extra:type_name -> bytedance.byteplus.retailv2.WriteProductsRequest.ExtraEntry
-	4,  // 16: bytedance.byteplus.retailv2.ProductError.product:type_name -> bytedance.byteplus.retailv2.Product
-	42, // 17: bytedance.byteplus.retailv2.WriteProductsResponse.status:type_name -> bytedance.byteplus.common.Status
-	6,  // 18: bytedance.byteplus.retailv2.WriteProductsResponse.errors:type_name -> bytedance.byteplus.retailv2.ProductError
-	29, // 19: bytedance.byteplus.retailv2.UserEvent.scene:type_name -> bytedance.byteplus.retailv2.UserEvent.Scene
-	30, // 20: bytedance.byteplus.retailv2.UserEvent.device:type_name -> bytedance.byteplus.retailv2.UserEvent.Device
-	31, // 21: bytedance.byteplus.retailv2.UserEvent.context:type_name -> bytedance.byteplus.retailv2.UserEvent.Context
-	32, // 22: bytedance.byteplus.retailv2.UserEvent.extra:type_name -> bytedance.byteplus.retailv2.UserEvent.ExtraEntry
-	8,  // 23: bytedance.byteplus.retailv2.WriteUserEventsRequest.user_events:type_name -> bytedance.byteplus.retailv2.UserEvent
-	33, // 24: bytedance.byteplus.retailv2.WriteUserEventsRequest.extra:type_name -> bytedance.byteplus.retailv2.WriteUserEventsRequest.ExtraEntry
-	8,  // 25: bytedance.byteplus.retailv2.UserEventError.user_event:type_name -> bytedance.byteplus.retailv2.UserEvent
-	42, // 26: bytedance.byteplus.retailv2.WriteUserEventsResponse.status:type_name -> bytedance.byteplus.common.Status
-	10, // 27: bytedance.byteplus.retailv2.WriteUserEventsResponse.errors:type_name -> bytedance.byteplus.retailv2.UserEventError
-	29, // 28: bytedance.byteplus.retailv2.PredictRequest.scene:type_name -> bytedance.byteplus.retailv2.UserEvent.Scene
-	34, // 29: bytedance.byteplus.retailv2.PredictRequest.context:type_name -> bytedance.byteplus.retailv2.PredictRequest.Context
-	35, // 30: bytedance.byteplus.retailv2.PredictRequest.extra:type_name -> bytedance.byteplus.retailv2.PredictRequest.ExtraEntry
-	36, // 31: bytedance.byteplus.retailv2.PredictResult.response_products:type_name -> bytedance.byteplus.retailv2.PredictResult.ResponseProduct
-	37, // 32: bytedance.byteplus.retailv2.PredictResult.extra:type_name -> bytedance.byteplus.retailv2.PredictResult.ExtraEntry
-	42, // 33: bytedance.byteplus.retailv2.PredictResponse.status:type_name -> bytedance.byteplus.common.Status
-	13, // 34: bytedance.byteplus.retailv2.PredictResponse.value:type_name -> bytedance.byteplus.retailv2.PredictResult
-	29, // 35: bytedance.byteplus.retailv2.AckServerImpressionsRequest.scene:type_name -> bytedance.byteplus.retailv2.UserEvent.Scene
-	39, // 36: bytedance.byteplus.retailv2.AckServerImpressionsRequest.altered_products:type_name -> bytedance.byteplus.retailv2.AckServerImpressionsRequest.AlteredProduct
-	40, // 37: bytedance.byteplus.retailv2.AckServerImpressionsRequest.extra:type_name -> bytedance.byteplus.retailv2.AckServerImpressionsRequest.ExtraEntry
-	42, // 38: bytedance.byteplus.retailv2.AckServerImpressionsResponse.status:type_name -> bytedance.byteplus.common.Status
-	27, // 39: bytedance.byteplus.retailv2.Product.Category.category_nodes:type_name -> bytedance.byteplus.retailv2.Product.Category.CategoryNode
-	4,  // 40: bytedance.byteplus.retailv2.PredictRequest.Context.root_product:type_name -> bytedance.byteplus.retailv2.Product
-	30, // 41: bytedance.byteplus.retailv2.PredictRequest.Context.device:type_name -> bytedance.byteplus.retailv2.UserEvent.Device
-	38, // 42: bytedance.byteplus.retailv2.PredictResult.ResponseProduct.extra:type_name -> bytedance.byteplus.retailv2.PredictResult.ResponseProduct.ExtraEntry
-	41, // 43: bytedance.byteplus.retailv2.AckServerImpressionsRequest.AlteredProduct.extra:type_name -> bytedance.byteplus.retailv2.AckServerImpressionsRequest.AlteredProduct.ExtraEntry
-	1,  // 44: bytedance.byteplus.retailv2.ByteplusRetailService.WriteUsers:input_type -> bytedance.byteplus.retailv2.WriteUsersRequest
-	5,  // 45: bytedance.byteplus.retailv2.ByteplusRetailService.WriteProducts:input_type -> bytedance.byteplus.retailv2.WriteProductsRequest
-	9,  // 46: bytedance.byteplus.retailv2.ByteplusRetailService.WriteUserEvents:input_type -> bytedance.byteplus.retailv2.WriteUserEventsRequest
-	12, // 47: bytedance.byteplus.retailv2.ByteplusRetailService.Predict:input_type -> bytedance.byteplus.retailv2.PredictRequest
-	15, // 48: bytedance.byteplus.retailv2.ByteplusRetailService.AckServerImpressions:input_type -> bytedance.byteplus.retailv2.AckServerImpressionsRequest
-	3,  // 49: bytedance.byteplus.retailv2.ByteplusRetailService.WriteUsers:output_type -> bytedance.byteplus.retailv2.WriteUsersResponse
-	7,  // 50: bytedance.byteplus.retailv2.ByteplusRetailService.WriteProducts:output_type -> bytedance.byteplus.retailv2.WriteProductsResponse
-	11, // 51: bytedance.byteplus.retailv2.ByteplusRetailService.WriteUserEvents:output_type -> bytedance.byteplus.retailv2.WriteUserEventsResponse
-	14, // 52: bytedance.byteplus.retailv2.ByteplusRetailService.Predict:output_type -> bytedance.byteplus.retailv2.PredictResponse
-	16, // 53: bytedance.byteplus.retailv2.ByteplusRetailService.AckServerImpressions:output_type -> bytedance.byteplus.retailv2.AckServerImpressionsResponse
-	49, // [49:54] is the sub-list for method output_type
-	44, // [44:49] is the sub-list for method input_type
-	44, // [44:44] is the sub-list for extension type_name
-	44, // [44:44] is the sub-list for extension extendee
-	0,  // [0:44] is the sub-list for field type_name
+	0,  // 7: bytedance.byteplus.retailv2.UsersInlineSource.users:type_name -> bytedance.byteplus.retailv2.User
+	4,  // 8: bytedance.byteplus.retailv2.UsersInputConfig.users_inline_source:type_name -> bytedance.byteplus.retailv2.UsersInlineSource
+	5,  // 9: bytedance.byteplus.retailv2.ImportUsersRequest.input_config:type_name -> bytedance.byteplus.retailv2.UsersInputConfig
+	6,  // 10: bytedance.byteplus.retailv2.ImportUsersRequest.date_config:type_name -> bytedance.byteplus.retailv2.DateConfig
+	7,  // 11: bytedance.byteplus.retailv2.ImportUsersRequest.errors_config:type_name -> bytedance.byteplus.retailv2.ImportErrorsConfig
+	34, // 12: bytedance.byteplus.retailv2.ImportUsersRequest.extra:type_name -> bytedance.byteplus.retailv2.ImportUsersRequest.ExtraEntry
+	59, // 13: bytedance.byteplus.retailv2.ImportUsersResponse.status:type_name -> bytedance.byteplus.common.Status
+	2,  // 14: bytedance.byteplus.retailv2.ImportUsersResponse.error_samples:type_name -> bytedance.byteplus.retailv2.UserError
+	35, // 15: bytedance.byteplus.retailv2.Product.categories:type_name -> bytedance.byteplus.retailv2.Product.Category
+	36, // 16: bytedance.byteplus.retailv2.Product.brands:type_name -> bytedance.byteplus.retailv2.Product.Brand
+	37, // 17: bytedance.byteplus.retailv2.Product.price:type_name -> bytedance.byteplus.retailv2.Product.Price
+	38, // 18: bytedance.byteplus.retailv2.Product.display:type_name -> bytedance.byteplus.retailv2.Product.Display
+	39, // 19: bytedance.byteplus.retailv2.Product.product_spec:type_name -> bytedance.byteplus.retailv2.Product.ProductSpec
+	40, // 20: bytedance.byteplus.retailv2.Product.seller:type_name -> bytedance.byteplus.retailv2.Product.Seller
+	41, // 21: bytedance.byteplus.retailv2.Product.extra:type_name -> bytedance.byteplus.retailv2.Product.ExtraEntry
+	10, // 22: bytedance.byteplus.retailv2.WriteProductsRequest.products:type_name -> bytedance.byteplus.retailv2.Product
+	43, // 23: bytedance.byteplus.retailv2.WriteProductsRequest.extra:type_name -> bytedance.byteplus.retailv2.WriteProductsRequest.ExtraEntry
+	10, // 24: bytedance.byteplus.retailv2.ProductError.product:type_name -> bytedance.byteplus.retailv2.Product
+	59, // 25: bytedance.byteplus.retailv2.WriteProductsResponse.status:type_name -> bytedance.byteplus.common.Status
+	12, // 26: bytedance.byteplus.retailv2.WriteProductsResponse.errors:type_name -> bytedance.byteplus.retailv2.ProductError
+	10, // 27: bytedance.byteplus.retailv2.ProductsInlineSource.products:type_name -> bytedance.byteplus.retailv2.Product
+	14, // 28: bytedance.byteplus.retailv2.ProductsInputConfig.products_inline_source:type_name -> bytedance.byteplus.retailv2.ProductsInlineSource
+	15, // 29: bytedance.byteplus.retailv2.ImportProductsRequest.input_config:type_name -> bytedance.byteplus.retailv2.ProductsInputConfig
+	6,  // 30: bytedance.byteplus.retailv2.ImportProductsRequest.date_config:type_name -> bytedance.byteplus.retailv2.DateConfig
+	7,  // 31: bytedance.byteplus.retailv2.ImportProductsRequest.errors_config:type_name -> bytedance.byteplus.retailv2.ImportErrorsConfig
+	44, // 32: bytedance.byteplus.retailv2.ImportProductsRequest.extra:type_name -> bytedance.byteplus.retailv2.ImportProductsRequest.ExtraEntry
+	59, // 33: bytedance.byteplus.retailv2.ImportProductsResponse.status:type_name -> bytedance.byteplus.common.Status
+	12, // 34: bytedance.byteplus.retailv2.ImportProductsResponse.error_samples:type_name -> bytedance.byteplus.retailv2.ProductError
+	45, // 35: bytedance.byteplus.retailv2.UserEvent.scene:type_name -> bytedance.byteplus.retailv2.UserEvent.Scene
+	46, // 36: bytedance.byteplus.retailv2.UserEvent.device:type_name -> bytedance.byteplus.retailv2.UserEvent.Device
+	47, // 37: bytedance.byteplus.retailv2.UserEvent.context:type_name -> bytedance.byteplus.retailv2.UserEvent.Context
+	48, // 38: bytedance.byteplus.retailv2.UserEvent.extra:type_name -> bytedance.byteplus.retailv2.UserEvent.ExtraEntry
+	18, // 39: bytedance.byteplus.retailv2.WriteUserEventsRequest.user_events:type_name -> bytedance.byteplus.retailv2.UserEvent
+	49, // 40: bytedance.byteplus.retailv2.WriteUserEventsRequest.extra:type_name -> bytedance.byteplus.retailv2.WriteUserEventsRequest.ExtraEntry
+	18, // 41: bytedance.byteplus.retailv2.UserEventError.user_event:type_name -> bytedance.byteplus.retailv2.UserEvent
+	59, // 42: bytedance.byteplus.retailv2.WriteUserEventsResponse.status:type_name -> bytedance.byteplus.common.Status
+	20, // 43: bytedance.byteplus.retailv2.WriteUserEventsResponse.errors:type_name -> bytedance.byteplus.retailv2.UserEventError
+	18, // 44: bytedance.byteplus.retailv2.UserEventsInlineSource.user_events:type_name -> bytedance.byteplus.retailv2.UserEvent
+	22, // 45: bytedance.byteplus.retailv2.UserEventsInputConfig.user_events_inline_source:type_name -> bytedance.byteplus.retailv2.UserEventsInlineSource
+	23, // 46: bytedance.byteplus.retailv2.ImportUserEventsRequest.input_config:type_name -> bytedance.byteplus.retailv2.UserEventsInputConfig
+	6,  // 47: bytedance.byteplus.retailv2.ImportUserEventsRequest.date_config:type_name -> bytedance.byteplus.retailv2.DateConfig
+	7,  // 48: bytedance.byteplus.retailv2.ImportUserEventsRequest.errors_config:type_name -> bytedance.byteplus.retailv2.ImportErrorsConfig
+	50, // 49: bytedance.byteplus.retailv2.ImportUserEventsRequest.extra:type_name -> bytedance.byteplus.retailv2.ImportUserEventsRequest.ExtraEntry
+	59, // 50: bytedance.byteplus.retailv2.ImportUserEventsResponse.status:type_name -> bytedance.byteplus.common.Status
+	20, // 51: bytedance.byteplus.retailv2.ImportUserEventsResponse.error_samples:type_name -> bytedance.byteplus.retailv2.UserEventError
+	45, // 52: bytedance.byteplus.retailv2.PredictRequest.scene:type_name -> bytedance.byteplus.retailv2.UserEvent.Scene
+	51, // 53: bytedance.byteplus.retailv2.PredictRequest.context:type_name -> bytedance.byteplus.retailv2.PredictRequest.Context
+	52, // 54: bytedance.byteplus.retailv2.PredictRequest.extra:type_name -> bytedance.byteplus.retailv2.PredictRequest.ExtraEntry
+	53, // 55: bytedance.byteplus.retailv2.PredictResult.response_products:type_name -> bytedance.byteplus.retailv2.PredictResult.ResponseProduct
+	54, // 56: bytedance.byteplus.retailv2.PredictResult.extra:type_name -> bytedance.byteplus.retailv2.PredictResult.ExtraEntry
+	59, // 57: bytedance.byteplus.retailv2.PredictResponse.status:type_name -> bytedance.byteplus.common.Status
+	27, // 58: bytedance.byteplus.retailv2.PredictResponse.value:type_name -> bytedance.byteplus.retailv2.PredictResult
+	45, // 59: bytedance.byteplus.retailv2.AckServerImpressionsRequest.scene:type_name -> bytedance.byteplus.retailv2.UserEvent.Scene
+	56, // 60: bytedance.byteplus.retailv2.AckServerImpressionsRequest.altered_products:type_name -> bytedance.byteplus.retailv2.AckServerImpressionsRequest.AlteredProduct
+	57, // 61: bytedance.byteplus.retailv2.AckServerImpressionsRequest.extra:type_name -> bytedance.byteplus.retailv2.AckServerImpressionsRequest.ExtraEntry
+	59, // 62: bytedance.byteplus.retailv2.AckServerImpressionsResponse.status:type_name -> bytedance.byteplus.common.Status
+	42, // 63: bytedance.byteplus.retailv2.Product.Category.category_nodes:type_name -> bytedance.byteplus.retailv2.Product.Category.CategoryNode
+	10, // 64: bytedance.byteplus.retailv2.PredictRequest.Context.root_product:type_name -> bytedance.byteplus.retailv2.Product
+	46, // 65: bytedance.byteplus.retailv2.PredictRequest.Context.device:type_name -> bytedance.byteplus.retailv2.UserEvent.Device
+	55, // 66: bytedance.byteplus.retailv2.PredictResult.ResponseProduct.extra:type_name -> bytedance.byteplus.retailv2.PredictResult.ResponseProduct.ExtraEntry
+	58, // 67: bytedance.byteplus.retailv2.AckServerImpressionsRequest.AlteredProduct.extra:type_name -> bytedance.byteplus.retailv2.AckServerImpressionsRequest.AlteredProduct.ExtraEntry
+	1,  // 68: bytedance.byteplus.retailv2.ByteplusRetailService.WriteUsers:input_type -> bytedance.byteplus.retailv2.WriteUsersRequest
+	8,  // 69: bytedance.byteplus.retailv2.ByteplusRetailService.ImportUsers:input_type -> bytedance.byteplus.retailv2.ImportUsersRequest
+	11, // 70: bytedance.byteplus.retailv2.ByteplusRetailService.WriteProducts:input_type -> bytedance.byteplus.retailv2.WriteProductsRequest
+	16, // 71: bytedance.byteplus.retailv2.ByteplusRetailService.ImportProducts:input_type -> bytedance.byteplus.retailv2.ImportProductsRequest
+	19, // 72: bytedance.byteplus.retailv2.ByteplusRetailService.WriteUserEvents:input_type -> bytedance.byteplus.retailv2.WriteUserEventsRequest
+	24, // 73: bytedance.byteplus.retailv2.ByteplusRetailService.ImportUserEvents:input_type -> bytedance.byteplus.retailv2.ImportUserEventsRequest
+	26, // 74: bytedance.byteplus.retailv2.ByteplusRetailService.Predict:input_type -> bytedance.byteplus.retailv2.PredictRequest
+	29, // 75: bytedance.byteplus.retailv2.ByteplusRetailService.AckServerImpressions:input_type -> bytedance.byteplus.retailv2.AckServerImpressionsRequest
+	3,  // 76: bytedance.byteplus.retailv2.ByteplusRetailService.WriteUsers:output_type -> bytedance.byteplus.retailv2.WriteUsersResponse
+	60, // 77: bytedance.byteplus.retailv2.ByteplusRetailService.ImportUsers:output_type -> bytedance.byteplus.common.OperationResponse
+	13, // 78: bytedance.byteplus.retailv2.ByteplusRetailService.WriteProducts:output_type -> bytedance.byteplus.retailv2.WriteProductsResponse
+	60, // 79: bytedance.byteplus.retailv2.ByteplusRetailService.ImportProducts:output_type -> bytedance.byteplus.common.OperationResponse
+	21, // 80: bytedance.byteplus.retailv2.ByteplusRetailService.WriteUserEvents:output_type -> bytedance.byteplus.retailv2.WriteUserEventsResponse
+	60, // 81: bytedance.byteplus.retailv2.ByteplusRetailService.ImportUserEvents:output_type -> bytedance.byteplus.common.OperationResponse
+	28, // 82: bytedance.byteplus.retailv2.ByteplusRetailService.Predict:output_type -> bytedance.byteplus.retailv2.PredictResponse
+	30, // 83: bytedance.byteplus.retailv2.ByteplusRetailService.AckServerImpressions:output_type -> bytedance.byteplus.retailv2.AckServerImpressionsResponse
+	76, // [76:84] is the sub-list for method output_type
+	68, // [68:76] is the sub-list for method input_type
+	68, // [68:68] is the sub-list for extension type_name
+	68, // [68:68] is the sub-list for extension extendee
+	0,  // [0:68] is the sub-list for field type_name
 }
 
 func init() { file_byteplus_retailv2_proto_init() }
@@ -3378,7 +4548,7 @@ func file_byteplus_retailv2_proto_init() {
 			}
 		}
 		file_byteplus_retailv2_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Product); i {
+			switch v := v.(*UsersInlineSource); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3390,7 +4560,7 @@ func file_byteplus_retailv2_proto_init() {
 			}
 		}
 		file_byteplus_retailv2_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*WriteProductsRequest); i {
+			switch v := v.(*UsersInputConfig); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3402,7 +4572,7 @@ func file_byteplus_retailv2_proto_init() {
 			}
 		}
 		file_byteplus_retailv2_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProductError); i {
+			switch v := v.(*DateConfig); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3414,7 +4584,7 @@ func file_byteplus_retailv2_proto_init() {
 			}
 		}
 		file_byteplus_retailv2_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*WriteProductsResponse); i {
+			switch v := v.(*ImportErrorsConfig); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3426,7 +4596,7 @@ func file_byteplus_retailv2_proto_init() {
 			}
 		}
 		file_byteplus_retailv2_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UserEvent); i {
+			switch v := v.(*ImportUsersRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3438,7 +4608,7 @@ func file_byteplus_retailv2_proto_init() {
 			}
 		}
 		file_byteplus_retailv2_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*WriteUserEventsRequest); i {
+			switch v := v.(*ImportUsersResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3450,7 +4620,7 @@ func file_byteplus_retailv2_proto_init() {
 			}
 		}
 		file_byteplus_retailv2_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UserEventError); i {
+			switch v := v.(*Product); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3462,7 +4632,7 @@ func file_byteplus_retailv2_proto_init() {
 			}
 		}
 		file_byteplus_retailv2_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*WriteUserEventsResponse); i {
+			switch v := v.(*WriteProductsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3474,7 +4644,7 @@ func file_byteplus_retailv2_proto_init() {
 			}
 		}
 		file_byteplus_retailv2_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PredictRequest); i {
+			switch v := v.(*ProductError); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3486,7 +4656,7 @@ func file_byteplus_retailv2_proto_init() {
 			}
 		}
 		file_byteplus_retailv2_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PredictResult); i {
+			switch v := v.(*WriteProductsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3498,7 +4668,7 @@ func file_byteplus_retailv2_proto_init() {
 			}
 		}
 		file_byteplus_retailv2_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PredictResponse); i {
+			switch v := v.(*ProductsInlineSource); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3510,7 +4680,7 @@ func file_byteplus_retailv2_proto_init() {
 			}
 		}
 		file_byteplus_retailv2_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AckServerImpressionsRequest); i {
+			switch v := v.(*ProductsInputConfig); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3522,7 +4692,7 @@ func file_byteplus_retailv2_proto_init() {
 			}
 		}
 		file_byteplus_retailv2_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AckServerImpressionsResponse); i {
+			switch v := v.(*ImportProductsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3534,7 +4704,31 @@ func file_byteplus_retailv2_proto_init() {
 			}
 		}
 		file_byteplus_retailv2_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*User_Location); i {
+			switch v := v.(*ImportProductsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_byteplus_retailv2_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_byteplus_retailv2_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WriteUserEventsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3546,7 +4740,7 @@ func file_byteplus_retailv2_proto_init() {
 			}
 		}
 		file_byteplus_retailv2_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Product_Category); i {
+			switch v := v.(*UserEventError); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3558,7 +4752,7 @@ func file_byteplus_retailv2_proto_init() {
 			}
 		}
 		file_byteplus_retailv2_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Product_Brand); i {
+			switch v := v.(*WriteUserEventsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3570,7 +4764,7 @@ func file_byteplus_retailv2_proto_init() {
 			}
 		}
 		file_byteplus_retailv2_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Product_Price); i {
+			switch v := v.(*UserEventsInlineSource); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3582,7 +4776,7 @@ func file_byteplus_retailv2_proto_init() {
 			}
 		}
 		file_byteplus_retailv2_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Product_Display); i {
+			switch v := v.(*UserEventsInputConfig); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3594,7 +4788,7 @@ func file_byteplus_retailv2_proto_init() {
 			}
 		}
 		file_byteplus_retailv2_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Product_ProductSpec); i {
+			switch v := v.(*ImportUserEventsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3606,7 +4800,19 @@ func file_byteplus_retailv2_proto_init() {
 			}
 		}
 		file_byteplus_retailv2_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Product_Seller); i {
+			switch v := v.(*ImportUserEventsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_byteplus_retailv2_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PredictRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3618,7 +4824,19 @@ func file_byteplus_retailv2_proto_init() {
 			}
 		}
 		file_byteplus_retailv2_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Product_Category_CategoryNode); i {
+			switch v := v.(*PredictResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_byteplus_retailv2_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PredictResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3630,7 +4848,7 @@ func file_byteplus_retailv2_proto_init() {
 			}
 		}
 		file_byteplus_retailv2_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UserEvent_Scene); i {
+			switch v := v.(*AckServerImpressionsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3642,7 +4860,7 @@ func file_byteplus_retailv2_proto_init() {
 			}
 		}
 		file_byteplus_retailv2_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UserEvent_Device); i {
+			switch v := v.(*AckServerImpressionsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3654,6 +4872,126 @@ func file_byteplus_retailv2_proto_init() {
 			}
 		}
 		file_byteplus_retailv2_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*User_Location); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_byteplus_retailv2_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Product_Category); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_byteplus_retailv2_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Product_Brand); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_byteplus_retailv2_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Product_Price); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_byteplus_retailv2_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Product_Display); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_byteplus_retailv2_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Product_ProductSpec); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_byteplus_retailv2_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Product_Seller); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_byteplus_retailv2_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Product_Category_CategoryNode); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_byteplus_retailv2_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserEvent_Scene); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_byteplus_retailv2_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserEvent_Device); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_byteplus_retailv2_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*UserEvent_Context); i {
 			case 0:
 				return &v.state
@@ -3665,7 +5003,7 @@ func file_byteplus_retailv2_proto_init() {
 				return nil
 			}
 		}
-		file_byteplus_retailv2_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+		file_byteplus_retailv2_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*PredictRequest_Context); i {
 			case 0:
 				return &v.state
@@ -3677,7 +5015,7 @@ func file_byteplus_retailv2_proto_init() {
 				return nil
 			}
 		}
-		file_byteplus_retailv2_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+		file_byteplus_retailv2_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*PredictResult_ResponseProduct); i {
 			case 0:
 				return &v.state
@@ -3689,7 +5027,7 @@ func file_byteplus_retailv2_proto_init() {
 				return nil
 			}
 		}
-		file_byteplus_retailv2_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+		file_byteplus_retailv2_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*AckServerImpressionsRequest_AlteredProduct); i {
 			case 0:
 				return &v.state
@@ -3702,13 +5040,25 @@ func file_byteplus_retailv2_proto_init() {
 			}
 		}
 	}
+	file_byteplus_retailv2_proto_msgTypes[5].OneofWrappers = []interface{}{
+		(*UsersInputConfig_UsersInlineSource)(nil),
+	}
+	file_byteplus_retailv2_proto_msgTypes[7].OneofWrappers = []interface{}{
+		(*ImportErrorsConfig_EmptyDestination)(nil),
+	}
+	file_byteplus_retailv2_proto_msgTypes[15].OneofWrappers = []interface{}{
+		(*ProductsInputConfig_ProductsInlineSource)(nil),
+	}
+	file_byteplus_retailv2_proto_msgTypes[23].OneofWrappers = []interface{}{
+		(*UserEventsInputConfig_UserEventsInlineSource)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_byteplus_retailv2_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   42,
+			NumMessages:   59,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
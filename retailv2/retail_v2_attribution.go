@@ -0,0 +1,60 @@
+package retailv2
+
+import (
+	. "github.com/byteplus-sdk/sdk-go/core"
+	. "github.com/byteplus-sdk/sdk-go/retailv2/protocol"
+)
+
+// trackPredict remembers the products of the successful predict response,
+// fallback responses are not tracked as they are not recommended by BytePlus
+func trackPredict(tracker *AttributionTracker, request *PredictRequest,
+	scene string, response *PredictResponse) {
+	if tracker == nil || response.GetStatus().GetCode() != StatusCodeSuccess {
+		return
+	}
+	if _, fallback := response.GetValue().GetExtra()[PredictFallbackExtraKey]; fallback {
+		return
+	}
+	if name := request.GetScene().GetSceneName(); name != "" {
+		scene = name
+	}
+	for _, product := range response.GetValue().GetResponseProducts() {
+		tracker.Track(request.GetUserId(), product.GetProductId(), &Attribution{
+			RequestId:  response.GetRequestId(),
+			RecInfo:    product.GetRecInfo(),
+			SceneName:  scene,
+			PageNumber: request.GetScene().GetPageNumber(),
+			Offset:     product.GetRank(),
+		})
+	}
+}
+
+// attributeUserEvents fills the empty attribution_token, rec_info and scene
+// of the events whose product was shown to the user by Predict.
+// The events are modified in place, so the request of the caller carries the
+// filled fields after the call. The traffic_source is left to the caller, as
+// the event may not be led by the recommendation even if the item was shown
+func attributeUserEvents(tracker *AttributionTracker, userEvents []*UserEvent) {
+	if tracker == nil {
+		return
+	}
+	for _, userEvent := range userEvents {
+		attribution := tracker.Lookup(userEvent.GetUserId(), userEvent.GetProductId())
+		if attribution == nil {
+			continue
+		}
+		if userEvent.AttributionToken == "" {
+			userEvent.AttributionToken = attribution.RequestId
+		}
+		if userEvent.RecInfo == "" {
+			userEvent.RecInfo = attribution.RecInfo
+		}
+		if userEvent.Scene == nil {
+			userEvent.Scene = &UserEvent_Scene{
+				SceneName:  attribution.SceneName,
+				PageNumber: attribution.PageNumber,
+				Offset:     attribution.Offset,
+			}
+		}
+	}
+}
@@ -2,6 +2,8 @@ package retailv2
 
 import (
 	"github.com/byteplus-sdk/sdk-go/common"
+	. "github.com/byteplus-sdk/sdk-go/common/protocol"
+	"github.com/byteplus-sdk/sdk-go/core"
 	"github.com/byteplus-sdk/sdk-go/core/option"
 	. "github.com/byteplus-sdk/sdk-go/retailv2/protocol"
 )
@@ -13,6 +15,13 @@ type Client interface {
 	// release resources
 	Release()
 
+	// HealthSnapshot
+	//
+	// Returns the health state of every host observed by the sdk, including
+	// the failure rate in window, recent ping latencies, the last ping error
+	// and the available hosts, which can be used to show the routing state.
+	HealthSnapshot() *core.HealthSnapshot
+
 	// WriteUsers
 	//
 	// Writes at most 2000 users at a time. Exceeding 2000 in a request protocol.results protocol.in
@@ -20,6 +29,21 @@ type Client interface {
 	// users (by providing all the fields).
 	WriteUsers(request *WriteUsersRequest, opts ...option.Option) (*WriteUsersResponse, error)
 
+	// ImportUsers
+	//
+	// Bulk import of Users.
+	//
+	// `Operation.response` is of type ImportUsersResponse. Note that it is
+	// possible for a subset of the items to be successfully inserted.
+	// Operation.metadata is of type Metadata.
+	// This call returns immediately after the server finishes the
+	// preliminary validations and persists the request. The caller should
+	// keep polling `OperationResponse.operation.name` using `GetOperation`
+	// call below to check the status.
+	// Note: This can also be used to update the existing data by providing the
+	// existing ids. In this case, please make sure you provide all fields.
+	ImportUsers(request *ImportUsersRequest, opts ...option.Option) (*OperationResponse, error)
+
 	// WriteProducts
 	//
 	// Writes at most 2000 products at a time. Exceeding 2000 in a request protocol.protocol.results
@@ -30,6 +54,21 @@ type Client interface {
 	// setting `product.is_recommendable` to False.
 	WriteProducts(request *WriteProductsRequest, opts ...option.Option) (*WriteProductsResponse, error)
 
+	// ImportProducts
+	//
+	// Bulk import of Products.
+	//
+	// `Operation.response` is of type ImportProductsResponse. Note that it is
+	// possible for a subset of the items to be successfully inserted.
+	// Operation.metadata is of type Metadata.
+	// This call returns immediately after the server finishes the preliminary
+	// validations and persists the request.  The caller should keep polling
+	// `OperationResponse.operation.name` using `GetOperation` call below to
+	// check the status.
+	// Note: This can also be used to update the existing data by providing the
+	// existing ids. In this case, please make sure you provide all fields.
+	ImportProducts(request *ImportProductsRequest, opts ...option.Option) (*OperationResponse, error)
+
 	// WriteUserEvents
 	//
 	// Writes at most 2000 UserEvents at a time. Exceeding 2000 in a request
@@ -37,8 +76,24 @@ type Client interface {
 	// UserEvents.  Note: This is processing realtime data, so we won't dedupe
 	// the requests.
 	// Please make sure the requests are deduplicated before sending over.
+	// When AttributionConfig is set, the empty attribution fields of the events
+	// are filled from the recent Predict results, modifying the request in place.
 	WriteUserEvents(request *WriteUserEventsRequest, opts ...option.Option) (*WriteUserEventsResponse, error)
 
+	// ImportUserEvents
+	//
+	// Bulk import of User events.
+	//
+	// `Operation.response` is of type ImportUserEventsResponse. Note that it is
+	// possible for a subset of the items to be successfully inserted.
+	// Operation.metadata is of type Metadata.
+	// This call returns immediately after the server finishes the preliminary
+	// validations and persists the request.  The caller should keep polling
+	// `OperationResponse.operation.name` using `GetOperation` call below to
+	// check the status.
+	// Please make sure the requests are deduplicated before sending over.
+	ImportUserEvents(request *ImportUserEventsRequest, opts ...option.Option) (*OperationResponse, error)
+
 	// Predict
 	//
 	// Gets the list of products (ranked).
@@ -6,36 +6,59 @@ import (
 	"strings"
 
 	"github.com/byteplus-sdk/sdk-go/common"
+	. "github.com/byteplus-sdk/sdk-go/common/protocol"
 	. "github.com/byteplus-sdk/sdk-go/core"
 	"github.com/byteplus-sdk/sdk-go/core/logs"
 	"github.com/byteplus-sdk/sdk-go/core/option"
 	. "github.com/byteplus-sdk/sdk-go/retailv2/protocol"
+	"google.golang.org/protobuf/proto"
 )
 
 var (
 	writeMsgFormat  = "Only can receive max to %d items in one write request"
 	writeTooManyErr = errors.New(fmt.Sprintf(writeMsgFormat, MaxWriteItemCount))
+
+	importMsgFormat  = "Only can receive max to %d items in one import request"
+	importTooManyErr = errors.New(fmt.Sprintf(importMsgFormat, MaxImportItemCount))
 )
 
 type clientImpl struct {
 	common.Client
-	hCaller *HTTPCaller
-	ru      *retailURL
-	hostAva *HostAvailabler
+	hCaller   *HTTPCaller
+	ru        *retailURL
+	hostAva   *HostAvailabler
+	pCache    *PredictCache
+	pFallback *PredictFallback
+	tracker   *AttributionTracker
+	dlSink    DeadLetterSink
 }
 
 func (c *clientImpl) Release() {
 	c.hostAva.Shutdown()
 }
 
+func (c *clientImpl) HealthSnapshot() *HealthSnapshot {
+	return c.hostAva.HealthSnapshot()
+}
+
 func (c *clientImpl) WriteUsers(request *WriteUsersRequest,
 	opts ...option.Option) (*WriteUsersResponse, error) {
 	if len(request.Users) > MaxWriteItemCount {
 		return nil, writeTooManyErr
 	}
+	options := option.Conv2Options(opts...)
+	if options.Validate {
+		if err := validateUsers(request.Users); err != nil {
+			return nil, err
+		}
+	}
 	url := c.ru.writeUsersURL
 	response := &WriteUsersResponse{}
-	err := c.hCaller.DoPBRequest(url, request, response, option.Conv2Options(opts...))
+	PrepareDeadLetters(c.dlSink, options)
+	err := c.hCaller.DoPBRequest(url, request, response, options)
+	WriteDeadLetters(c.dlSink, options, func() []interface{} {
+		return usersItems(request.Users)
+	}, response, err, usersFailedItems)
 	if err != nil {
 		return nil, err
 	}
@@ -43,14 +66,42 @@ func (c *clientImpl) WriteUsers(request *WriteUsersRequest,
 	return response, nil
 }
 
+func (c *clientImpl) ImportUsers(request *ImportUsersRequest,
+	opts ...option.Option) (*OperationResponse, error) {
+	users := request.GetInputConfig().GetUsersInlineSource().GetUsers()
+	if len(users) > MaxImportItemCount {
+		return nil, importTooManyErr
+	}
+	options := option.Conv2Options(opts...)
+	FillDateOptions(options, request.GetDateConfig().GetDate(), request.GetDateConfig().GetIsEnd())
+	url := c.ru.importUsersURL
+	response := &OperationResponse{}
+	err := c.hCaller.DoPBRequest(url, request, response, options)
+	if err != nil {
+		return nil, err
+	}
+	logs.Debug("[ImportUsers] rsp:\n%s\n", response)
+	return response, nil
+}
+
 func (c *clientImpl) WriteProducts(request *WriteProductsRequest,
 	opts ...option.Option) (*WriteProductsResponse, error) {
 	if len(request.Products) > MaxWriteItemCount {
 		return nil, writeTooManyErr
 	}
+	options := option.Conv2Options(opts...)
+	if options.Validate {
+		if err := validateProducts(request.Products); err != nil {
+			return nil, err
+		}
+	}
 	url := c.ru.writeProductsURL
 	response := &WriteProductsResponse{}
-	err := c.hCaller.DoPBRequest(url, request, response, option.Conv2Options(opts...))
+	PrepareDeadLetters(c.dlSink, options)
+	err := c.hCaller.DoPBRequest(url, request, response, options)
+	WriteDeadLetters(c.dlSink, options, func() []interface{} {
+		return productsItems(request.Products)
+	}, response, err, productsFailedItems)
 	if err != nil {
 		return nil, err
 	}
@@ -58,14 +109,44 @@ func (c *clientImpl) WriteProducts(request *WriteProductsRequest,
 	return response, nil
 }
 
+func (c *clientImpl) ImportProducts(request *ImportProductsRequest,
+	opts ...option.Option) (*OperationResponse, error) {
+	products := request.GetInputConfig().GetProductsInlineSource().GetProducts()
+	if len(products) > MaxImportItemCount {
+		return nil, importTooManyErr
+	}
+	options := option.Conv2Options(opts...)
+	FillDateOptions(options, request.GetDateConfig().GetDate(), request.GetDateConfig().GetIsEnd())
+	url := c.ru.importProductsURL
+	response := &OperationResponse{}
+	err := c.hCaller.DoPBRequest(url, request, response, options)
+	if err != nil {
+		return nil, err
+	}
+	logs.Debug("[ImportProducts] rsp:\n%s\n", response)
+	return response, nil
+}
+
 func (c *clientImpl) WriteUserEvents(request *WriteUserEventsRequest,
 	opts ...option.Option) (*WriteUserEventsResponse, error) {
 	if len(request.UserEvents) > MaxWriteItemCount {
 		return nil, writeTooManyErr
 	}
+	options := option.Conv2Options(opts...)
+	// the attribution fills the scene, which is required by the validation
+	attributeUserEvents(c.tracker, request.UserEvents)
+	if options.Validate {
+		if err := validateUserEvents(request.UserEvents); err != nil {
+			return nil, err
+		}
+	}
 	url := c.ru.writeUserEventsURL
 	response := &WriteUserEventsResponse{}
-	err := c.hCaller.DoPBRequest(url, request, response, option.Conv2Options(opts...))
+	PrepareDeadLetters(c.dlSink, options)
+	err := c.hCaller.DoPBRequest(url, request, response, options)
+	WriteDeadLetters(c.dlSink, options, func() []interface{} {
+		return userEventsItems(request.UserEvents)
+	}, response, err, userEventsFailedItems)
 	if err != nil {
 		return nil, err
 	}
@@ -73,18 +154,56 @@ func (c *clientImpl) WriteUserEvents(request *WriteUserEventsRequest,
 	return response, nil
 }
 
+func (c *clientImpl) ImportUserEvents(request *ImportUserEventsRequest,
+	opts ...option.Option) (*OperationResponse, error) {
+	userEvents := request.GetInputConfig().GetUserEventsInlineSource().GetUserEvents()
+	if len(userEvents) > MaxImportItemCount {
+		return nil, importTooManyErr
+	}
+	options := option.Conv2Options(opts...)
+	FillDateOptions(options, request.GetDateConfig().GetDate(), request.GetDateConfig().GetIsEnd())
+	url := c.ru.importUserEventsURL
+	response := &OperationResponse{}
+	err := c.hCaller.DoPBRequest(url, request, response, options)
+	if err != nil {
+		return nil, err
+	}
+	logs.Debug("[ImportUserEvents] rsp:\n%s\n", response)
+	return response, nil
+}
+
 func (c *clientImpl) Predict(request *PredictRequest, scene string,
 	opts ...option.Option) (*PredictResponse, error) {
 	url := strings.ReplaceAll(c.ru.predictURLFormat, "{}", scene)
 	response := &PredictResponse{}
-	err := c.hCaller.DoPBRequest(url, request, response, option.Conv2Options(opts...))
+	options := option.Conv2Options(opts...)
+	err := c.pCache.Load(request, scene, options, response,
+		func(options *option.Options, response proto.Message) error {
+			return c.hCaller.DoHedgedPBRequest(url, request, response, options)
+		})
+	err = c.pFallback.Apply(request, scene, response, err)
 	if err != nil {
 		return nil, err
 	}
+	trackPredict(c.tracker, request, scene, response)
 	logs.Debug("[Predict] rsp:\n%s\n", response)
 	return response, nil
 }
 
+// predictShadow sends the secondary request of shadowPredictClient, which neither
+// reads nor changes the state of the primary requests, i.e. the predict cache,
+// fallback and attribution tracking
+func (c *clientImpl) predictShadow(request *PredictRequest, scene string,
+	opts ...option.Option) (*PredictResponse, error) {
+	url := strings.ReplaceAll(c.ru.predictURLFormat, "{}", scene)
+	response := &PredictResponse{}
+	err := c.hCaller.DoHedgedPBRequest(url, request, response, option.Conv2Options(opts...))
+	if err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
 func (c *clientImpl) AckServerImpressions(request *AckServerImpressionsRequest,
 	opts ...option.Option) (*AckServerImpressionsResponse, error) {
 	url := c.ru.ackImpressionURL
@@ -0,0 +1,307 @@
+package retailv2
+
+import (
+	"compress/gzip"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/byteplus-sdk/sdk-go/common/protocol"
+	"github.com/byteplus-sdk/sdk-go/core"
+	"github.com/byteplus-sdk/sdk-go/core/option"
+	. "github.com/byteplus-sdk/sdk-go/retailv2/protocol"
+	"google.golang.org/protobuf/proto"
+)
+
+// stubRequest is a request received by stubServer, whose body is decompressed
+type stubRequest struct {
+	url    string
+	header http.Header
+	body   []byte
+}
+
+// stubServer responds the requests with the message returned by respond
+type stubServer struct {
+	*httptest.Server
+	lock     sync.Mutex
+	requests []*stubRequest
+}
+
+func newStubServer(t *testing.T, respond func(request *stubRequest) proto.Message) *stubServer {
+	server := &stubServer{}
+	server.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		reader, err := gzip.NewReader(r.Body)
+		if err != nil {
+			t.Errorf("request body is not gzipped, err:%v", err)
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		body, _ := ioutil.ReadAll(reader)
+		request := &stubRequest{url: r.URL.String(), header: r.Header, body: body}
+		server.lock.Lock()
+		server.requests = append(server.requests, request)
+		server.lock.Unlock()
+		rspBytes, _ := proto.Marshal(respond(request))
+		_, _ = w.Write(rspBytes)
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func (s *stubServer) received() []*stubRequest {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return append([]*stubRequest(nil), s.requests...)
+}
+
+func newStubClient(t *testing.T, server *stubServer, attributionConfig *core.AttributionConfig) *clientImpl {
+	client, err := (&ClientBuilder{}).
+		Tenant("retail_demo").
+		TenantId("tenant_id").
+		Token("token").
+		Region(core.RegionSg).
+		Schema("http").
+		Hosts([]string{strings.TrimPrefix(server.URL, "http://")}).
+		AttributionConfig(attributionConfig).
+		Build()
+	if err != nil {
+		t.Fatalf("Build() error = %v", err)
+	}
+	t.Cleanup(client.Release)
+	return client.(*clientImpl)
+}
+
+func TestClientImpl_WriteUserEvents_attributeBeforeValidation(t *testing.T) {
+	server := newStubServer(t, func(request *stubRequest) proto.Message {
+		return &WriteUserEventsResponse{Status: &protocol.Status{Code: 0}}
+	})
+	client := newStubClient(t, server, &core.AttributionConfig{})
+	client.tracker.Track("u1", "p1", &core.Attribution{RequestId: "r1", SceneName: "home"})
+	request := &WriteUserEventsRequest{UserEvents: []*UserEvent{
+		{UserId: "u1", EventType: "click", EventTimestamp: time.Now().Unix(), ProductId: "p1"},
+	}}
+	if _, err := client.WriteUserEvents(request, option.WithValidation()); err != nil {
+		t.Fatalf("WriteUserEvents() error = %v", err)
+	}
+	if len(server.received()) != 1 {
+		t.Fatalf("server received %d requests, want 1", len(server.received()))
+	}
+	sent := &WriteUserEventsRequest{}
+	if err := proto.Unmarshal(server.received()[0].body, sent); err != nil {
+		t.Fatalf("unmarshal request error = %v", err)
+	}
+	userEvent := sent.GetUserEvents()[0]
+	if userEvent.GetScene().GetSceneName() != "home" || userEvent.GetAttributionToken() != "r1" {
+		t.Errorf("sent user event = %v, want it's attributed", userEvent)
+	}
+	unknown := &WriteUserEventsRequest{UserEvents: []*UserEvent{
+		{UserId: "u2", EventType: "click", EventTimestamp: time.Now().Unix(), ProductId: "p1"},
+	}}
+	if _, err := client.WriteUserEvents(unknown, option.WithValidation()); err == nil {
+		t.Errorf("WriteUserEvents() of event not attributed without scene should fail")
+	}
+}
+
+func TestShadowPredictClient_bypassPrimaryState(t *testing.T) {
+	var lock sync.Mutex
+	var responded int
+	server := newStubServer(t, func(request *stubRequest) proto.Message {
+		lock.Lock()
+		defer lock.Unlock()
+		responded++
+		productId := "p1"
+		if responded > 1 {
+			productId = "p2"
+		}
+		products := []*PredictResult_ResponseProduct{{ProductId: productId, Rank: 1}}
+		return &PredictResponse{Status: &protocol.Status{Code: 0}, RequestId: request.header.Get("Request-Id"),
+			Value: &PredictResult{ResponseProducts: products}}
+	})
+	primary := newStubClient(t, server, &core.AttributionConfig{})
+	compared := make(chan *core.PredictComparison, 1)
+	client := NewShadowPredictClient(primary, nil, &core.ShadowPredictConfig{
+		SampleRate: 1,
+		OnCompare: func(comparison *core.PredictComparison) {
+			compared <- comparison
+		},
+	})
+	request := &PredictRequest{UserId: "u1"}
+	if _, err := client.Predict(request, "home", option.WithRequestId("r1")); err != nil {
+		t.Fatalf("Predict() error = %v", err)
+	}
+	select {
+	case <-compared:
+	case <-time.After(time.Second):
+		t.Fatalf("shadow request is not compared")
+	}
+	requests := server.received()
+	if len(requests) != 2 {
+		t.Fatalf("server received %d requests, want 2", len(requests))
+	}
+	if id := requests[1].header.Get("Request-Id"); id == "" || id == "r1" {
+		t.Errorf("shadow request id = %q, want a new one", id)
+	}
+	// the products of shadow response are not attributed
+	if primary.tracker.Lookup("u1", "p1") == nil || primary.tracker.Lookup("u1", "p2") != nil {
+		t.Errorf("only the products of primary response should be attributed")
+	}
+}
+
+func TestWriteUsersWithRetry_resubmitOriginalUsers(t *testing.T) {
+	var lock sync.Mutex
+	var attempts int
+	server := newStubServer(t, func(request *stubRequest) proto.Message {
+		lock.Lock()
+		defer lock.Unlock()
+		attempts++
+		if attempts > 1 {
+			return &WriteUsersResponse{Status: &protocol.Status{Code: 0}}
+		}
+		// the server only echoes the id of the failed user
+		return &WriteUsersResponse{Status: &protocol.Status{Code: 0}, Errors: []*UserError{
+			{Message: "internal error", User: &User{UserId: "u2"}},
+			{Message: "internal error"},
+		}}
+	})
+	client := newStubClient(t, server, nil)
+	request := &WriteUsersRequest{Users: []*User{{UserId: "u1", Gender: "male"}, {UserId: "u2", Gender: "female"}}}
+	config := &core.PartialRetryConfig{InitialBackoff: time.Millisecond}
+	report, err := WriteUsersWithRetry(client, request, config)
+	if err != nil {
+		t.Fatalf("WriteUsersWithRetry() error = %v", err)
+	}
+	// the failure without user can't be resubmitted
+	if report.Retries != 1 || len(report.Failed) != 1 || !report.Failed[0].Permanent {
+		t.Errorf("report = %+v", report)
+	}
+	requests := server.received()
+	if len(requests) != 2 {
+		t.Fatalf("server received %d requests, want 2", len(requests))
+	}
+	resubmitted := &WriteUsersRequest{}
+	if err := proto.Unmarshal(requests[1].body, resubmitted); err != nil {
+		t.Fatalf("unmarshal request error = %v", err)
+	}
+	if users := resubmitted.GetUsers(); len(users) != 1 || !proto.Equal(users[0], request.Users[1]) {
+		t.Errorf("resubmitted users = %v, want the original u2", users)
+	}
+}
+
+func TestClientImpl_Import(t *testing.T) {
+	server := newStubServer(t, func(request *stubRequest) proto.Message {
+		return &protocol.OperationResponse{Status: &protocol.Status{Code: 0},
+			Operation: &protocol.Operation{Name: "op"}}
+	})
+	client := newStubClient(t, server, nil)
+	dateConfig := &DateConfig{Date: "2021-06-10", IsEnd: true}
+	tests := []struct {
+		name     string
+		doImport func() (*protocol.OperationResponse, error)
+		wantURL  string
+	}{
+		{
+			name: "users",
+			doImport: func() (*protocol.OperationResponse, error) {
+				return client.ImportUsers(&ImportUsersRequest{
+					InputConfig: &UsersInputConfig{Source: &UsersInputConfig_UsersInlineSource{
+						UsersInlineSource: &UsersInlineSource{Users: []*User{{UserId: "u1"}}}}},
+					DateConfig: dateConfig,
+				})
+			},
+			wantURL: "/data/api/retail/v2/retail_demo/user?method=import",
+		},
+		{
+			name: "products",
+			doImport: func() (*protocol.OperationResponse, error) {
+				return client.ImportProducts(&ImportProductsRequest{
+					InputConfig: &ProductsInputConfig{Source: &ProductsInputConfig_ProductsInlineSource{
+						ProductsInlineSource: &ProductsInlineSource{Products: []*Product{{ProductId: "p1"}}}}},
+					DateConfig: dateConfig,
+				})
+			},
+			wantURL: "/data/api/retail/v2/retail_demo/product?method=import",
+		},
+		{
+			name: "user_events",
+			doImport: func() (*protocol.OperationResponse, error) {
+				return client.ImportUserEvents(&ImportUserEventsRequest{
+					InputConfig: &UserEventsInputConfig{Source: &UserEventsInputConfig_UserEventsInlineSource{
+						UserEventsInlineSource: &UserEventsInlineSource{UserEvents: []*UserEvent{{UserId: "u1"}}}}},
+					DateConfig: dateConfig,
+				})
+			},
+			wantURL: "/data/api/retail/v2/retail_demo/user_event?method=import",
+		},
+	}
+	date, _ := time.ParseInLocation("2006-01-02", "2021-06-10", time.Local)
+	for i, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			response, err := tt.doImport()
+			if err != nil || response.GetOperation().GetName() != "op" {
+				t.Fatalf("import = %v, %v", response, err)
+			}
+			request := server.received()[i]
+			if request.url != tt.wantURL {
+				t.Errorf("url = %s, want %s", request.url, tt.wantURL)
+			}
+			if got := request.header.Get("Content-Date"); got != date.Format(time.RFC3339) {
+				t.Errorf("Content-Date = %s, want %s", got, date.Format(time.RFC3339))
+			}
+			if got := request.header.Get("Content-End"); got != "true" {
+				t.Errorf("Content-End = %s, want true", got)
+			}
+		})
+	}
+}
+
+func TestClientImpl_Import_tooManyItems(t *testing.T) {
+	server := newStubServer(t, func(request *stubRequest) proto.Message {
+		return &protocol.OperationResponse{Status: &protocol.Status{Code: 0}}
+	})
+	client := newStubClient(t, server, nil)
+	users := make([]*User, core.MaxImportItemCount+1)
+	products := make([]*Product, core.MaxImportItemCount+1)
+	userEvents := make([]*UserEvent, core.MaxImportItemCount+1)
+	tests := []struct {
+		name     string
+		doImport func() (*protocol.OperationResponse, error)
+	}{
+		{
+			name: "users",
+			doImport: func() (*protocol.OperationResponse, error) {
+				return client.ImportUsers(&ImportUsersRequest{InputConfig: &UsersInputConfig{
+					Source: &UsersInputConfig_UsersInlineSource{UsersInlineSource: &UsersInlineSource{Users: users}}}})
+			},
+		},
+		{
+			name: "products",
+			doImport: func() (*protocol.OperationResponse, error) {
+				return client.ImportProducts(&ImportProductsRequest{InputConfig: &ProductsInputConfig{
+					Source: &ProductsInputConfig_ProductsInlineSource{
+						ProductsInlineSource: &ProductsInlineSource{Products: products}}}})
+			},
+		},
+		{
+			name: "user_events",
+			doImport: func() (*protocol.OperationResponse, error) {
+				return client.ImportUserEvents(&ImportUserEventsRequest{InputConfig: &UserEventsInputConfig{
+					Source: &UserEventsInputConfig_UserEventsInlineSource{
+						UserEventsInlineSource: &UserEventsInlineSource{UserEvents: userEvents}}}})
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := tt.doImport(); err != importTooManyErr {
+				t.Errorf("import error = %v, want %v", err, importTooManyErr)
+			}
+		})
+	}
+	if len(server.received()) != 0 {
+		t.Errorf("server received %d requests, want none", len(server.received()))
+	}
+}
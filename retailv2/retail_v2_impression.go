@@ -0,0 +1,51 @@
+package retailv2
+
+import (
+	"errors"
+
+	. "github.com/byteplus-sdk/sdk-go/core"
+	. "github.com/byteplus-sdk/sdk-go/retailv2/protocol"
+	"google.golang.org/protobuf/proto"
+)
+
+// BuildAckServerImpressionsRequest builds the request of AckServerImpressions from
+// the predict request, its response and the product ids finally displayed in order.
+// The products in response but not displayed are "filtered", or the reason in
+// filteredReasons. TrafficSource default is "byteplus".
+// See Client.AckServerImpressions for the format of the request
+func BuildAckServerImpressionsRequest(request *PredictRequest, response *PredictResponse,
+	displayedIds []string, trafficSource string, filteredReasons map[string]string) (*AckServerImpressionsRequest, error) {
+	if response.GetRequestId() == "" {
+		return nil, errors.New("predict request id is empty")
+	}
+	if _, fallback := response.GetValue().GetExtra()[PredictFallbackExtraKey]; fallback {
+		return nil, errors.New("predict response is a fallback, which is not recommended by byteplus")
+	}
+	if trafficSource == "" {
+		trafficSource = "byteplus"
+	}
+	products := response.GetValue().GetResponseProducts()
+	predictedIds := make([]string, 0, len(products))
+	for _, product := range products {
+		predictedIds = append(predictedIds, product.GetProductId())
+	}
+	alteredItems := DiffImpressions(predictedIds, displayedIds, filteredReasons)
+	alteredProducts := make([]*AckServerImpressionsRequest_AlteredProduct, 0, len(alteredItems))
+	for _, item := range alteredItems {
+		alteredProducts = append(alteredProducts, &AckServerImpressionsRequest_AlteredProduct{
+			ProductId:     item.Id,
+			AlteredReason: item.Reason,
+			Rank:          item.Rank,
+		})
+	}
+	ackRequest := &AckServerImpressionsRequest{
+		PredictRequestId: response.GetRequestId(),
+		UserId:           request.GetUserId(),
+		TrafficSource:    trafficSource,
+		AlteredProducts:  alteredProducts,
+	}
+	if request.GetScene() != nil {
+		ackRequest.Scene = proto.Clone(request.GetScene()).(*UserEvent_Scene)
+	}
+	return ackRequest, nil
+}
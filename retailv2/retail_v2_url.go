@@ -36,14 +36,23 @@ type retailURL struct {
 	// The URL of uploading real-time user data
 	// Example: https://tob.sgsnssdk.com/data/api/retail/v2/retail_demo/user?method=write
 	writeUsersURL string
+	// The URL of importing daily offline user data
+	// Example: https://tob.sgsnssdk.com/data/api/retail/v2/retail_demo/user?method=import
+	importUsersURL string
 
 	// The URL of uploading real-time product data
 	// Example: https://tob.sgsnssdk.com/data/api/retail/v2/retail_demo/product?method=write
 	writeProductsURL string
+	// The URL of importing daily offline product data
+	// Example: https://tob.sgsnssdk.com/data/api/retail/v2/retail_demo/product?method=import
+	importProductsURL string
 
 	// The URL of uploading real-time user event data
 	// Example: https://tob.sgsnssdk.com/data/api/retail/v2/retail_demo/user_event?method=write
 	writeUserEventsURL string
+	// The URL of importing daily offline user event data
+	// Example: https://tob.sgsnssdk.com/data/api/retail/v2/retail_demo/user_event?method=import
+	importUserEventsURL string
 }
 
 func (receiver *retailURL) Refresh(host string) {
@@ -51,8 +60,11 @@ func (receiver *retailURL) Refresh(host string) {
 	receiver.predictURLFormat = receiver.generatePredictURLFormat(host)
 	receiver.ackImpressionURL = receiver.generateAckURL(host)
 	receiver.writeUsersURL = receiver.generateUploadURL(host, "user", "write")
+	receiver.importUsersURL = receiver.generateUploadURL(host, "user", "import")
 	receiver.writeProductsURL = receiver.generateUploadURL(host, "product", "write")
+	receiver.importProductsURL = receiver.generateUploadURL(host, "product", "import")
 	receiver.writeUserEventsURL = receiver.generateUploadURL(host, "user_event", "write")
+	receiver.importUserEventsURL = receiver.generateUploadURL(host, "user_event", "import")
 }
 
 func (receiver *retailURL) generatePredictURLFormat(host string) string {
@@ -0,0 +1,159 @@
+package retailv2
+
+import (
+	"fmt"
+	"time"
+
+	. "github.com/byteplus-sdk/sdk-go/core"
+	. "github.com/byteplus-sdk/sdk-go/retailv2/protocol"
+)
+
+const (
+	userEventTypeSearch         = "search"
+	userEventTypePurchase       = "purchase"
+	userEventTypeImpression     = "impression"
+	userEventTypeClick          = "click"
+	userEventTypeStayDetailPage = "stay-detail-page"
+)
+
+// the acceptable values of `UserEvent.event_type`
+var userEventTypes = map[string]bool{
+	"impression":            true,
+	"click":                 true,
+	"add-to-cart":           true,
+	"remove-from-cart":      true,
+	"add-to-favorites":      true,
+	"remove-from-favorites": true,
+	"purchase":              true,
+	"search":                true,
+	"stay-detail-page":      true,
+}
+
+// ValidateUser checks the required fields of user
+func ValidateUser(user *User) []*ValidationIssue {
+	var issues []*ValidationIssue
+	if user.GetUserId() == "" {
+		issues = append(issues, &ValidationIssue{Field: "user_id", Message: "is empty"})
+	}
+	return issues
+}
+
+// ValidateProduct checks the required fields of product, the depths of categories,
+// and warns if the current price is higher than the origin price
+func ValidateProduct(product *Product) []*ValidationIssue {
+	var issues []*ValidationIssue
+	if product.GetProductId() == "" {
+		issues = append(issues, &ValidationIssue{Field: "product_id", Message: "is empty"})
+	}
+	for i, category := range product.GetCategories() {
+		field := fmt.Sprintf("categories[%d]", i)
+		if category.GetCategoryDepth() != int32(i+1) {
+			issues = append(issues, &ValidationIssue{
+				Field:   field + ".category_depth",
+				Message: fmt.Sprintf("is %d, should be %d as depths are consecutive from 1", category.GetCategoryDepth(), i+1),
+			})
+		}
+		if len(category.GetCategoryNodes()) == 0 {
+			issues = append(issues, &ValidationIssue{Field: field + ".category_nodes", Message: "is empty"})
+		}
+		for j, node := range category.GetCategoryNodes() {
+			if node.GetIdOrName() == "" {
+				issues = append(issues, &ValidationIssue{
+					Field:   fmt.Sprintf("%s.category_nodes[%d].id_or_name", field, j),
+					Message: "is empty",
+				})
+			}
+		}
+	}
+	price := product.GetPrice()
+	if price.GetCurrentPrice() < 0 || price.GetOriginPrice() < 0 {
+		issues = append(issues, &ValidationIssue{Field: "price", Message: "is negative"})
+	} else if price.GetCurrentPrice() > price.GetOriginPrice() {
+		issues = append(issues, &ValidationIssue{
+			Field:   "price.current_price",
+			Message: fmt.Sprintf("%d is higher than origin_price %d", price.GetCurrentPrice(), price.GetOriginPrice()),
+			Warning: true,
+		})
+	}
+	return issues
+}
+
+// ValidateUserEvent checks the required fields of user event, the event type, the
+// timestamp in seconds, and the fields required by the event type: the query of
+// search, the purchase_count of purchase, the scene of impression and click, and
+// the detail_page_stay_time of stay-detail-page
+func ValidateUserEvent(userEvent *UserEvent) []*ValidationIssue {
+	var issues []*ValidationIssue
+	if userEvent.GetUserId() == "" {
+		issues = append(issues, &ValidationIssue{Field: "user_id", Message: "is empty"})
+	}
+	eventType := userEvent.GetEventType()
+	if !userEventTypes[eventType] {
+		issues = append(issues, &ValidationIssue{
+			Field:   "event_type",
+			Message: fmt.Sprintf("%q is not an acceptable event type", eventType),
+		})
+	}
+	if problem := ValidateTimestamp(userEvent.GetEventTimestamp(), time.Now()); problem != "" {
+		issues = append(issues, &ValidationIssue{Field: "event_timestamp", Message: problem})
+	}
+	switch eventType {
+	case userEventTypeSearch:
+		if userEvent.GetContext().GetQuery() == "" {
+			issues = append(issues, &ValidationIssue{Field: "context.query", Message: "is empty for search event"})
+		}
+	case userEventTypePurchase:
+		if userEvent.GetPurchaseCount() <= 0 {
+			issues = append(issues, &ValidationIssue{Field: "purchase_count", Message: "is empty for purchase event"})
+		}
+	case userEventTypeImpression, userEventTypeClick:
+		if userEvent.GetScene().GetSceneName() == "" {
+			issues = append(issues, &ValidationIssue{
+				Field:   "scene.scene_name",
+				Message: fmt.Sprintf("is empty for %s event", eventType),
+			})
+		}
+	case userEventTypeStayDetailPage:
+		if userEvent.GetDetailPageStayTime() <= 0 {
+			issues = append(issues, &ValidationIssue{
+				Field:   "detail_page_stay_time",
+				Message: "is empty for stay-detail-page event",
+			})
+		}
+	}
+	if eventType != userEventTypeSearch && userEvent.GetProductId() == "" {
+		issues = append(issues, &ValidationIssue{Field: "product_id", Message: "is empty"})
+	}
+	return issues
+}
+
+func validateUsers(users []*User) error {
+	var issues []*ValidationIssue
+	for i, user := range users {
+		issues = append(issues, indexIssues(i, ValidateUser(user))...)
+	}
+	return CheckValidationIssues(issues)
+}
+
+func validateProducts(products []*Product) error {
+	var issues []*ValidationIssue
+	for i, product := range products {
+		issues = append(issues, indexIssues(i, ValidateProduct(product))...)
+	}
+	return CheckValidationIssues(issues)
+}
+
+func validateUserEvents(userEvents []*UserEvent) error {
+	var issues []*ValidationIssue
+	for i, userEvent := range userEvents {
+		issues = append(issues, indexIssues(i, ValidateUserEvent(userEvent))...)
+	}
+	return CheckValidationIssues(issues)
+}
+
+func indexIssues(index int, issues []*ValidationIssue) []*ValidationIssue {
+	for _, issue := range issues {
+		issue.Index = index
+	}
+	return issues
+}
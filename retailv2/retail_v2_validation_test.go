@@ -0,0 +1,97 @@
+package retailv2
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	. "github.com/byteplus-sdk/sdk-go/core"
+	. "github.com/byteplus-sdk/sdk-go/retailv2/protocol"
+)
+
+func issueFields(issues []*ValidationIssue) []string {
+	var fields []string
+	for _, issue := range issues {
+		fields = append(fields, issue.Field)
+	}
+	return fields
+}
+
+func TestValidateUserEvent(t *testing.T) {
+	now := time.Now().Unix()
+	scene := &UserEvent_Scene{SceneName: "home"}
+	tests := []struct {
+		name       string
+		userEvent  *UserEvent
+		wantFields []string
+	}{
+		{
+			name: "impression",
+			userEvent: &UserEvent{UserId: "u", EventType: "impression", EventTimestamp: now,
+				ProductId: "p", Scene: scene},
+		},
+		{
+			name:       "impression_without_scene",
+			userEvent:  &UserEvent{UserId: "u", EventType: "impression", EventTimestamp: now, ProductId: "p"},
+			wantFields: []string{"scene.scene_name"},
+		},
+		{
+			name:       "click_without_scene",
+			userEvent:  &UserEvent{UserId: "u", EventType: "click", EventTimestamp: now, ProductId: "p"},
+			wantFields: []string{"scene.scene_name"},
+		},
+		{
+			name: "stay_detail_page",
+			userEvent: &UserEvent{UserId: "u", EventType: "stay-detail-page", EventTimestamp: now,
+				ProductId: "p", DetailPageStayTime: 10},
+		},
+		{
+			name:       "stay_detail_page_without_stay_time",
+			userEvent:  &UserEvent{UserId: "u", EventType: "stay-detail-page", EventTimestamp: now},
+			wantFields: []string{"detail_page_stay_time", "product_id"},
+		},
+		{
+			name: "search",
+			userEvent: &UserEvent{UserId: "u", EventType: "search", EventTimestamp: now,
+				Context: &UserEvent_Context{Query: "shoes"}},
+		},
+		{
+			name:       "purchase_without_count",
+			userEvent:  &UserEvent{UserId: "u", EventType: "purchase", EventTimestamp: now, ProductId: "p"},
+			wantFields: []string{"purchase_count"},
+		},
+		{
+			name:       "unknown_type_and_milliseconds",
+			userEvent:  &UserEvent{EventType: "view", EventTimestamp: now * 1000, ProductId: "p"},
+			wantFields: []string{"user_id", "event_type", "event_timestamp"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := issueFields(ValidateUserEvent(tt.userEvent))
+			if !reflect.DeepEqual(got, tt.wantFields) {
+				t.Errorf("ValidateUserEvent() fields = %v, want %v", got, tt.wantFields)
+			}
+		})
+	}
+}
+
+func TestValidateProduct(t *testing.T) {
+	product := &Product{
+		ProductId: "p",
+		Categories: []*Product_Category{
+			{CategoryDepth: 1, CategoryNodes: []*Product_Category_CategoryNode{{IdOrName: "shoes"}}},
+			{CategoryDepth: 3, CategoryNodes: []*Product_Category_CategoryNode{{}}},
+		},
+		Price: &Product_Price{CurrentPrice: 200, OriginPrice: 100},
+	}
+	issues := ValidateProduct(product)
+	wantFields := []string{"categories[1].category_depth", "categories[1].category_nodes[0].id_or_name",
+		"price.current_price"}
+	if got := issueFields(issues); !reflect.DeepEqual(got, wantFields) {
+		t.Fatalf("ValidateProduct() fields = %v, want %v", got, wantFields)
+	}
+	if !issues[2].Warning {
+		t.Errorf("ValidateProduct() higher current price should be a warning")
+	}
+}